@@ -13,12 +13,26 @@ type BatchOperation struct {
 	OperationType string                 `json:"operationType"`
 	Id            string                 `json:"id"`
 	ResourceBody  map[string]interface{} `json:"resourceBody"`
+	IfMatch       string                 `json:"ifMatch"`
+	IfNoneMatch   string                 `json:"ifNoneMatch"`
 }
 
 type BatchOperationResult struct {
 	StatusCode    int                    `json:"statusCode"`
 	RequestCharge float64                `json:"requestCharge"`
 	ResourceBody  map[string]interface{} `json:"resourceBody"`
-	Etag          string                 `json:"etag"`
+	Etag          string                 `json:"eTag,omitempty"`
 	Message       string                 `json:"message"`
 }
+
+type QueryError struct {
+	Severity string             `json:"severity"`
+	Location QueryErrorLocation `json:"location"`
+	Code     string             `json:"code"`
+	Message  string             `json:"message"`
+}
+
+type QueryErrorLocation struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pikami/cosmium/internal/logger"
 )
@@ -20,6 +21,14 @@ const (
 	DataStoreBadger = "badger"
 )
 
+const (
+	ConsistencyLevelStrong           = "Strong"
+	ConsistencyLevelBoundedStaleness = "BoundedStaleness"
+	ConsistencyLevelSession          = "Session"
+	ConsistencyLevelConsistentPrefix = "ConsistentPrefix"
+	ConsistencyLevelEventual         = "Eventual"
+)
+
 func ParseFlags() ServerConfig {
 	host := flag.String("Host", "localhost", "Hostname")
 	port := flag.Int("Port", 8081, "Listen port")
@@ -37,6 +46,18 @@ func ParseFlags() ServerConfig {
 	dataStore := NewEnumValue("json", []string{DataStoreJson, DataStoreBadger})
 	flag.Var(dataStore, "DataStore", fmt.Sprintf("Sets the data store %s", dataStore.AllowedValuesList()))
 	enableRntbd := flag.Bool("ExperimentalEnableRntbd", false, "EXPERIMENTAL: Enable RNTBD (CosmosDB Direct Connection Mode)")
+	partitionCount := flag.Int("PartitionCount", 1, "Number of physical partitions for new collections")
+	consistencyLevel := NewEnumValue(ConsistencyLevelSession, []string{
+		ConsistencyLevelStrong,
+		ConsistencyLevelBoundedStaleness,
+		ConsistencyLevelSession,
+		ConsistencyLevelConsistentPrefix,
+		ConsistencyLevelEventual,
+	})
+	flag.Var(consistencyLevel, "DefaultConsistencyLevel", fmt.Sprintf("Sets the default consistency level of the account %s", consistencyLevel.AllowedValuesList()))
+	replicationLag := flag.Duration("ReplicationLag", 0, "Delay before writes become visible to reads weaker than Strong (e.g. 500ms), 0 disables replica simulation")
+	maxStalenessPrefix := flag.Int64("MaxStalenessPrefix", 100, "Number of writes a BoundedStaleness read can lag behind")
+	maxStalenessInterval := flag.Duration("MaxStalenessInterval", 5*time.Second, "Time a BoundedStaleness read can lag behind")
 
 	flag.Parse()
 	setFlagsFromEnvironment()
@@ -56,6 +77,11 @@ func ParseFlags() ServerConfig {
 	config.LogLevel = logLevel.value
 	config.DataStore = dataStore.value
 	config.EnableRntbd = *enableRntbd
+	config.PartitionCount = *partitionCount
+	config.DefaultConsistencyLevel = consistencyLevel.value
+	config.ReplicationLag = *replicationLag
+	config.MaxStalenessPrefix = *maxStalenessPrefix
+	config.MaxStalenessInterval = *maxStalenessInterval
 
 	config.PopulateCalculatedFields()
 
@@ -111,6 +137,15 @@ func (c *ServerConfig) ApplyDefaultsToEmptyFields() {
 	if c.AccountKey == "" {
 		c.AccountKey = DefaultAccountKey
 	}
+	if c.DefaultConsistencyLevel == "" {
+		c.DefaultConsistencyLevel = ConsistencyLevelSession
+	}
+	if c.MaxStalenessPrefix == 0 {
+		c.MaxStalenessPrefix = 100
+	}
+	if c.MaxStalenessInterval == 0 {
+		c.MaxStalenessInterval = 5 * time.Second
+	}
 }
 
 func setFlagsFromEnvironment() (err error) {
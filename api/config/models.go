@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type ServerConfig struct {
 	DatabaseAccount  string `json:"databaseAccount"`
 	DatabaseDomain   string `json:"databaseDomain"`
@@ -20,6 +22,12 @@ type ServerConfig struct {
 	LogLevel                string `json:"logLevel"`
 	ExplorerBaseUrlLocation string `json:"explorerBaseUrlLocation"`
 	EnableRntbd             bool   `json:"enableRntbd"`
+	PartitionCount          int    `json:"partitionCount"`
+
+	DefaultConsistencyLevel string        `json:"defaultConsistencyLevel"`
+	ReplicationLag          time.Duration `json:"replicationLag"`
+	MaxStalenessPrefix      int64         `json:"maxStalenessPrefix"`
+	MaxStalenessInterval    time.Duration `json:"maxStalenessInterval"`
 
 	DataStore string `json:"dataStore"`
 }
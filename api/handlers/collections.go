@@ -35,6 +35,11 @@ func (h *Handlers) GetCollection(c *gin.Context) {
 
 	collection, status := h.dataStore.GetCollection(databaseId, id)
 	if status == datastore.StatusOk {
+		if !checkIfNoneMatch(c, collection.ETag) {
+			return
+		}
+
+		c.Header(headers.ETag, collection.ETag)
 		c.IndentedJSON(http.StatusOK, collection)
 		return
 	}
@@ -51,7 +56,7 @@ func (h *Handlers) DeleteCollection(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	id := c.Param("collId")
 
-	status := h.dataStore.DeleteCollection(databaseId, id)
+	status := h.dataStore.DeleteCollection(databaseId, id, requestIfMatch(c))
 	if status == datastore.StatusOk {
 		c.Status(http.StatusNoContent)
 		return
@@ -62,6 +67,11 @@ func (h *Handlers) DeleteCollection(c *gin.Context) {
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
@@ -79,6 +89,19 @@ func (h *Handlers) CreateCollection(c *gin.Context) {
 		return
 	}
 
+	if err := validatePartitionKeyDefinition(newCollection.PartitionKey); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"code": "BadRequest", "message": err.Error()})
+		return
+	}
+
+	if err := validateComputedProperties(newCollection); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"code": "BadRequest", "message": err.Error()})
+		return
+	}
+
+	// The number of physical partitions is a setting of the emulator, clients can't choose it
+	newCollection.PartitionCount = h.config.PartitionCount
+
 	createdCollection, status := h.dataStore.CreateCollection(databaseId, newCollection)
 	if status == datastore.Conflict {
 		c.IndentedJSON(http.StatusConflict, constants.ConflictResponse)
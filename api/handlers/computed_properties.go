@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/pikami/cosmium/parsers"
+	"github.com/pikami/cosmium/parsers/nosql"
+)
+
+const maxComputedProperties = 20
+
+var computedPropertyReservedNames = []string{"id", "_rid", "_ts", "_etag", "_self", "_attachments"}
+
+var computedPropertyUnsupportedFunctions = []parsers.FunctionCallType{
+	parsers.FunctionCallGetCurrentDateTime,
+	parsers.FunctionCallGetCurrentTicks,
+	parsers.FunctionCallGetCurrentTimestamp,
+	parsers.FunctionCallMathRand,
+	parsers.FunctionCallStArea,
+	parsers.FunctionCallStDistance,
+	parsers.FunctionCallStWithin,
+	parsers.FunctionCallStIntersects,
+	parsers.FunctionCallStIsValid,
+	parsers.FunctionCallStIsValidDetailed,
+	parsers.FunctionCallUserDefined,
+}
+
+// parseComputedProperties parses the computed property queries of a collection,
+// the queries are validated when the collection is created
+func parseComputedProperties(computedProperties []datastore.CollectionComputedProperty) map[string]parsers.SelectStmt {
+	if len(computedProperties) == 0 {
+		return nil
+	}
+
+	result := make(map[string]parsers.SelectStmt, len(computedProperties))
+	for _, computedProperty := range computedProperties {
+		if query, err := parseComputedPropertyQuery(computedProperty.Query); err == nil {
+			result[computedProperty.Name] = query
+		}
+	}
+
+	return result
+}
+
+// validateComputedProperties applies the restrictions Cosmos DB enforces on computed property definitions
+func validateComputedProperties(collection datastore.Collection) error {
+	if len(collection.ComputedProperties) > maxComputedProperties {
+		return fmt.Errorf("The number of computed properties exceeds the maximum of %d.", maxComputedProperties)
+	}
+
+	names := make(map[string]bool, len(collection.ComputedProperties))
+	for _, computedProperty := range collection.ComputedProperties {
+		names[computedProperty.Name] = true
+	}
+
+	seenNames := make(map[string]bool, len(collection.ComputedProperties))
+	for _, computedProperty := range collection.ComputedProperties {
+		name := computedProperty.Name
+		if name == "" || strings.ContainsAny(name, "/.[]\"'") {
+			return fmt.Errorf("Computed property name '%s' is invalid.", name)
+		}
+
+		if slices.Contains(computedPropertyReservedNames, name) {
+			return fmt.Errorf("Computed property name '%s' is reserved for system properties.", name)
+		}
+
+		if seenNames[name] {
+			return fmt.Errorf("Computed property name '%s' is defined more than once.", name)
+		}
+		seenNames[name] = true
+
+		for _, path := range collection.PartitionKey.Paths {
+			if path == "/"+name || strings.HasPrefix(path, "/"+name+"/") {
+				return fmt.Errorf("Computed property '%s' cannot be used as a partition key.", name)
+			}
+		}
+
+		query, err := parseComputedPropertyQuery(computedProperty.Query)
+		if err != nil {
+			return fmt.Errorf("Computed property '%s' has an invalid query: %s", name, err.Error())
+		}
+
+		if err := validateComputedPropertyQuery(query, names); err != nil {
+			return fmt.Errorf("Computed property '%s' has an invalid query: %s", name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func parseComputedPropertyQuery(queryText string) (parsers.SelectStmt, error) {
+	parsedQuery, err := nosql.Parse("", []byte(queryText))
+	if err != nil {
+		return parsers.SelectStmt{}, errors.New(nosql.GetSyntaxError(queryText, err).Message)
+	}
+
+	query, ok := parsedQuery.(parsers.SelectStmt)
+	if !ok {
+		return parsers.SelectStmt{}, errors.New("Only SELECT queries are supported.")
+	}
+
+	return query, nil
+}
+
+func validateComputedPropertyQuery(query parsers.SelectStmt, computedPropertyNames map[string]bool) error {
+	if len(query.SelectItems) != 1 || !query.SelectItems[0].IsTopLevel {
+		return errors.New("The query must use the form 'SELECT VALUE <expression> FROM <alias>'.")
+	}
+
+	rootAlias := query.Table.SelectItem.Alias
+	if len(query.Table.SelectItem.Path) != 1 || query.Table.IsInSelect || query.Table.SelectItem.Type != parsers.SelectItemTypeField {
+		return errors.New("The FROM clause must reference the root item only.")
+	}
+
+	if rootAlias == "" {
+		rootAlias = query.Table.SelectItem.Path[0]
+	}
+
+	if len(query.JoinItems) > 0 || query.Filters != nil || len(query.OrderExpressions) > 0 || len(query.GroupBy) > 0 ||
+		query.Distinct || query.Count > 0 || query.Offset > 0 {
+		return errors.New("JOIN, WHERE, GROUP BY, ORDER BY, DISTINCT, TOP and OFFSET LIMIT clauses are not supported.")
+	}
+
+	return validateComputedPropertyExpression(query.SelectItems[0], rootAlias, computedPropertyNames)
+}
+
+func validateComputedPropertyExpression(expression interface{}, rootAlias string, computedPropertyNames map[string]bool) error {
+	validate := func(expressions ...interface{}) error {
+		for _, expression := range expressions {
+			if err := validateComputedPropertyExpression(expression, rootAlias, computedPropertyNames); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch typedValue := expression.(type) {
+	case parsers.SelectItem:
+		if typedValue.Type == parsers.SelectItemTypeSubQuery {
+			return errors.New("Subqueries are not supported.")
+		}
+
+		if typedValue.Type == parsers.SelectItemTypeField && len(typedValue.Path) > 1 &&
+			typedValue.Path[0] == rootAlias && computedPropertyNames[typedValue.Path[1]] {
+			return errors.New("Computed properties cannot reference other computed properties.")
+		}
+
+		for _, selectItem := range typedValue.SelectItems {
+			if err := validate(selectItem); err != nil {
+				return err
+			}
+		}
+
+		if typedValue.Value != nil {
+			return validate(typedValue.Value)
+		}
+	case parsers.FunctionCall:
+		if slices.Contains(parsers.AggregateFunctions, typedValue.Type) {
+			return errors.New("Aggregate functions are not supported.")
+		}
+
+		if slices.Contains(computedPropertyUnsupportedFunctions, typedValue.Type) {
+			return fmt.Errorf("Function '%s' is not supported.", typedValue.Type)
+		}
+
+		return validate(typedValue.Arguments...)
+	case parsers.ComparisonExpression:
+		return validate(typedValue.Left, typedValue.Right)
+	case parsers.LogicalExpression:
+		return validate(typedValue.Expressions...)
+	case parsers.BetweenExpression:
+		return validate(typedValue.Value, typedValue.Low, typedValue.High)
+	case parsers.BinaryExpression:
+		return validate(typedValue.Left, typedValue.Right)
+	case parsers.UnaryExpression:
+		return validate(typedValue.Value)
+	case parsers.SelectStmt:
+		return errors.New("Subqueries are not supported.")
+	case []interface{}:
+		return validate(typedValue...)
+	}
+
+	return nil
+}
@@ -30,6 +30,11 @@ func (h *Handlers) GetDatabase(c *gin.Context) {
 
 	database, status := h.dataStore.GetDatabase(id)
 	if status == datastore.StatusOk {
+		if !checkIfNoneMatch(c, database.ETag) {
+			return
+		}
+
+		c.Header(headers.ETag, database.ETag)
 		c.IndentedJSON(http.StatusOK, database)
 		return
 	}
@@ -45,7 +50,7 @@ func (h *Handlers) GetDatabase(c *gin.Context) {
 func (h *Handlers) DeleteDatabase(c *gin.Context) {
 	id := c.Param("databaseId")
 
-	status := h.dataStore.DeleteDatabase(id)
+	status := h.dataStore.DeleteDatabase(id, requestIfMatch(c))
 	if status == datastore.StatusOk {
 		c.Status(http.StatusNoContent)
 		return
@@ -56,6 +61,11 @@ func (h *Handlers) DeleteDatabase(c *gin.Context) {
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	apimodels "github.com/pikami/cosmium/api/api_models"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/constants"
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+const failedDependencyMessage = "The operation could not be completed because another operation in the batch failed."
+
+// handleBatchRequest executes the operations of a batch in order within a single data store transaction.
+// Atomic batches are all-or-nothing, when an operation fails the transaction is rolled back and the other
+// operations fail with 424. Non-atomic batches stop at the first failure unless continue-on-error is requested.
+func (h *Handlers) handleBatchRequest(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+
+	batchOperations := make([]apimodels.BatchOperation, 0)
+	if err := c.BindJSON(&batchOperations); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+		return
+	}
+
+	partitionKey, ok := h.requestPointPartitionKey(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	collection, status := h.dataStore.GetCollection(databaseId, collectionId)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return
+	}
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+
+	udfs, status := h.dataStore.GetAllUserDefinedFunctions(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+
+	isAtomic, _ := strconv.ParseBool(c.GetHeader(headers.IsBatchAtomic))
+	continueOnError, _ := strconv.ParseBool(c.GetHeader(headers.BatchContinueOnError))
+
+	transaction, status := h.beginDocumentTransaction(databaseId, collection, partitionKey)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return
+	}
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+	defer transaction.Rollback()
+
+	batchOperationResults := make([]apimodels.BatchOperationResult, len(batchOperations))
+	failedIdx := -1
+	for idx, operation := range batchOperations {
+		batchOperationResults[idx] = h.executeBatchOperation(transaction, udfs, operation)
+		if batchOperationResults[idx].StatusCode >= http.StatusBadRequest && failedIdx < 0 {
+			failedIdx = idx
+			if isAtomic || !continueOnError {
+				break
+			}
+		}
+	}
+
+	// Failed operations leave no changes behind, so only atomic batches have to discard the successful ones
+	if failedIdx >= 0 && isAtomic {
+		transaction.Rollback()
+	} else if status := transaction.Commit(); status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+
+	h.setSessionHeaders(c, databaseId, collectionId, partitionKey)
+
+	if failedIdx < 0 {
+		c.JSON(http.StatusOK, batchOperationResults)
+		return
+	}
+
+	for idx := range batchOperationResults {
+		if idx == failedIdx {
+			continue
+		}
+
+		if isAtomic || (!continueOnError && idx > failedIdx) {
+			batchOperationResults[idx] = apimodels.BatchOperationResult{
+				StatusCode: http.StatusFailedDependency,
+				Message:    failedDependencyMessage,
+			}
+		}
+	}
+
+	c.JSON(http.StatusMultiStatus, batchOperationResults)
+}
+
+func (h *Handlers) executeBatchOperation(
+	transaction *documentTransaction,
+	udfs []datastore.UserDefinedFunction,
+	operation apimodels.BatchOperation,
+) apimodels.BatchOperationResult {
+	ifMatch := normalizeIfMatch(operation.IfMatch)
+
+	switch operation.OperationType {
+	case apimodels.BatchOperationTypeCreate,
+		apimodels.BatchOperationTypeUpsert,
+		apimodels.BatchOperationTypeReplace,
+		apimodels.BatchOperationTypePatch:
+		if operation.ResourceBody == nil {
+			return apimodels.BatchOperationResult{
+				StatusCode: http.StatusBadRequest,
+				Message:    "The operation is missing the resource body.",
+			}
+		}
+	}
+
+	switch operation.OperationType {
+	case apimodels.BatchOperationTypeCreate:
+		createdDocument, status := transaction.createDocument(operation.ResourceBody)
+		return newBatchOperationResult(status, http.StatusCreated, createdDocument)
+	case apimodels.BatchOperationTypeDelete:
+		existingDocument, status := transaction.getDocument(operation.Id)
+		if status == datastore.StatusOk {
+			status = transaction.deleteDocument(existingDocument, ifMatch)
+		}
+		return newBatchOperationResult(status, http.StatusNoContent, nil)
+	case apimodels.BatchOperationTypeReplace:
+		existingDocument, status := transaction.getDocument(operation.Id)
+		if status != datastore.StatusOk {
+			return newBatchOperationResult(status, http.StatusCreated, nil)
+		}
+		replacedDocument, status := transaction.replaceDocument(existingDocument, operation.ResourceBody, ifMatch)
+		return newBatchOperationResult(status, http.StatusCreated, replacedDocument)
+	case apimodels.BatchOperationTypeUpsert:
+		upsertedDocument, status := transaction.upsertDocument(operation.ResourceBody, ifMatch)
+		return newBatchOperationResult(status, http.StatusCreated, upsertedDocument)
+	case apimodels.BatchOperationTypeRead:
+		document, status := transaction.getDocument(operation.Id)
+		if etag, _ := document["_etag"].(string); status == datastore.StatusOk && operation.IfNoneMatch != "" && etagMatches(operation.IfNoneMatch, etag) {
+			return apimodels.BatchOperationResult{StatusCode: http.StatusNotModified, Etag: etag}
+		}
+		return newBatchOperationResult(status, http.StatusOK, document)
+	case apimodels.BatchOperationTypePatch:
+		existingDocument, status := transaction.getDocument(operation.Id)
+		if status != datastore.StatusOk {
+			return newBatchOperationResult(status, http.StatusOK, nil)
+		}
+		etag, _ := existingDocument["_etag"].(string)
+		if ifMatch != "" && ifMatch != etag {
+			return newBatchOperationResult(datastore.PreconditionFailed, http.StatusOK, nil)
+		}
+		modifiedDocument, statusCode, err := h.applyPatch(transaction.collection, udfs, existingDocument, operation.ResourceBody)
+		if err != nil {
+			return apimodels.BatchOperationResult{StatusCode: statusCode, Message: err.Error()}
+		}
+		patchedDocument, status := transaction.replaceDocument(existingDocument, modifiedDocument, etag)
+		return newBatchOperationResult(status, http.StatusOK, patchedDocument)
+	}
+
+	return apimodels.BatchOperationResult{
+		StatusCode: http.StatusBadRequest,
+		Message:    "Unknown operation type",
+	}
+}
+
+// newBatchOperationResult maps the data store status to the result of a batch operation,
+// successful operations are reported with the status code of the operation type
+func newBatchOperationResult(status datastore.DataStoreStatus, successStatusCode int, document datastore.Document) apimodels.BatchOperationResult {
+	if status != datastore.StatusOk {
+		return apimodels.BatchOperationResult{StatusCode: dataStoreStatusToResponseCode(status)}
+	}
+
+	etag, _ := document["_etag"].(string)
+	return apimodels.BatchOperationResult{
+		StatusCode:   successStatusCode,
+		ResourceBody: document,
+		Etag:         etag,
+	}
+}
@@ -0,0 +1,347 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pikami/cosmium/internal/converters"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/parsers"
+	"github.com/pikami/cosmium/parsers/nosql"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+)
+
+// maxPatchOperations is the maximum number of operations Cosmos DB accepts in a single patch request
+const maxPatchOperations = 10
+
+const (
+	patchOperationAdd       = "add"
+	patchOperationSet       = "set"
+	patchOperationReplace   = "replace"
+	patchOperationRemove    = "remove"
+	patchOperationIncrement = "incr"
+	patchOperationMove      = "move"
+)
+
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from"`
+	Value interface{} `json:"value"`
+}
+
+// applyPatch applies the operations of a patch request body to a copy of the document. When the body has a
+// condition, it is evaluated against the current document first. The returned status code tells how a failure
+// should be reported to the client
+func (h *Handlers) applyPatch(
+	collection datastore.Collection,
+	udfs []datastore.UserDefinedFunction,
+	document datastore.Document,
+	patchBody map[string]interface{},
+) (map[string]interface{}, int, error) {
+	var operations []patchOperation
+	operationsBytes, err := json.Marshal(patchBody["operations"])
+	if err != nil || json.Unmarshal(operationsBytes, &operations) != nil {
+		return nil, http.StatusBadRequest, errors.New("Could not decode operations")
+	}
+
+	if len(operations) == 0 {
+		return nil, http.StatusBadRequest, errors.New("Patch request has no operations.")
+	}
+
+	if len(operations) > maxPatchOperations {
+		return nil, http.StatusBadRequest, fmt.Errorf("The number of patch operations exceeds the maximum of %d operations per request.", maxPatchOperations)
+	}
+
+	if condition, ok := patchBody["condition"].(string); ok && condition != "" {
+		conditionHolds, err := h.evaluatePatchCondition(collection, udfs, document, condition)
+		if err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+
+		if !conditionHolds {
+			return nil, http.StatusPreconditionFailed, errors.New("One of the specified pre-condition is not met.")
+		}
+	}
+
+	// Work on a deep copy, the stored document must stay untouched when an operation fails
+	currentDocumentBytes, err := json.Marshal(document)
+	if err != nil {
+		logger.ErrorLn("Failed to marshal existing document:", err)
+		return nil, http.StatusInternalServerError, errors.New("Failed to marshal existing document")
+	}
+
+	var modifiedDocument map[string]interface{}
+	if err := json.Unmarshal(currentDocumentBytes, &modifiedDocument); err != nil {
+		logger.ErrorLn("Failed to unmarshal existing document:", err)
+		return nil, http.StatusInternalServerError, errors.New("Failed to unmarshal existing document")
+	}
+
+	for _, operation := range operations {
+		if err := applyPatchOperation(modifiedDocument, operation); err != nil {
+			return nil, http.StatusBadRequest, err
+		}
+	}
+
+	if modifiedDocument["id"] != document["id"] {
+		return nil, http.StatusUnprocessableEntity, errors.New("The ID field cannot be modified")
+	}
+
+	return modifiedDocument, http.StatusOK, nil
+}
+
+// evaluatePatchCondition checks whether the document satisfies a condition like "FROM c WHERE c.status = 'open'"
+func (h *Handlers) evaluatePatchCondition(collection datastore.Collection, udfs []datastore.UserDefinedFunction, document datastore.Document, condition string) (bool, error) {
+	query := "SELECT VALUE 1 " + condition
+	parsedQuery, err := nosql.Parse("", []byte(query))
+	if err != nil {
+		return false, fmt.Errorf("The patch condition '%s' is invalid.", condition)
+	}
+
+	typedQuery, ok := parsedQuery.(parsers.SelectStmt)
+	if !ok {
+		return false, fmt.Errorf("The patch condition '%s' is invalid.", condition)
+	}
+
+	typedQuery.Udfs = make(map[string]string, len(udfs))
+	for _, udf := range udfs {
+		typedQuery.Udfs[udf.ID] = udf.Body
+	}
+	typedQuery.ComputedProperties = parseComputedProperties(collection.ComputedProperties)
+
+	rowsIterator := converters.NewDocumentToRowTypeIterator(&singleDocumentIterator{document: document})
+	result := memoryexecutor.ExecuteQuery(typedQuery, rowsIterator, 0, 1)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return len(result.Rows) > 0, nil
+}
+
+func applyPatchOperation(document map[string]interface{}, operation patchOperation) error {
+	segments, err := parsePatchPath(operation.Path)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Op {
+	case patchOperationAdd:
+		return updatePatchTarget(document, segments, false, func(container interface{}, key string) (interface{}, error) {
+			return addPatchValue(container, key, operation.Value, operation.Path)
+		})
+	case patchOperationSet:
+		return updatePatchTarget(document, segments, true, func(container interface{}, key string) (interface{}, error) {
+			return setPatchValue(container, key, operation.Value, operation.Path)
+		})
+	case patchOperationReplace:
+		return updatePatchTarget(document, segments, false, func(container interface{}, key string) (interface{}, error) {
+			if _, ok := getPatchChild(container, key); !ok {
+				return nil, fmt.Errorf("Replace Operation can only be applied on an existing path. Path: '%s'.", operation.Path)
+			}
+			return setPatchValue(container, key, operation.Value, operation.Path)
+		})
+	case patchOperationRemove:
+		return updatePatchTarget(document, segments, false, func(container interface{}, key string) (interface{}, error) {
+			return removePatchValue(container, key, operation.Path)
+		})
+	case patchOperationIncrement:
+		increment, ok := operation.Value.(float64)
+		if !ok {
+			return fmt.Errorf("Increment Operation requires a numeric value. Path: '%s'.", operation.Path)
+		}
+		return updatePatchTarget(document, segments, false, func(container interface{}, key string) (interface{}, error) {
+			current, exists := getPatchChild(container, key)
+			if !exists {
+				return setPatchValue(container, key, increment, operation.Path)
+			}
+
+			number, ok := current.(float64)
+			if !ok {
+				return nil, fmt.Errorf("Increment Operation can only be applied on a numeric value. Path: '%s'.", operation.Path)
+			}
+			return setPatchValue(container, key, number+increment, operation.Path)
+		})
+	case patchOperationMove:
+		return movePatchValue(document, operation, segments)
+	}
+
+	return fmt.Errorf("Unsupported patch operation type '%s'.", operation.Op)
+}
+
+func movePatchValue(document map[string]interface{}, operation patchOperation, segments []string) error {
+	fromSegments, err := parsePatchPath(operation.From)
+	if err != nil {
+		return err
+	}
+
+	if operation.From == operation.Path {
+		return nil
+	}
+
+	if strings.HasPrefix(operation.Path, operation.From+"/") {
+		return fmt.Errorf("Move Operation cannot move a value into its own child. From: '%s', Path: '%s'.", operation.From, operation.Path)
+	}
+
+	var value interface{}
+	err = updatePatchTarget(document, fromSegments, false, func(container interface{}, key string) (interface{}, error) {
+		current, exists := getPatchChild(container, key)
+		if !exists {
+			return nil, fmt.Errorf("Move Operation can only be applied on an existing path. From: '%s'.", operation.From)
+		}
+		value = current
+		return removePatchValue(container, key, operation.From)
+	})
+	if err != nil {
+		return err
+	}
+
+	return updatePatchTarget(document, segments, false, func(container interface{}, key string) (interface{}, error) {
+		return addPatchValue(container, key, value, operation.Path)
+	})
+}
+
+// parsePatchPath splits a JSON pointer like "/a/b/0" into its unescaped segments
+func parsePatchPath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, "/") || len(path) < 2 {
+		return nil, fmt.Errorf("The patch path '%s' is invalid.", path)
+	}
+
+	segments := strings.Split(path[1:], "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+
+	return segments, nil
+}
+
+// updatePatchTarget walks the path and calls update with the container holding the last segment,
+// the returned container replaces the previous one so that arrays can grow and shrink. Missing
+// intermediate objects are created when createMissing is set
+func updatePatchTarget(
+	document map[string]interface{},
+	segments []string,
+	createMissing bool,
+	update func(container interface{}, key string) (interface{}, error),
+) error {
+	var walk func(node interface{}, segments []string) (interface{}, error)
+	walk = func(node interface{}, segments []string) (interface{}, error) {
+		if len(segments) == 1 {
+			return update(node, segments[0])
+		}
+
+		child, exists := getPatchChild(node, segments[0])
+		if !exists {
+			object, isObject := node.(map[string]interface{})
+			if !createMissing || !isObject {
+				return nil, fmt.Errorf("The patch path segment '%s' does not exist.", segments[0])
+			}
+			child = map[string]interface{}{}
+			object[segments[0]] = child
+		}
+
+		updatedChild, err := walk(child, segments[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		return setPatchValue(node, segments[0], updatedChild, segments[0])
+	}
+
+	_, err := walk(document, segments)
+	return err
+}
+
+func getPatchChild(container interface{}, key string) (interface{}, bool) {
+	switch typedContainer := container.(type) {
+	case map[string]interface{}:
+		value, ok := typedContainer[key]
+		return value, ok
+	case []interface{}:
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index >= len(typedContainer) {
+			return nil, false
+		}
+		return typedContainer[index], true
+	}
+
+	return nil, false
+}
+
+func setPatchValue(container interface{}, key string, value interface{}, path string) (interface{}, error) {
+	switch typedContainer := container.(type) {
+	case map[string]interface{}:
+		typedContainer[key] = value
+		return typedContainer, nil
+	case []interface{}:
+		if key == "-" {
+			return append(typedContainer, value), nil
+		}
+
+		index, err := strconv.Atoi(key)
+		if err != nil || index < 0 || index > len(typedContainer) {
+			return nil, fmt.Errorf("The array index in patch path '%s' is out of range.", path)
+		}
+		if index == len(typedContainer) {
+			return append(typedContainer, value), nil
+		}
+
+		typedContainer[index] = value
+		return typedContainer, nil
+	}
+
+	return nil, fmt.Errorf("The patch path '%s' does not point to an object or array member.", path)
+}
+
+func addPatchValue(container interface{}, key string, value interface{}, path string) (interface{}, error) {
+	array, isArray := container.([]interface{})
+	if !isArray || key == "-" {
+		return setPatchValue(container, key, value, path)
+	}
+
+	index, err := strconv.Atoi(key)
+	if err != nil || index < 0 || index > len(array) {
+		return nil, fmt.Errorf("The array index in patch path '%s' is out of range.", path)
+	}
+
+	return slices.Insert(array, index, value), nil
+}
+
+func removePatchValue(container interface{}, key string, path string) (interface{}, error) {
+	if _, exists := getPatchChild(container, key); !exists {
+		return nil, fmt.Errorf("Remove Operation can only be applied on an existing path. Path: '%s'.", path)
+	}
+
+	switch typedContainer := container.(type) {
+	case map[string]interface{}:
+		delete(typedContainer, key)
+		return typedContainer, nil
+	case []interface{}:
+		index, _ := strconv.Atoi(key)
+		return slices.Delete(typedContainer, index, index+1), nil
+	}
+
+	return container, nil
+}
+
+// singleDocumentIterator feeds one document to the query executor
+type singleDocumentIterator struct {
+	document datastore.Document
+	consumed bool
+}
+
+func (i *singleDocumentIterator) Next() (datastore.Document, datastore.DataStoreStatus) {
+	if i.consumed {
+		return datastore.Document{}, datastore.IterEOF
+	}
+
+	i.consumed = true
+	return i.document, datastore.StatusOk
+}
+
+func (i *singleDocumentIterator) Close() {}
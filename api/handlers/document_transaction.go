@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+// documentTransaction stages the document changes of a request in a data store transaction,
+// they are applied together once the request has succeeded and dropped when it fails
+type documentTransaction struct {
+	datastore.DocumentTransaction
+	databaseId   string
+	collectionId string
+	collection   datastore.Collection
+	partitionKey []interface{}
+}
+
+// beginDocumentTransaction starts a transaction scoped to the partition key of the request,
+// written documents have to belong to that partition when it is set. The data store must not
+// be used until the transaction is committed or rolled back
+func (h *Handlers) beginDocumentTransaction(
+	databaseId string,
+	collection datastore.Collection,
+	partitionKey []interface{},
+) (*documentTransaction, datastore.DataStoreStatus) {
+	transaction, status := h.dataStore.BeginDocumentTransaction(databaseId, collection.ID)
+	if status != datastore.StatusOk {
+		return nil, status
+	}
+
+	return &documentTransaction{
+		DocumentTransaction: transaction,
+		databaseId:          databaseId,
+		collectionId:        collection.ID,
+		collection:          collection,
+		partitionKey:        partitionKey,
+	}, datastore.StatusOk
+}
+
+func (t *documentTransaction) getDocument(documentId string) (datastore.Document, datastore.DataStoreStatus) {
+	return t.GetDocument(t.partitionKey, documentId)
+}
+
+func (t *documentTransaction) createDocument(document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	return t.CreateDocument(t.partitionKey, document)
+}
+
+func (t *documentTransaction) deleteDocument(existingDocument datastore.Document, etag string) datastore.DataStoreStatus {
+	documentId := existingDocument["id"].(string)
+	partitionKey := datastore.GetDocumentPartitionKey(t.collection, existingDocument)
+
+	return t.DeleteDocument(partitionKey, documentId, etag)
+}
+
+func (t *documentTransaction) replaceDocument(existingDocument datastore.Document, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	documentId := existingDocument["id"].(string)
+	partitionKey := datastore.GetDocumentPartitionKey(t.collection, existingDocument)
+
+	if t.partitionKey != nil && !datastore.PartitionKeysEqual(t.partitionKey, datastore.GetDocumentPartitionKey(t.collection, document)) {
+		return datastore.Document{}, datastore.PartitionKeyMismatch
+	}
+
+	return t.ReplaceDocument(partitionKey, documentId, document, etag)
+}
+
+// upsertDocument replaces the document with the same id and partition key or creates it,
+// the data store makes the decision so that concurrent writes cannot interleave
+func (t *documentTransaction) upsertDocument(document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	return t.UpsertDocument(t.partitionKey, document, etag)
+}
+
+// commitDocument commits the transaction when the document was written successfully
+func (t *documentTransaction) commitDocument(document datastore.Document, status datastore.DataStoreStatus) (datastore.Document, datastore.DataStoreStatus) {
+	if status != datastore.StatusOk {
+		return document, status
+	}
+
+	if status := t.Commit(); status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	return document, datastore.StatusOk
+}
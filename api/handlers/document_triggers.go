@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+type documentWrite func(transaction *documentTransaction, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus)
+
+// documentRead returns the document that is going to be written, it runs inside the transaction
+// so that documents derived from the stored version cannot miss a concurrent write. Deletes have no document
+type documentRead func(transaction *documentTransaction) (map[string]interface{}, datastore.DataStoreStatus, *scriptError)
+
+// requestDocument is the documentRead of writes that store the document sent in the request
+func requestDocument(document map[string]interface{}) documentRead {
+	return func(*documentTransaction) (map[string]interface{}, datastore.DataStoreStatus, *scriptError) {
+		return document, datastore.StatusOk, nil
+	}
+}
+
+// executeDocumentWrite reads the document, runs the pre-triggers requested in the headers, then the write and then
+// the post-triggers, all of them in one data store transaction that is only committed when every step succeeds
+func (h *Handlers) executeDocumentWrite(
+	c *gin.Context,
+	databaseId string,
+	collectionId string,
+	partitionKey []interface{},
+	operation datastore.TriggerOperation,
+	read documentRead,
+	write documentWrite,
+) (datastore.Document, datastore.DataStoreStatus, *scriptError) {
+	preTriggers, scriptErr := h.getRequestedTriggers(c, databaseId, collectionId, headers.PreTriggerInclude, datastore.Pre, operation)
+	if scriptErr != nil {
+		return nil, datastore.StatusOk, scriptErr
+	}
+
+	postTriggers, scriptErr := h.getRequestedTriggers(c, databaseId, collectionId, headers.PostTriggerInclude, datastore.Post, operation)
+	if scriptErr != nil {
+		return nil, datastore.StatusOk, scriptErr
+	}
+
+	collection, status := h.dataStore.GetCollection(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil, status, nil
+	}
+
+	if len(preTriggers) == 0 && len(postTriggers) == 0 {
+		transaction, status := h.beginDocumentTransaction(databaseId, collection, partitionKey)
+		if status != datastore.StatusOk {
+			return nil, status, nil
+		}
+		defer transaction.Rollback()
+
+		document, status, scriptErr := read(transaction)
+		if status != datastore.StatusOk || scriptErr != nil {
+			return nil, status, scriptErr
+		}
+
+		writtenDocument, status := transaction.commitDocument(write(transaction, document))
+		return writtenDocument, status, nil
+	}
+
+	udfs, status := h.dataStore.GetAllUserDefinedFunctions(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil, status, nil
+	}
+
+	transaction, status := h.beginDocumentTransaction(databaseId, collection, partitionKey)
+	if status != datastore.StatusOk {
+		return nil, status, nil
+	}
+	defer transaction.Rollback()
+
+	document, status, scriptErr := read(transaction)
+	if status != datastore.StatusOk || scriptErr != nil {
+		return nil, status, scriptErr
+	}
+
+	script := h.newScriptContext(transaction, udfs)
+	script.operationType = operation
+	if document != nil {
+		script.requestBody = document
+	}
+
+	for _, trigger := range preTriggers {
+		if scriptErr := script.run(trigger.ID, trigger.Body, nil); scriptErr != nil {
+			return nil, datastore.StatusOk, scriptErr
+		}
+	}
+
+	if document != nil {
+		updatedDocument, ok := script.requestBody.(map[string]interface{})
+		if !ok {
+			return nil, datastore.StatusOk, &scriptError{
+				statusCode: http.StatusBadRequest,
+				message:    "The request body set by a pre-trigger must be an object.",
+			}
+		}
+		document = updatedDocument
+	}
+
+	writtenDocument, status := write(transaction, document)
+	if status != datastore.StatusOk {
+		return writtenDocument, status, nil
+	}
+
+	script.responseBody = map[string]interface{}(writtenDocument)
+	for _, trigger := range postTriggers {
+		if scriptErr := script.run(trigger.ID, trigger.Body, nil); scriptErr != nil {
+			return nil, datastore.StatusOk, scriptErr
+		}
+	}
+
+	if status := transaction.Commit(); status != datastore.StatusOk {
+		return nil, status, nil
+	}
+
+	if responseDocument, ok := script.responseBody.(map[string]interface{}); ok {
+		writtenDocument = responseDocument
+	}
+
+	return writtenDocument, status, nil
+}
+
+// getRequestedTriggers loads the comma separated triggers listed in the header and
+// checks that they can be executed for the operation
+func (h *Handlers) getRequestedTriggers(
+	c *gin.Context,
+	databaseId string,
+	collectionId string,
+	header string,
+	triggerType datastore.TriggerType,
+	operation datastore.TriggerOperation,
+) ([]datastore.Trigger, *scriptError) {
+	headerValue := c.GetHeader(header)
+	if headerValue == "" {
+		return nil, nil
+	}
+
+	triggers := make([]datastore.Trigger, 0)
+	for _, triggerId := range strings.Split(headerValue, ",") {
+		triggerId = strings.TrimSpace(triggerId)
+		if triggerId == "" {
+			continue
+		}
+
+		trigger, status := h.dataStore.GetTrigger(databaseId, collectionId, triggerId)
+		if status == datastore.StatusNotFound {
+			return nil, &scriptError{
+				statusCode: http.StatusNotFound,
+				message:    fmt.Sprintf("Trigger '%s' does not exist in the system.", triggerId),
+			}
+		}
+
+		if status != datastore.StatusOk {
+			return nil, &scriptError{statusCode: http.StatusInternalServerError, message: "Unknown error"}
+		}
+
+		if trigger.TriggerType != triggerType {
+			return nil, &scriptError{
+				statusCode: http.StatusBadRequest,
+				message:    fmt.Sprintf("Trigger '%s' is a %s-trigger and cannot be used as a %s-trigger.", triggerId, trigger.TriggerType, triggerType),
+			}
+		}
+
+		if trigger.TriggerOperation != datastore.All && trigger.TriggerOperation != operation {
+			return nil, &scriptError{
+				statusCode: http.StatusBadRequest,
+				message:    fmt.Sprintf("Trigger '%s' cannot be used for %s operations.", triggerId, operation),
+			}
+		}
+
+		triggers = append(triggers, trigger)
+	}
+
+	return triggers, nil
+}
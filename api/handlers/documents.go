@@ -1,12 +1,12 @@
 package handlers
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
+	"unicode/utf8"
 
-	jsonpatch "github.com/cosmiumdev/json-patch/v5"
 	"github.com/gin-gonic/gin"
 	apimodels "github.com/pikami/cosmium/api/api_models"
 	"github.com/pikami/cosmium/api/headers"
@@ -24,10 +24,26 @@ func (h *Handlers) GetAllDocuments(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
 
-	documents, status := h.dataStore.GetAllDocuments(databaseId, collectionId)
+	partitionKeyRange, ok := h.requestPartitionKeyRange(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	dataStore, ok := h.readDataStore(c, databaseId, collectionId, nil)
+	if !ok {
+		return
+	}
+
+	documents, status := dataStore.GetAllDocuments(databaseId, collectionId)
 	if status == datastore.StatusOk {
 		collection, _ := h.dataStore.GetCollection(databaseId, collectionId)
 
+		if partitionKeyRange != nil {
+			documents = slices.DeleteFunc(documents, func(document datastore.Document) bool {
+				return !documentInPartitionKeyRange(collection, *partitionKeyRange, document)
+			})
+		}
+
 		c.Header(headers.ItemCount, fmt.Sprintf("%d", len(documents)))
 		c.IndentedJSON(http.StatusOK, gin.H{
 			"_rid":      collection.ID,
@@ -45,11 +61,24 @@ func (h *Handlers) GetDocument(c *gin.Context) {
 	collectionId := c.Param("collId")
 	documentId := c.Param("docId")
 
-	document, status := h.dataStore.GetDocument(databaseId, collectionId, documentId)
+	partitionKey, ok := h.requestPointPartitionKey(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	dataStore, ok := h.readDataStore(c, databaseId, collectionId, partitionKey)
+	if !ok {
+		return
+	}
+
+	document, status := dataStore.GetDocument(databaseId, collectionId, partitionKey, documentId)
 	if status == datastore.StatusOk {
-		if etag, ok := document["_etag"].(string); ok {
-			c.Header(headers.ETag, etag)
+		etag, _ := document["_etag"].(string)
+		if !checkIfNoneMatch(c, etag) {
+			return
 		}
+
+		c.Header(headers.ETag, etag)
 		c.IndentedJSON(http.StatusOK, document)
 		return
 	}
@@ -59,6 +88,11 @@ func (h *Handlers) GetDocument(c *gin.Context) {
 		return
 	}
 
+	if status == datastore.BadRequest {
+		c.IndentedJSON(http.StatusBadRequest, constants.BadRequestResponse)
+		return
+	}
+
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
@@ -67,8 +101,31 @@ func (h *Handlers) DeleteDocument(c *gin.Context) {
 	collectionId := c.Param("collId")
 	documentId := c.Param("docId")
 
-	status := h.dataStore.DeleteDocument(databaseId, collectionId, documentId)
+	partitionKey, ok := h.requestPointPartitionKey(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	ifMatch := requestIfMatch(c)
+
+	var existingDocument datastore.Document
+	_, status, scriptErr := h.executeDocumentWrite(c, databaseId, collectionId, partitionKey, datastore.Delete, requestDocument(nil),
+		func(transaction *documentTransaction, _ map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+			document, status := transaction.getDocument(documentId)
+			if status != datastore.StatusOk {
+				return nil, status
+			}
+
+			existingDocument = document
+			return document, transaction.deleteDocument(document, ifMatch)
+		})
+	if scriptErr != nil {
+		scriptErr.writeResponse(c)
+		return
+	}
+
 	if status == datastore.StatusOk {
+		h.setDocumentSessionHeaders(c, databaseId, collectionId, existingDocument)
 		c.Status(http.StatusNoContent)
 		return
 	}
@@ -78,10 +135,14 @@ func (h *Handlers) DeleteDocument(c *gin.Context) {
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
-// TODO: Maybe move "replace" logic to data store
 func (h *Handlers) ReplaceDocument(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
@@ -93,37 +154,52 @@ func (h *Handlers) ReplaceDocument(c *gin.Context) {
 		return
 	}
 
-	existingDocument, status := h.dataStore.GetDocument(databaseId, collectionId, documentId)
-	if status == datastore.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
-		return
-	}
-	if status != datastore.StatusOk {
-		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+	partitionKey, ok := h.requestPointPartitionKey(c, databaseId, collectionId)
+	if !ok {
 		return
 	}
 
-	if ifMatch := c.GetHeader(headers.IfMatch); ifMatch != "" {
-		if existingDocument["_etag"] != ifMatch {
-			c.Header(headers.ErrorCode, "PreconditionFailed")
-			c.JSON(http.StatusPreconditionFailed, constants.PreconditionFailedResponse)
-			return
-		}
+	ifMatch := requestIfMatch(c)
+
+	createdDocument, status, scriptErr := h.executeDocumentWrite(c, databaseId, collectionId, partitionKey, datastore.Replace, requestDocument(requestBody),
+		func(transaction *documentTransaction, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+			existingDocument, status := transaction.getDocument(documentId)
+			if status != datastore.StatusOk {
+				return nil, status
+			}
+
+			return transaction.replaceDocument(existingDocument, document, ifMatch)
+		})
+	if scriptErr != nil {
+		scriptErr.writeResponse(c)
+		return
 	}
 
-	status = h.dataStore.DeleteDocument(databaseId, collectionId, documentId)
 	if status == datastore.StatusNotFound {
 		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
 		return
 	}
 
-	createdDocument, status := h.dataStore.CreateDocument(databaseId, collectionId, requestBody)
 	if status == datastore.Conflict {
 		c.IndentedJSON(http.StatusConflict, constants.ConflictResponse)
 		return
 	}
 
+	if status == datastore.PartitionKeyMismatch {
+		writePartitionKeyMismatchResponse(c)
+		return
+	}
+
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	if status == datastore.StatusOk {
+		if etag, ok := createdDocument["_etag"].(string); ok {
+			c.Header(headers.ETag, etag)
+		}
+		h.setDocumentSessionHeaders(c, databaseId, collectionId, createdDocument)
 		c.IndentedJSON(http.StatusCreated, createdDocument)
 		return
 	}
@@ -136,9 +212,8 @@ func (h *Handlers) PatchDocument(c *gin.Context) {
 	collectionId := c.Param("collId")
 	documentId := c.Param("docId")
 
-	document, status := h.dataStore.GetDocument(databaseId, collectionId, documentId)
-	if status == datastore.StatusNotFound {
-		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+	partitionKey, ok := h.requestPointPartitionKey(c, databaseId, collectionId)
+	if !ok {
 		return
 	}
 
@@ -148,59 +223,84 @@ func (h *Handlers) PatchDocument(c *gin.Context) {
 		return
 	}
 
-	operations := requestBody["operations"]
-	operationsBytes, err := json.Marshal(operations)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": "Could not decode operations"})
+	collection, status := h.dataStore.GetCollection(databaseId, collectionId)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
 		return
 	}
-
-	patch, err := jsonpatch.DecodePatch(operationsBytes)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 		return
 	}
 
-	currentDocumentBytes, err := json.Marshal(document)
-	if err != nil {
-		logger.ErrorLn("Failed to marshal existing document:", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to marshal existing document"})
+	udfs, status := h.dataStore.GetAllUserDefinedFunctions(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 		return
 	}
 
-	modifiedDocumentBytes, err := patch.Apply(currentDocumentBytes)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
-		return
-	}
+	ifMatch := requestIfMatch(c)
 
-	var modifiedDocument map[string]interface{}
-	err = json.Unmarshal(modifiedDocumentBytes, &modifiedDocument)
-	if err != nil {
-		logger.ErrorLn("Failed to unmarshal modified document:", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"message": "Failed to unmarshal modified document"})
-		return
-	}
+	// The patch is applied to the document read in the transaction, so that a concurrent write
+	// cannot be overwritten with a patched copy of the version it replaced
+	var existingDocument datastore.Document
+	createdDocument, status, scriptErr := h.executeDocumentWrite(c, databaseId, collectionId, partitionKey, datastore.Replace,
+		func(transaction *documentTransaction) (map[string]interface{}, datastore.DataStoreStatus, *scriptError) {
+			document, status := transaction.getDocument(documentId)
+			if status != datastore.StatusOk {
+				return nil, status, nil
+			}
+
+			if ifMatch != "" && document["_etag"] != ifMatch {
+				return nil, datastore.PreconditionFailed, nil
+			}
 
-	if modifiedDocument["id"] != document["id"] {
-		c.JSON(http.StatusUnprocessableEntity, gin.H{"message": "The ID field cannot be modified"})
+			modifiedDocument, statusCode, err := h.applyPatch(collection, udfs, document, requestBody)
+			if statusCode == http.StatusPreconditionFailed {
+				return nil, datastore.PreconditionFailed, nil
+			}
+			if err != nil {
+				return nil, datastore.StatusOk, &scriptError{statusCode: statusCode, message: err.Error()}
+			}
+
+			existingDocument = document
+			return modifiedDocument, datastore.StatusOk, nil
+		},
+		func(transaction *documentTransaction, modifiedDocument map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+			etag, _ := existingDocument["_etag"].(string)
+			return transaction.replaceDocument(existingDocument, modifiedDocument, etag)
+		})
+	if scriptErr != nil {
+		scriptErr.writeResponse(c)
 		return
 	}
 
-	status = h.dataStore.DeleteDocument(databaseId, collectionId, documentId)
 	if status == datastore.StatusNotFound {
 		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
 		return
 	}
 
-	createdDocument, status := h.dataStore.CreateDocument(databaseId, collectionId, modifiedDocument)
 	if status == datastore.Conflict {
 		c.IndentedJSON(http.StatusConflict, constants.ConflictResponse)
 		return
 	}
 
+	if status == datastore.PartitionKeyMismatch {
+		writePartitionKeyMismatchResponse(c)
+		return
+	}
+
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	if status == datastore.StatusOk {
-		c.IndentedJSON(http.StatusCreated, createdDocument)
+		if etag, ok := createdDocument["_etag"].(string); ok {
+			c.Header(headers.ETag, etag)
+		}
+		h.setDocumentSessionHeaders(c, databaseId, collectionId, createdDocument)
+		c.IndentedJSON(http.StatusOK, createdDocument)
 		return
 	}
 
@@ -218,6 +318,13 @@ func (h *Handlers) DocumentsPost(c *gin.Context) {
 		return
 	}
 
+	// Handle delete all items by partition key requests
+	isPartitionKeyDelete, _ := strconv.ParseBool(c.GetHeader(headers.IsPartitionKeyDelete))
+	if isPartitionKeyDelete {
+		h.handlePartitionKeyDeleteRequest(c)
+		return
+	}
+
 	var requestBody map[string]interface{}
 	if err := c.BindJSON(&requestBody); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
@@ -227,7 +334,7 @@ func (h *Handlers) DocumentsPost(c *gin.Context) {
 	// Handle query plan requests
 	isQueryPlanRequest, _ := strconv.ParseBool(c.GetHeader(headers.IsQueryPlanRequest))
 	if isQueryPlanRequest {
-		c.IndentedJSON(http.StatusOK, constants.QueryPlanResponse)
+		h.handleQueryPlanRequest(c, requestBody)
 		return
 	}
 
@@ -244,18 +351,53 @@ func (h *Handlers) DocumentsPost(c *gin.Context) {
 		return
 	}
 
+	partitionKey, ok := h.requestPointPartitionKey(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	operation := datastore.Create
 	isUpsert, _ := strconv.ParseBool(c.GetHeader(headers.IsUpsert))
-	if isUpsert {
-		h.dataStore.DeleteDocument(databaseId, collectionId, requestBody["id"].(string))
+	if documentId, ok := requestBody["id"].(string); ok && isUpsert {
+		collection, _ := h.dataStore.GetCollection(databaseId, collectionId)
+		documentPartitionKey := datastore.GetDocumentPartitionKey(collection, requestBody)
+		if _, status := h.dataStore.GetDocument(databaseId, collectionId, documentPartitionKey, documentId); status == datastore.StatusOk {
+			operation = datastore.Replace
+		}
+	}
+
+	createdDocument, status, scriptErr := h.executeDocumentWrite(c, databaseId, collectionId, partitionKey, operation, requestDocument(requestBody),
+		func(transaction *documentTransaction, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+			if isUpsert {
+				return transaction.upsertDocument(document, requestIfMatch(c))
+			}
+			return transaction.createDocument(document)
+		})
+	if scriptErr != nil {
+		scriptErr.writeResponse(c)
+		return
 	}
 
-	createdDocument, status := h.dataStore.CreateDocument(databaseId, collectionId, requestBody)
 	if status == datastore.Conflict {
 		c.IndentedJSON(http.StatusConflict, constants.ConflictResponse)
 		return
 	}
 
+	if status == datastore.PartitionKeyMismatch {
+		writePartitionKeyMismatchResponse(c)
+		return
+	}
+
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	if status == datastore.StatusOk {
+		if etag, ok := createdDocument["_etag"].(string); ok {
+			c.Header(headers.ETag, etag)
+		}
+		h.setDocumentSessionHeaders(c, databaseId, collectionId, createdDocument)
 		c.IndentedJSON(http.StatusCreated, createdDocument)
 		return
 	}
@@ -295,6 +437,11 @@ func (h *Handlers) handleDocumentQuery(c *gin.Context, requestBody map[string]in
 		return
 	}
 
+	partitionKeyRange, ok := h.requestPartitionKeyRange(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
 	continuationToken := continuationtoken.GenerateDefault(collection.ResourceID)
 	continuationTokenHeader := c.GetHeader(headers.ContinuationToken)
 	if continuationTokenHeader != "" {
@@ -306,20 +453,61 @@ func (h *Handlers) handleDocumentQuery(c *gin.Context, requestBody map[string]in
 		pageMaxItemCount = 1000
 	}
 
-	queryText := requestBody["query"].(string)
-	executeQueryResult, status := h.executeQueryDocuments(
-		databaseId, collectionId, queryText, queryParameters, pageMaxItemCount, continuationToken.Token.TotalResults)
+	partitionKey, ok := requestPartitionKey(c)
+	if !ok {
+		return
+	}
+
+	dataStore, ok := h.readDataStore(c, databaseId, collectionId, partitionKey)
+	if !ok {
+		return
+	}
+
+	allDocumentsIterator, status := dataStore.GetDocumentIterator(databaseId, collectionId)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return
+	}
 	if status != datastore.StatusOk {
-		// TODO: Currently we return everything if the query fails
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+	defer allDocumentsIterator.Close()
+
+	udfs, status := dataStore.GetAllUserDefinedFunctions(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+
+	queryText := requestBody["query"].(string)
+	executeQueryResult, status, queryErrors := h.executeQueryDocuments(
+		allDocumentsIterator, collection, udfs, queryText, queryParameters, partitionKey, partitionKeyRange, pageMaxItemCount, continuationToken.Token.TotalResults)
+	if len(queryErrors) > 0 {
 		logger.Infof("Query failed: %s", queryText)
-		h.GetAllDocuments(c)
+		c.IndentedJSON(http.StatusBadRequest, queryErrorResponse(queryErrors))
+		return
+	}
+
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return
+	}
+
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 		return
 	}
 
 	resultCount := len(executeQueryResult.Rows)
 	if executeQueryResult.HasMorePages {
-		nextContinuationToken := continuationtoken.Generate(
-			collection.ResourceID, continuationToken.Token.PageIndex+1, continuationToken.Token.TotalResults+resultCount)
+		minRange, maxRange := datastore.MinEffectivePartitionKey, datastore.MaxEffectivePartitionKey
+		if partitionKeyRange != nil {
+			minRange, maxRange = partitionKeyRange.MinInclusive, partitionKeyRange.MaxExclusive
+		}
+
+		nextContinuationToken := continuationtoken.GenerateForRange(
+			collection.ResourceID, continuationToken.Token.PageIndex+1, continuationToken.Token.TotalResults+resultCount, minRange, maxRange)
 		c.Header(headers.ContinuationToken, nextContinuationToken.ToString())
 	}
 
@@ -331,87 +519,41 @@ func (h *Handlers) handleDocumentQuery(c *gin.Context, requestBody map[string]in
 	})
 }
 
-func (h *Handlers) handleBatchRequest(c *gin.Context) {
+// handlePartitionKeyDeleteRequest removes all documents of the logical partition given in the partition key header,
+// a prefix of a hierarchical partition key removes all partitions below it
+func (h *Handlers) handlePartitionKeyDeleteRequest(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
 
-	batchOperations := make([]apimodels.BatchOperation, 0)
-	if err := c.BindJSON(&batchOperations); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"message": err.Error()})
+	partitionKey, ok := requestPartitionKey(c)
+	if !ok {
 		return
 	}
 
-	batchOperationResults := make([]apimodels.BatchOperationResult, len(batchOperations))
-	for idx, operation := range batchOperations {
-		switch operation.OperationType {
-		case apimodels.BatchOperationTypeCreate:
-			createdDocument, status := h.dataStore.CreateDocument(databaseId, collectionId, operation.ResourceBody)
-			responseCode := dataStoreStatusToResponseCode(status)
-			if status == datastore.StatusOk {
-				responseCode = http.StatusCreated
-			}
-			batchOperationResults[idx] = apimodels.BatchOperationResult{
-				StatusCode:   responseCode,
-				ResourceBody: createdDocument,
-			}
-		case apimodels.BatchOperationTypeDelete:
-			status := h.dataStore.DeleteDocument(databaseId, collectionId, operation.Id)
-			responseCode := dataStoreStatusToResponseCode(status)
-			if status == datastore.StatusOk {
-				responseCode = http.StatusNoContent
-			}
-			batchOperationResults[idx] = apimodels.BatchOperationResult{
-				StatusCode: responseCode,
-			}
-		case apimodels.BatchOperationTypeReplace:
-			deleteStatus := h.dataStore.DeleteDocument(databaseId, collectionId, operation.Id)
-			if deleteStatus == datastore.StatusNotFound {
-				batchOperationResults[idx] = apimodels.BatchOperationResult{
-					StatusCode: http.StatusNotFound,
-				}
-				continue
-			}
-			createdDocument, createStatus := h.dataStore.CreateDocument(databaseId, collectionId, operation.ResourceBody)
-			responseCode := dataStoreStatusToResponseCode(createStatus)
-			if createStatus == datastore.StatusOk {
-				responseCode = http.StatusCreated
-			}
-			batchOperationResults[idx] = apimodels.BatchOperationResult{
-				StatusCode:   responseCode,
-				ResourceBody: createdDocument,
-			}
-		case apimodels.BatchOperationTypeUpsert:
-			documentId := operation.ResourceBody["id"].(string)
-			h.dataStore.DeleteDocument(databaseId, collectionId, documentId)
-			createdDocument, createStatus := h.dataStore.CreateDocument(databaseId, collectionId, operation.ResourceBody)
-			responseCode := dataStoreStatusToResponseCode(createStatus)
-			if createStatus == datastore.StatusOk {
-				responseCode = http.StatusCreated
-			}
-			batchOperationResults[idx] = apimodels.BatchOperationResult{
-				StatusCode:   responseCode,
-				ResourceBody: createdDocument,
-			}
-		case apimodels.BatchOperationTypeRead:
-			document, status := h.dataStore.GetDocument(databaseId, collectionId, operation.Id)
-			batchOperationResults[idx] = apimodels.BatchOperationResult{
-				StatusCode:   dataStoreStatusToResponseCode(status),
-				ResourceBody: document,
-			}
-		case apimodels.BatchOperationTypePatch:
-			batchOperationResults[idx] = apimodels.BatchOperationResult{
-				StatusCode: http.StatusNotImplemented,
-				Message:    "Patch operation is not implemented",
-			}
-		default:
-			batchOperationResults[idx] = apimodels.BatchOperationResult{
-				StatusCode: http.StatusBadRequest,
-				Message:    "Unknown operation type",
-			}
-		}
+	if partitionKey == nil {
+		c.IndentedJSON(http.StatusBadRequest, constants.BadRequestResponse)
+		return
+	}
+
+	if collection, status := h.dataStore.GetCollection(databaseId, collectionId); status == datastore.StatusOk && len(partitionKey) > len(collection.PartitionKey.Paths) {
+		c.Header(headers.SubStatus, "1001")
+		c.IndentedJSON(http.StatusBadRequest, constants.InvalidPartitionKeyResponse)
+		return
+	}
+
+	status := h.dataStore.DeleteDocumentsByPartitionKey(databaseId, collectionId, partitionKey)
+	if status == datastore.StatusOk {
+		h.setSessionHeaders(c, databaseId, collectionId, partitionKey)
+		c.Status(http.StatusOK)
+		return
 	}
 
-	c.JSON(http.StatusOK, batchOperationResults)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return
+	}
+
+	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
 func dataStoreStatusToResponseCode(status datastore.DataStoreStatus) int {
@@ -422,39 +564,73 @@ func dataStoreStatusToResponseCode(status datastore.DataStoreStatus) int {
 		return http.StatusNotFound
 	case datastore.Conflict:
 		return http.StatusConflict
-	case datastore.BadRequest:
+	case datastore.BadRequest, datastore.PartitionKeyMismatch:
 		return http.StatusBadRequest
+	case datastore.PreconditionFailed:
+		return http.StatusPreconditionFailed
 	default:
 		return http.StatusInternalServerError
 	}
 }
 
 func (h *Handlers) executeQueryDocuments(
-	databaseId string,
-	collectionId string,
+	allDocumentsIterator datastore.DocumentIterator,
+	collection datastore.Collection,
+	udfs []datastore.UserDefinedFunction,
 	query string,
 	queryParameters map[string]interface{},
+	partitionKey []interface{},
+	partitionKeyRange *datastore.PartitionKeyRange,
 	pageMaxItemCount int,
 	pageCursor int,
-) (memoryexecutor.ExecuteQueryResult, datastore.DataStoreStatus) {
+) (memoryexecutor.ExecuteQueryResult, datastore.DataStoreStatus, []apimodels.QueryError) {
 	parsedQuery, err := nosql.Parse("", []byte(query))
 	if err != nil {
 		logger.Errorf("Failed to parse query: %s\nerr: %v", query, err)
-		return memoryexecutor.ExecuteQueryResult{}, datastore.BadRequest
+		syntaxError := nosql.GetSyntaxError(query, err)
+		return memoryexecutor.ExecuteQueryResult{}, datastore.BadRequest, []apimodels.QueryError{
+			newQueryError(queryErrorCodeSyntax, syntaxError.Message, syntaxError.Start, syntaxError.End),
+		}
 	}
 
-	allDocumentsIterator, status := h.dataStore.GetDocumentIterator(databaseId, collectionId)
-	if status != datastore.StatusOk {
-		return memoryexecutor.ExecuteQueryResult{}, status
+	typedQuery, ok := parsedQuery.(parsers.SelectStmt)
+	if !ok {
+		return memoryexecutor.ExecuteQueryResult{}, datastore.BadRequest, []apimodels.QueryError{
+			newQueryError(queryErrorCodeSyntax, "Only SELECT queries are supported.", 0, utf8.RuneCountInString(query)),
+		}
+	}
+
+	if partitionKey != nil {
+		allDocumentsIterator = &partitionKeyFilterIterator{
+			documents:    allDocumentsIterator,
+			collection:   collection,
+			partitionKey: partitionKey,
+		}
+	}
+
+	if partitionKeyRange != nil {
+		allDocumentsIterator = &partitionKeyRangeFilterIterator{
+			documents:         allDocumentsIterator,
+			collection:        collection,
+			partitionKeyRange: *partitionKeyRange,
+		}
 	}
-	defer allDocumentsIterator.Close()
 
 	rowsIterator := converters.NewDocumentToRowTypeIterator(allDocumentsIterator)
 
-	if typedQuery, ok := parsedQuery.(parsers.SelectStmt); ok {
-		typedQuery.Parameters = queryParameters
-		return memoryexecutor.ExecuteQuery(typedQuery, rowsIterator, pageCursor, pageMaxItemCount), datastore.StatusOk
+	typedQuery.Parameters = queryParameters
+	typedQuery.Udfs = make(map[string]string, len(udfs))
+	for _, udf := range udfs {
+		typedQuery.Udfs[udf.ID] = udf.Body
+	}
+	typedQuery.ComputedProperties = parseComputedProperties(collection.ComputedProperties)
+	result := memoryexecutor.ExecuteQuery(typedQuery, rowsIterator, pageCursor, pageMaxItemCount)
+	if result.Error != nil {
+		logger.Errorf("Failed to execute query: %s\nerr: %v", query, result.Error)
+		return result, datastore.BadRequest, []apimodels.QueryError{
+			newQueryError(queryErrorCodeSemantic, result.Error.Error(), 0, utf8.RuneCountInString(query)),
+		}
 	}
 
-	return memoryexecutor.ExecuteQueryResult{}, datastore.BadRequest
+	return result, datastore.StatusOk, nil
 }
@@ -3,16 +3,31 @@ package handlers
 import (
 	"github.com/pikami/cosmium/api/config"
 	"github.com/pikami/cosmium/internal/datastore"
+	replicadatastore "github.com/pikami/cosmium/internal/datastore/replica_datastore"
 )
 
 type Handlers struct {
 	dataStore datastore.DataStore
 	config    *config.ServerConfig
+
+	// replica serves reads weaker than Strong when replication lag is simulated, nil otherwise
+	replica *replicadatastore.ReplicaDataStore
 }
 
 func NewHandlers(dataStore datastore.DataStore, config *config.ServerConfig) *Handlers {
-	return &Handlers{
+	handlers := &Handlers{
 		dataStore: dataStore,
 		config:    config,
 	}
+
+	if config.ReplicationLag > 0 {
+		handlers.replica = replicadatastore.NewReplicaDataStore(dataStore, replicadatastore.ReplicaDataStoreOptions{
+			ReplicationLag:       config.ReplicationLag,
+			MaxStalenessPrefix:   config.MaxStalenessPrefix,
+			MaxStalenessInterval: config.MaxStalenessInterval,
+		})
+		handlers.dataStore = handlers.replica
+	}
+
+	return handlers
 }
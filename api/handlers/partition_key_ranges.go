@@ -22,14 +22,14 @@ func (h *Handlers) GetPartitionKeyRanges(c *gin.Context) {
 
 	partitionKeyRanges, status := h.dataStore.GetPartitionKeyRanges(databaseId, collectionId)
 	if status == datastore.StatusOk {
-		c.Header(headers.ETag, "\"420\"")
-		c.Header(headers.LSN, "420")
-		c.Header(headers.CosmosLsn, "420")
-		c.Header(headers.GlobalCommittedLsn, "420")
+		collection, _ := h.dataStore.GetCollection(databaseId, collectionId)
+
+		// Partition key ranges never change, the etag of the collection identifies them
+		c.Header(headers.ETag, collection.ETag)
+		writeSessionHeaders(c, partitionKeyRanges)
 		c.Header(headers.ItemCount, fmt.Sprintf("%d", len(partitionKeyRanges)))
 
 		collectionRid := collectionId
-		collection, _ := h.dataStore.GetCollection(databaseId, collectionId)
 		if collection.ResourceID != "" {
 			collectionRid = collection.ResourceID
 		}
@@ -50,3 +50,62 @@ func (h *Handlers) GetPartitionKeyRanges(c *gin.Context) {
 
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
+
+// requestPartitionKeyRange returns the partition key range the request is scoped to,
+// writes a Gone response when the range does not exist
+func (h *Handlers) requestPartitionKeyRange(c *gin.Context, databaseId string, collectionId string) (*datastore.PartitionKeyRange, bool) {
+	partitionKeyRangeId := c.GetHeader(headers.PartitionKeyRangeId)
+	if partitionKeyRangeId == "" {
+		return nil, true
+	}
+
+	partitionKeyRanges, status := h.dataStore.GetPartitionKeyRanges(databaseId, collectionId)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return nil, false
+	}
+
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return nil, false
+	}
+
+	for _, partitionKeyRange := range partitionKeyRanges {
+		if partitionKeyRange.ID == partitionKeyRangeId {
+			return &partitionKeyRange, true
+		}
+	}
+
+	c.Header(headers.SubStatus, "1002")
+	c.IndentedJSON(http.StatusGone, constants.PartitionKeyRangeGoneResponse)
+	return nil, false
+}
+
+// partitionKeyRangeFilterIterator skips documents that are hashed outside of the given range
+type partitionKeyRangeFilterIterator struct {
+	documents         datastore.DocumentIterator
+	collection        datastore.Collection
+	partitionKeyRange datastore.PartitionKeyRange
+}
+
+func (i *partitionKeyRangeFilterIterator) Next() (datastore.Document, datastore.DataStoreStatus) {
+	for {
+		document, status := i.documents.Next()
+		if status != datastore.StatusOk {
+			return document, status
+		}
+
+		if documentInPartitionKeyRange(i.collection, i.partitionKeyRange, document) {
+			return document, status
+		}
+	}
+}
+
+func (i *partitionKeyRangeFilterIterator) Close() {
+	i.documents.Close()
+}
+
+func documentInPartitionKeyRange(collection datastore.Collection, partitionKeyRange datastore.PartitionKeyRange, document datastore.Document) bool {
+	partitionKey := datastore.GetDocumentPartitionKey(collection, document)
+	return partitionKeyRange.Contains(datastore.GetEffectivePartitionKey(collection.PartitionKey, partitionKey))
+}
@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/constants"
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+// parsePartitionKeyHeader parses the value of the partition key header (e.g. `["value"]`),
+// returns nil when the request is not scoped to a partition
+func parsePartitionKeyHeader(value string) ([]interface{}, bool) {
+	if value == "" {
+		return nil, true
+	}
+
+	var partitionKey []interface{}
+	if err := json.Unmarshal([]byte(value), &partitionKey); err != nil {
+		return nil, false
+	}
+
+	if len(partitionKey) == 0 {
+		return nil, true
+	}
+
+	return partitionKey, true
+}
+
+// requestPartitionKey parses the partition key header of the request,
+// writes a BadRequest response when the header is malformed
+func requestPartitionKey(c *gin.Context) ([]interface{}, bool) {
+	partitionKey, ok := parsePartitionKeyHeader(c.GetHeader(headers.PartitionKey))
+	if !ok {
+		c.IndentedJSON(http.StatusBadRequest, constants.BadRequestResponse)
+		return nil, false
+	}
+
+	return partitionKey, true
+}
+
+// requestPointPartitionKey parses the partition key header of a point operation,
+// unlike queries, point operations have to specify a value for every partition key path
+func (h *Handlers) requestPointPartitionKey(c *gin.Context, databaseId string, collectionId string) ([]interface{}, bool) {
+	partitionKey, ok := requestPartitionKey(c)
+	if !ok || partitionKey == nil {
+		return partitionKey, ok
+	}
+
+	collection, status := h.dataStore.GetCollection(databaseId, collectionId)
+	if status == datastore.StatusOk && len(partitionKey) != len(collection.PartitionKey.Paths) {
+		c.Header(headers.SubStatus, "1001")
+		c.IndentedJSON(http.StatusBadRequest, constants.InvalidPartitionKeyResponse)
+		return nil, false
+	}
+
+	return partitionKey, true
+}
+
+const partitionKeyMismatchMessage = "PartitionKey extracted from document doesn't match the one specified in the header."
+
+func writePartitionKeyMismatchResponse(c *gin.Context) {
+	c.Header(headers.SubStatus, "1001")
+	c.IndentedJSON(http.StatusBadRequest, constants.PartitionKeyMismatchResponse)
+}
+
+// partitionKeyFilterIterator skips documents that do not belong to the given partition,
+// a prefix of a hierarchical partition key matches all partitions below it
+type partitionKeyFilterIterator struct {
+	documents    datastore.DocumentIterator
+	collection   datastore.Collection
+	partitionKey []interface{}
+}
+
+func (i *partitionKeyFilterIterator) Next() (datastore.Document, datastore.DataStoreStatus) {
+	for {
+		document, status := i.documents.Next()
+		if status != datastore.StatusOk {
+			return document, status
+		}
+
+		if datastore.PartitionKeyHasPrefix(datastore.GetDocumentPartitionKey(i.collection, document), i.partitionKey) {
+			return document, status
+		}
+	}
+}
+
+func (i *partitionKeyFilterIterator) Close() {
+	i.documents.Close()
+}
+
+// Cosmos DB allows up to three levels of hierarchical partition keys
+const maxMultiHashPartitionKeyPaths = 3
+
+// validatePartitionKeyDefinition applies the restrictions Cosmos DB enforces on partition key definitions
+func validatePartitionKeyDefinition(definition datastore.CollectionPartitionKey) error {
+	maxPaths := 1
+	switch definition.Kind {
+	case "", datastore.PartitionKeyKindHash:
+	case datastore.PartitionKeyKindMultiHash:
+		maxPaths = maxMultiHashPartitionKeyPaths
+		if definition.Version == 1 {
+			return fmt.Errorf("Partition key kind '%s' requires partition key version 2.", definition.Kind)
+		}
+		if len(definition.Paths) == 0 {
+			return fmt.Errorf("Partition key kind '%s' requires at least one partition key path.", definition.Kind)
+		}
+	default:
+		return fmt.Errorf("Partition key kind '%s' is not supported.", definition.Kind)
+	}
+
+	if len(definition.Paths) > maxPaths {
+		return fmt.Errorf("Too many partition key paths (%d) specified. A maximum of %d is allowed.", len(definition.Paths), maxPaths)
+	}
+
+	seenPaths := make(map[string]bool, len(definition.Paths))
+	for _, path := range definition.Paths {
+		if len(path) < 2 || !strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") || strings.ContainsAny(path, "*[]") {
+			return fmt.Errorf("Partition key path '%s' is invalid.", path)
+		}
+
+		if seenPaths[path] {
+			return fmt.Errorf("Partition key path '%s' is specified more than once.", path)
+		}
+		seenPaths[path] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/constants"
+)
+
+// anyETag is the wildcard that matches every version of an existing resource
+const anyETag = "*"
+
+// requestIfMatch returns the etag a write is conditioned on, the wildcard is dropped
+// because the write already requires the resource to exist
+func requestIfMatch(c *gin.Context) string {
+	return normalizeIfMatch(c.GetHeader(headers.IfMatch))
+}
+
+func normalizeIfMatch(ifMatch string) string {
+	if ifMatch == anyETag {
+		return ""
+	}
+
+	return ifMatch
+}
+
+// etagMatches checks an If-Match or If-None-Match condition against the current etag of a resource
+func etagMatches(condition string, etag string) bool {
+	return condition == anyETag || condition == etag
+}
+
+// checkIfNoneMatch writes a NotModified response when the If-None-Match header matches the current etag,
+// clients use it to skip downloading a resource they already have
+func checkIfNoneMatch(c *gin.Context, etag string) bool {
+	ifNoneMatch := c.GetHeader(headers.IfNoneMatch)
+	if ifNoneMatch == "" || !etagMatches(ifNoneMatch, etag) {
+		return true
+	}
+
+	c.Header(headers.ETag, etag)
+	c.Status(http.StatusNotModified)
+	return false
+}
+
+func writePreconditionFailedResponse(c *gin.Context) {
+	c.Header(headers.ErrorCode, "PreconditionFailed")
+	c.Header(headers.SubStatus, "0")
+	c.JSON(http.StatusPreconditionFailed, constants.PreconditionFailedResponse)
+}
@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	apimodels "github.com/pikami/cosmium/api/api_models"
+)
+
+const (
+	// SC1xxx codes are reported for syntax errors, SC2xxx for semantic ones
+	queryErrorCodeSyntax   = "SC1001"
+	queryErrorCodeSemantic = "SC2001"
+)
+
+func newQueryError(code string, message string, start int, end int) apimodels.QueryError {
+	return apimodels.QueryError{
+		Severity: "Error",
+		Location: apimodels.QueryErrorLocation{Start: start, End: end},
+		Code:     code,
+		Message:  message,
+	}
+}
+
+// queryErrorResponse builds the body that Cosmos DB returns for invalid queries,
+// the errors list is serialized into the message the same way the real service does it
+func queryErrorResponse(queryErrors []apimodels.QueryError) gin.H {
+	errorsJson, _ := json.Marshal(map[string]interface{}{"errors": queryErrors})
+
+	return gin.H{
+		"code": "BadRequest",
+		"message": fmt.Sprintf(
+			"Message: %s\r\nActivityId: %s, Microsoft.Azure.Documents.Common/2.14.0",
+			errorsJson,
+			uuid.New().String(),
+		),
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	apimodels "github.com/pikami/cosmium/api/api_models"
+	"github.com/pikami/cosmium/internal/logger"
+	queryplan "github.com/pikami/cosmium/internal/query_plan"
+	"github.com/pikami/cosmium/parsers"
+	"github.com/pikami/cosmium/parsers/nosql"
+)
+
+// handleQueryPlanRequest builds the query plan the SDKs request before running a query,
+// it describes how the results of each partition have to be merged
+func (h *Handlers) handleQueryPlanRequest(c *gin.Context, requestBody map[string]interface{}) {
+	queryText, _ := requestBody["query"].(string)
+
+	parsedQuery, err := nosql.Parse("", []byte(queryText))
+	if err != nil {
+		logger.Errorf("Failed to parse query: %s\nerr: %v", queryText, err)
+		syntaxError := nosql.GetSyntaxError(queryText, err)
+		c.IndentedJSON(http.StatusBadRequest, queryErrorResponse([]apimodels.QueryError{
+			newQueryError(queryErrorCodeSyntax, syntaxError.Message, syntaxError.Start, syntaxError.End),
+		}))
+		return
+	}
+
+	typedQuery, ok := parsedQuery.(parsers.SelectStmt)
+	if !ok {
+		c.IndentedJSON(http.StatusBadRequest, queryErrorResponse([]apimodels.QueryError{
+			newQueryError(queryErrorCodeSyntax, "Only SELECT queries are supported.", 0, utf8.RuneCountInString(queryText)),
+		}))
+		return
+	}
+
+	queryPlan, err := queryplan.Build(typedQuery)
+	if err != nil {
+		c.IndentedJSON(http.StatusBadRequest, queryErrorResponse([]apimodels.QueryError{
+			newQueryError(queryErrorCodeSemantic, err.Error(), 0, utf8.RuneCountInString(queryText)),
+		}))
+		return
+	}
+
+	c.IndentedJSON(http.StatusOK, queryPlan)
+}
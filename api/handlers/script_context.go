@@ -0,0 +1,455 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/dop251/goja"
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/pikami/cosmium/internal/jsruntime"
+)
+
+// scriptContext implements the server-side getContext() API that is
+// available to stored procedures and triggers
+type scriptContext struct {
+	handlers      *Handlers
+	vm            *goja.Runtime
+	databaseId    string
+	collectionId  string
+	collection    datastore.Collection
+	partitionKey  []interface{}
+	transaction   *documentTransaction
+	udfs          []datastore.UserDefinedFunction
+	operationType datastore.TriggerOperation
+	requestBody   interface{}
+	responseBody  interface{}
+	logs          []string
+}
+
+type scriptError struct {
+	statusCode int
+	message    string
+}
+
+var scriptErrorCodes = map[string]int{
+	"BadRequest":            http.StatusBadRequest,
+	"Forbidden":             http.StatusForbidden,
+	"NotFound":              http.StatusNotFound,
+	"Conflict":              http.StatusConflict,
+	"PreconditionFailed":    http.StatusPreconditionFailed,
+	"RequestEntityTooLarge": http.StatusRequestEntityTooLarge,
+	"RetryWith":             449,
+	"InternalServerError":   http.StatusInternalServerError,
+}
+
+// newScriptContext creates the context of a script that reads and writes documents through the transaction,
+// the user defined functions are loaded up front as the data store cannot be used while the transaction is open
+func (h *Handlers) newScriptContext(transaction *documentTransaction, udfs []datastore.UserDefinedFunction) *scriptContext {
+	s := &scriptContext{
+		handlers:     h,
+		vm:           jsruntime.New(),
+		databaseId:   transaction.databaseId,
+		collectionId: transaction.collectionId,
+		collection:   transaction.collection,
+		partitionKey: transaction.partitionKey,
+		transaction:  transaction,
+		udfs:         udfs,
+	}
+
+	context := s.vm.NewObject()
+	context.Set("getCollection", s.newCollectionObject)
+	context.Set("getRequest", func() *goja.Object {
+		request := s.newMessageObject(&s.requestBody)
+		request.Set("getOperationType", func() string { return string(s.operationType) })
+		return request
+	})
+	context.Set("getResponse", func() *goja.Object { return s.newMessageObject(&s.responseBody) })
+	s.vm.Set("getContext", func() *goja.Object { return context })
+
+	errorCodes := s.vm.NewObject()
+	for name, code := range scriptErrorCodes {
+		errorCodes.Set(name, code)
+	}
+	s.vm.Set("ErrorCodes", errorCodes)
+
+	console := s.vm.NewObject()
+	console.Set("log", func(call goja.FunctionCall) goja.Value {
+		parts := make([]string, len(call.Arguments))
+		for i, argument := range call.Arguments {
+			parts[i] = argument.String()
+		}
+		s.logs = append(s.logs, strings.Join(parts, " "))
+		return goja.Undefined()
+	})
+	s.vm.Set("console", console)
+
+	return s
+}
+
+// run executes the script body with the given arguments, the caller has to roll back
+// the document changes made by the script if it fails
+func (s *scriptContext) run(name string, body string, arguments []interface{}) *scriptError {
+	function, err := jsruntime.Compile(s.vm, name, body)
+	if err != nil {
+		return &scriptError{statusCode: http.StatusBadRequest, message: jsruntime.ErrorMessage(err)}
+	}
+
+	jsArguments := make([]goja.Value, len(arguments))
+	for i, argument := range arguments {
+		if jsArguments[i], err = jsruntime.ToValue(s.vm, argument); err != nil {
+			return &scriptError{statusCode: http.StatusBadRequest, message: jsruntime.ErrorMessage(err)}
+		}
+	}
+
+	if _, err = jsruntime.Call(s.vm, function, jsruntime.DefaultTimeout, jsArguments...); err != nil {
+		return &scriptError{statusCode: thrownStatusCode(err), message: jsruntime.ErrorMessage(err)}
+	}
+
+	return nil
+}
+
+func (e *scriptError) writeResponse(c *gin.Context) {
+	c.IndentedJSON(e.statusCode, gin.H{
+		"code":    statusCodeName(e.statusCode),
+		"message": e.message,
+	})
+}
+
+// thrownStatusCode returns the status of a failed collection operation that the script did not handle
+func thrownStatusCode(err error) int {
+	if exception, ok := err.(*goja.Exception); ok {
+		if object, ok := exception.Value().Export().(map[string]interface{}); ok {
+			if number, ok := object["number"].(int64); ok && number >= 400 && number < 600 {
+				return int(number)
+			}
+		}
+	}
+
+	return http.StatusBadRequest
+}
+
+func (s *scriptContext) newMessageObject(body *interface{}) *goja.Object {
+	message := s.vm.NewObject()
+	message.Set("getBody", func() goja.Value {
+		value, err := jsruntime.ToValue(s.vm, *body)
+		if err != nil {
+			panic(s.vm.NewGoError(err))
+		}
+		return value
+	})
+	message.Set("setBody", func(value goja.Value) {
+		exported, err := jsruntime.Export(s.vm, value)
+		if err != nil {
+			panic(s.vm.NewGoError(err))
+		}
+		*body = exported
+	})
+	return message
+}
+
+func (s *scriptContext) newCollectionObject() *goja.Object {
+	collection := s.vm.NewObject()
+	collection.Set("getSelfLink", func() string { return s.collection.Self })
+	collection.Set("getAltLink", func() string {
+		return fmt.Sprintf("dbs/%s/colls/%s", s.databaseId, s.collectionId)
+	})
+	collection.Set("createDocument", s.operation(2, s.createDocument))
+	collection.Set("upsertDocument", s.operation(2, s.upsertDocument))
+	collection.Set("readDocument", s.operation(1, s.readDocument))
+	collection.Set("readDocuments", s.operation(1, s.readDocuments))
+	collection.Set("queryDocuments", s.operation(2, s.queryDocuments))
+	collection.Set("replaceDocument", s.operation(2, s.replaceDocument))
+	collection.Set("deleteDocument", s.operation(1, s.deleteDocument))
+	return collection
+}
+
+type scriptOperation func(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError)
+
+// operation wraps a collection operation so that it accepts the optional options and
+// callback arguments, errors are passed to the callback or thrown when there is none
+func (s *scriptContext) operation(argumentCount int, handler scriptOperation) func(goja.FunctionCall) goja.Value {
+	return func(call goja.FunctionCall) goja.Value {
+		arguments := make([]goja.Value, argumentCount)
+		for i := range arguments {
+			arguments[i] = call.Argument(i)
+		}
+
+		options := map[string]interface{}{}
+		var callback goja.Callable
+		for _, argument := range call.Arguments[min(argumentCount, len(call.Arguments)):] {
+			if function, ok := goja.AssertFunction(argument); ok {
+				callback = function
+			} else if object, ok := argument.Export().(map[string]interface{}); ok {
+				options = object
+			}
+		}
+
+		result, opErr := handler(arguments, options)
+		if opErr != nil && callback == nil {
+			panic(s.errorValue(opErr))
+		}
+
+		if callback != nil {
+			errValue := goja.Undefined()
+			if opErr != nil {
+				errValue = s.errorValue(opErr)
+			}
+
+			resultValue, err := jsruntime.ToValue(s.vm, result)
+			if err != nil {
+				panic(s.vm.NewGoError(err))
+			}
+
+			if _, err := callback(goja.Undefined(), errValue, resultValue, s.vm.NewObject()); err != nil {
+				panic(err)
+			}
+		}
+
+		return s.vm.ToValue(true)
+	}
+}
+
+func (s *scriptContext) errorValue(opErr *scriptError) goja.Value {
+	errorObject, _ := s.vm.New(s.vm.Get("Error"), s.vm.ToValue(opErr.message))
+	errorObject.Set("number", opErr.statusCode)
+	errorObject.Set("body", fmt.Sprintf("{\"code\":%q,\"message\":%q}", statusCodeName(opErr.statusCode), opErr.message))
+	return errorObject
+}
+
+func (s *scriptContext) createDocument(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError) {
+	document, opErr := s.exportDocument(arguments[1])
+	if opErr != nil {
+		return nil, opErr
+	}
+
+	if opErr := s.validateNewDocument(document, options); opErr != nil {
+		return nil, opErr
+	}
+
+	return s.toScriptResult(s.transaction.createDocument(document))
+}
+
+func (s *scriptContext) upsertDocument(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError) {
+	document, opErr := s.exportDocument(arguments[1])
+	if opErr != nil {
+		return nil, opErr
+	}
+
+	if opErr := s.validateNewDocument(document, options); opErr != nil {
+		return nil, opErr
+	}
+
+	return s.toScriptResult(s.transaction.upsertDocument(document, scriptEtag(options)))
+}
+
+func (s *scriptContext) readDocument(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError) {
+	document, opErr := s.getDocument(documentIdFromLink(arguments[0].String()))
+	if opErr != nil {
+		return nil, opErr
+	}
+
+	return map[string]interface{}(document), nil
+}
+
+func (s *scriptContext) readDocuments(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError) {
+	return s.query("SELECT * FROM c", nil)
+}
+
+func (s *scriptContext) queryDocuments(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError) {
+	switch query := arguments[1].Export().(type) {
+	case string:
+		return s.query(query, nil)
+	case map[string]interface{}:
+		queryText, _ := query["query"].(string)
+		parameters, _ := query["parameters"].([]interface{})
+		return s.query(queryText, parametersToMap(parameters))
+	}
+
+	return nil, &scriptError{statusCode: http.StatusBadRequest, message: "The query must be a string or an object with a query property."}
+}
+
+func (s *scriptContext) replaceDocument(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError) {
+	existingDocument, opErr := s.getDocument(documentIdFromLink(arguments[0].String()))
+	if opErr != nil {
+		return nil, opErr
+	}
+
+	document, opErr := s.exportDocument(arguments[1])
+	if opErr != nil {
+		return nil, opErr
+	}
+
+	if document["id"] != existingDocument["id"] {
+		return nil, &scriptError{statusCode: http.StatusBadRequest, message: "The id of the document does not match the id in the document link."}
+	}
+
+	if opErr := s.validateNewDocument(document, options); opErr != nil {
+		return nil, opErr
+	}
+
+	return s.toScriptResult(s.transaction.replaceDocument(existingDocument, document, scriptEtag(options)))
+}
+
+func (s *scriptContext) deleteDocument(arguments []goja.Value, options map[string]interface{}) (interface{}, *scriptError) {
+	existingDocument, opErr := s.getDocument(documentIdFromLink(arguments[0].String()))
+	if opErr != nil {
+		return nil, opErr
+	}
+
+	if status := s.transaction.deleteDocument(existingDocument, scriptEtag(options)); status != datastore.StatusOk {
+		return nil, dataStoreStatusToScriptError(status)
+	}
+
+	return nil, nil
+}
+
+func (s *scriptContext) query(queryText string, parameters map[string]interface{}) (interface{}, *scriptError) {
+	documents, status := s.transaction.GetDocumentIterator()
+	if status != datastore.StatusOk {
+		return nil, dataStoreStatusToScriptError(status)
+	}
+	defer documents.Close()
+
+	result, status, queryErrors := s.handlers.executeQueryDocuments(
+		documents, s.collection, s.udfs, queryText, parameters, s.partitionKey, nil, math.MaxInt32, 0)
+	if len(queryErrors) > 0 {
+		return nil, &scriptError{statusCode: http.StatusBadRequest, message: queryErrors[0].Message}
+	}
+
+	if status != datastore.StatusOk {
+		return nil, dataStoreStatusToScriptError(status)
+	}
+
+	rows := make([]interface{}, len(result.Rows))
+	for i, row := range result.Rows {
+		rows[i] = row
+	}
+
+	return rows, nil
+}
+
+// getDocument looks up a document by its id or resource id within the partition of the request
+func (s *scriptContext) getDocument(documentId string) (datastore.Document, *scriptError) {
+	document, status := s.transaction.getDocument(documentId)
+	if status == datastore.StatusNotFound {
+		document, status = s.getDocumentByResourceId(documentId)
+	}
+
+	if status != datastore.StatusOk {
+		return nil, dataStoreStatusToScriptError(status)
+	}
+
+	return document, nil
+}
+
+// getDocumentByResourceId looks up a document by its _rid, only the partition of the request is searched when it is set
+func (s *scriptContext) getDocumentByResourceId(resourceId string) (datastore.Document, datastore.DataStoreStatus) {
+	documents, status := s.transaction.GetDocumentIterator()
+	if status != datastore.StatusOk {
+		return nil, status
+	}
+
+	if s.partitionKey != nil {
+		documents = &partitionKeyFilterIterator{
+			documents:    documents,
+			collection:   s.collection,
+			partitionKey: s.partitionKey,
+		}
+	}
+	defer documents.Close()
+
+	for {
+		document, status := documents.Next()
+		if status != datastore.StatusOk {
+			return nil, datastore.StatusNotFound
+		}
+
+		if document["_rid"] == resourceId {
+			return document, datastore.StatusOk
+		}
+	}
+}
+
+func (s *scriptContext) exportDocument(value goja.Value) (map[string]interface{}, *scriptError) {
+	exported, err := jsruntime.Export(s.vm, value)
+	if err != nil {
+		return nil, &scriptError{statusCode: http.StatusBadRequest, message: err.Error()}
+	}
+
+	document, ok := exported.(map[string]interface{})
+	if !ok {
+		return nil, &scriptError{statusCode: http.StatusBadRequest, message: "The document body must be an object."}
+	}
+
+	return document, nil
+}
+
+func (s *scriptContext) validateNewDocument(document map[string]interface{}, options map[string]interface{}) *scriptError {
+	if _, hasId := document["id"].(string); !hasId && options["disableAutomaticIdGeneration"] == true {
+		return &scriptError{statusCode: http.StatusBadRequest, message: "The input content is invalid because the required properties - 'id; ' - are missing"}
+	}
+
+	return nil
+}
+
+func (s *scriptContext) toScriptResult(document datastore.Document, status datastore.DataStoreStatus) (interface{}, *scriptError) {
+	if status != datastore.StatusOk {
+		return nil, dataStoreStatusToScriptError(status)
+	}
+
+	return map[string]interface{}(document), nil
+}
+
+// scriptEtag returns the etag passed in the operation options, either directly or as an IfMatch access condition
+func scriptEtag(options map[string]interface{}) string {
+	etag, _ := options["etag"].(string)
+	if accessCondition, ok := options["accessCondition"].(map[string]interface{}); ok {
+		if conditionType, _ := accessCondition["type"].(string); strings.EqualFold(conditionType, "IfMatch") {
+			etag, _ = accessCondition["condition"].(string)
+		}
+	}
+
+	return etag
+}
+
+// documentIdFromLink extracts the document id from links like "dbs/db/colls/coll/docs/id"
+func documentIdFromLink(link string) string {
+	parts := strings.Split(strings.Trim(link, "/"), "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if parts[i] == "docs" {
+			return parts[i+1]
+		}
+	}
+
+	return parts[len(parts)-1]
+}
+
+func dataStoreStatusToScriptError(status datastore.DataStoreStatus) *scriptError {
+	switch status {
+	case datastore.StatusNotFound:
+		return &scriptError{statusCode: http.StatusNotFound, message: "Entity with the specified id does not exist in the system."}
+	case datastore.Conflict:
+		return &scriptError{statusCode: http.StatusConflict, message: "Resource with specified id or name already exists."}
+	case datastore.BadRequest:
+		return &scriptError{statusCode: http.StatusBadRequest, message: "The request is invalid."}
+	case datastore.PartitionKeyMismatch:
+		return &scriptError{statusCode: http.StatusBadRequest, message: partitionKeyMismatchMessage}
+	case datastore.PreconditionFailed:
+		return &scriptError{statusCode: http.StatusPreconditionFailed, message: "One of the specified pre-condition is not met."}
+	}
+
+	return &scriptError{statusCode: http.StatusInternalServerError, message: "Unknown error"}
+}
+
+func statusCodeName(statusCode int) string {
+	for name, code := range scriptErrorCodes {
+		if code == statusCode {
+			return name
+		}
+	}
+
+	return strings.ReplaceAll(http.StatusText(statusCode), " ", "")
+}
@@ -36,7 +36,11 @@ func (h *Handlers) GetServerInfo(c *gin.Context) {
 			"minReplicaSetSize": 1,
 			"maxReplicasetSize": 4,
 		},
-		"userConsistencyPolicy":    map[string]interface{}{"defaultConsistencyLevel": "Session"},
+		"userConsistencyPolicy": map[string]interface{}{
+			"defaultConsistencyLevel": h.defaultConsistencyLevel(),
+			"maxStalenessPrefix":      h.config.MaxStalenessPrefix,
+			"maxIntervalInSeconds":    int(h.config.MaxStalenessInterval.Seconds()),
+		},
 		"systemReplicationPolicy":  map[string]interface{}{"minReplicaSetSize": 1, "maxReplicasetSize": 4},
 		"readPolicy":               map[string]interface{}{"primaryReadCoefficient": 1, "secondaryReadCoefficient": 1},
 		"queryEngineConfiguration": "{\"allowNewKeywords\":true,\"maxJoinsPerSqlQuery\":10,\"maxQueryRequestTimeoutFraction\":0.9,\"maxSqlQueryInputLength\":524288,\"maxUdfRefPerSqlQuery\":10,\"queryMaxInMemorySortDocumentCount\":-1000,\"spatialMaxGeometryPointCount\":256,\"sqlAllowNonFiniteNumbers\":false,\"sqlDisableOptimizationFlags\":0,\"enableSpatialIndexing\":true,\"maxInExpressionItemsCount\":2147483647,\"maxLogicalAndPerSqlQuery\":2147483647,\"maxLogicalOrPerSqlQuery\":2147483647,\"maxSpatialQueryCells\":2147483647,\"sqlAllowAggregateFunctions\":true,\"sqlAllowGroupByClause\":true,\"sqlAllowLike\":true,\"sqlAllowSubQuery\":true,\"sqlAllowScalarSubQuery\":true,\"sqlAllowTop\":true}",
@@ -56,28 +60,35 @@ type Address struct {
 func (h *Handlers) GetAddresses(c *gin.Context) {
 	addresses := []Address{}
 
-	if h.config.EnableRntbd {
-		addresses = append(addresses, Address{
-			IsPrimary:                     true,
-			PhyscialUri:                   h.config.RntbdEndpoint,
-			IsAuxiliary:                   false,
-			PartitionTargetReplicaSetSize: 1,
-			Protocol:                      "rntbd",
-			PartitionKeyRangeId:           "0",
-			PartitionIndex:                "0@0",
-		})
+	partitionKeyRangeIds := []string{"0"}
+	if requestedIds := c.Query("$partitionKeyRangeIds"); requestedIds != "" {
+		partitionKeyRangeIds = strings.Split(requestedIds, ",")
 	}
 
-	if !strings.Contains(c.Request.RequestURI, "protocol%20eq%20rntbd") {
-		addresses = append(addresses, Address{
-			IsPrimary:                     true,
-			PhyscialUri:                   h.config.DatabaseEndpoint,
-			IsAuxiliary:                   false,
-			PartitionTargetReplicaSetSize: 1,
-			Protocol:                      "https",
-			PartitionKeyRangeId:           "0",
-			PartitionIndex:                "0@0",
-		})
+	for _, partitionKeyRangeId := range partitionKeyRangeIds {
+		if h.config.EnableRntbd {
+			addresses = append(addresses, Address{
+				IsPrimary:                     true,
+				PhyscialUri:                   h.config.RntbdEndpoint,
+				IsAuxiliary:                   false,
+				PartitionTargetReplicaSetSize: 1,
+				Protocol:                      "rntbd",
+				PartitionKeyRangeId:           partitionKeyRangeId,
+				PartitionIndex:                "0@0",
+			})
+		}
+
+		if !strings.Contains(c.Request.RequestURI, "protocol%20eq%20rntbd") {
+			addresses = append(addresses, Address{
+				IsPrimary:                     true,
+				PhyscialUri:                   h.config.DatabaseEndpoint,
+				IsAuxiliary:                   false,
+				PartitionTargetReplicaSetSize: 1,
+				Protocol:                      "https",
+				PartitionKeyRangeId:           partitionKeyRangeId,
+				PartitionIndex:                "0@0",
+			})
+		}
 	}
 
 	c.IndentedJSON(http.StatusOK, gin.H{
@@ -0,0 +1,196 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/constants"
+	"github.com/pikami/cosmium/internal/datastore"
+	replicadatastore "github.com/pikami/cosmium/internal/datastore/replica_datastore"
+)
+
+// sessionTokenVersion is the partition key range version written to session tokens,
+// partition key ranges never split, so it stays the same
+const sessionTokenVersion = -1
+
+// readDataStore returns the data store a read is served from, that is the replica view matching the
+// consistency level of the request when replication lag is simulated. A ReadSessionNotAvailable response
+// is written when the session token of the request is ahead of any partition key range the request reads from,
+// otherwise the session headers of the served view are set
+func (h *Handlers) readDataStore(c *gin.Context, databaseId string, collectionId string, partitionKey []interface{}) (datastore.DataStore, bool) {
+	sessionToken := c.GetHeader(headers.SessionToken)
+	sessionLSNs, ok := parseSessionToken(sessionToken)
+	if !ok {
+		c.IndentedJSON(http.StatusBadRequest, gin.H{
+			"code":    "BadRequest",
+			"message": fmt.Sprintf("The session token provided '%s' is invalid.", sessionToken),
+		})
+		return nil, false
+	}
+
+	consistencyLevel, ok := h.requestConsistencyLevel(c)
+	if !ok {
+		return nil, false
+	}
+
+	partitionKeyRanges := h.sessionPartitionKeyRanges(c, h.dataStore, databaseId, collectionId, partitionKey)
+	for _, partitionKeyRange := range partitionKeyRanges {
+		if sessionLSN, ok := sessionLSNs[partitionKeyRange.ID]; ok && sessionLSN > partitionKeyRange.Lsn {
+			c.Header(headers.SubStatus, "1002")
+			c.IndentedJSON(http.StatusNotFound, constants.ReadSessionNotAvailableResponse)
+			return nil, false
+		}
+	}
+
+	dataStore := h.dataStore
+	if h.replica != nil {
+		dataStore = h.replica.View(consistencyLevel, sessionLSNs)
+		partitionKeyRanges = h.sessionPartitionKeyRanges(c, dataStore, databaseId, collectionId, partitionKey)
+	}
+
+	writeSessionHeaders(c, partitionKeyRanges)
+	return dataStore, true
+}
+
+// requestConsistencyLevel returns the consistency level requested by the x-ms-consistency-level header,
+// falls back to the default consistency level of the account
+func (h *Handlers) requestConsistencyLevel(c *gin.Context) (replicadatastore.ConsistencyLevel, bool) {
+	consistencyLevel := c.GetHeader(headers.ConsistencyLevel)
+	if consistencyLevel == "" {
+		consistencyLevel = h.defaultConsistencyLevel()
+	}
+
+	switch consistencyLevel {
+	case config.ConsistencyLevelStrong,
+		config.ConsistencyLevelBoundedStaleness,
+		config.ConsistencyLevelSession,
+		config.ConsistencyLevelConsistentPrefix,
+		config.ConsistencyLevelEventual:
+		return replicadatastore.ConsistencyLevel(consistencyLevel), true
+	}
+
+	c.IndentedJSON(http.StatusBadRequest, gin.H{
+		"code":    "BadRequest",
+		"message": fmt.Sprintf("Invalid value '%s' for header '%s'.", consistencyLevel, headers.ConsistencyLevel),
+	})
+	return "", false
+}
+
+func (h *Handlers) defaultConsistencyLevel() string {
+	if h.config.DefaultConsistencyLevel == "" {
+		return config.ConsistencyLevelSession
+	}
+
+	return h.config.DefaultConsistencyLevel
+}
+
+// setSessionHeaders returns the current LSN and session token of the partition key ranges the request wrote to
+func (h *Handlers) setSessionHeaders(c *gin.Context, databaseId string, collectionId string, partitionKey []interface{}) {
+	writeSessionHeaders(c, h.sessionPartitionKeyRanges(c, h.dataStore, databaseId, collectionId, partitionKey))
+}
+
+// setDocumentSessionHeaders returns the session of the partition key range the document is placed in
+func (h *Handlers) setDocumentSessionHeaders(c *gin.Context, databaseId string, collectionId string, document datastore.Document) {
+	collection, status := h.dataStore.GetCollection(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return
+	}
+
+	h.setSessionHeaders(c, databaseId, collectionId, datastore.GetDocumentPartitionKey(collection, document))
+}
+
+// sessionPartitionKeyRanges returns the partition key ranges a request is scoped to, that is the range
+// requested by id, the range a full partition key is placed in or all ranges of the collection
+func (h *Handlers) sessionPartitionKeyRanges(
+	c *gin.Context,
+	dataStore datastore.DataStore,
+	databaseId string,
+	collectionId string,
+	partitionKey []interface{},
+) []datastore.PartitionKeyRange {
+	collection, status := dataStore.GetCollection(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil
+	}
+
+	partitionKeyRanges, status := dataStore.GetPartitionKeyRanges(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil
+	}
+
+	partitionKeyRangeId := c.GetHeader(headers.PartitionKeyRangeId)
+	if partitionKeyRangeId == "" && partitionKey != nil && len(partitionKey) >= len(collection.PartitionKey.Paths) {
+		partitionKeyRangeId = datastore.GetPartitionKeyRangeId(collection, partitionKey)
+	}
+
+	if partitionKeyRangeId == "" {
+		return partitionKeyRanges
+	}
+
+	for _, partitionKeyRange := range partitionKeyRanges {
+		if partitionKeyRange.ID == partitionKeyRangeId {
+			return []datastore.PartitionKeyRange{partitionKeyRange}
+		}
+	}
+
+	return nil
+}
+
+func writeSessionHeaders(c *gin.Context, partitionKeyRanges []datastore.PartitionKeyRange) {
+	if len(partitionKeyRanges) == 0 {
+		return
+	}
+
+	var lsn int64
+	sessionTokens := make([]string, 0, len(partitionKeyRanges))
+	for _, partitionKeyRange := range partitionKeyRanges {
+		lsn = max(lsn, partitionKeyRange.Lsn)
+		sessionTokens = append(sessionTokens, formatSessionToken(partitionKeyRange.ID, partitionKeyRange.Lsn))
+	}
+
+	c.Header(headers.LSN, strconv.FormatInt(lsn, 10))
+	c.Header(headers.CosmosLsn, strconv.FormatInt(lsn, 10))
+	c.Header(headers.GlobalCommittedLsn, strconv.FormatInt(lsn, 10))
+	c.Header(headers.SessionToken, strings.Join(sessionTokens, ","))
+}
+
+// formatSessionToken writes a session token like "0:-1#123", that is the partition key range id,
+// its version and the LSN the session has seen
+func formatSessionToken(partitionKeyRangeId string, lsn int64) string {
+	return fmt.Sprintf("%s:%d#%d", partitionKeyRangeId, sessionTokenVersion, lsn)
+}
+
+// parseSessionToken reads the LSN of every partition key range from a comma separated session token,
+// both the simple "0:123" and the vector "0:-1#123#1=120" formats are accepted
+func parseSessionToken(sessionToken string) (map[string]int64, bool) {
+	lsns := make(map[string]int64)
+	if sessionToken == "" {
+		return lsns, true
+	}
+
+	for _, rangeToken := range strings.Split(sessionToken, ",") {
+		partitionKeyRangeId, token, ok := strings.Cut(strings.TrimSpace(rangeToken), ":")
+		if !ok || partitionKeyRangeId == "" {
+			return nil, false
+		}
+
+		lsnPart := token
+		if segments := strings.Split(token, "#"); len(segments) > 1 {
+			lsnPart = segments[1]
+		}
+
+		lsn, err := strconv.ParseInt(lsnPart, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+
+		lsns[partitionKeyRangeId] = max(lsns[partitionKeyRangeId], lsn)
+	}
+
+	return lsns, true
+}
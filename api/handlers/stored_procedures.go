@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pikami/cosmium/api/headers"
@@ -28,11 +31,16 @@ func (h *Handlers) GetAllStoredProcedures(c *gin.Context) {
 func (h *Handlers) GetStoredProcedure(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
-	spId := c.Param("spId")
+	spId := c.Param("sprocId")
 
 	sp, status := h.dataStore.GetStoredProcedure(databaseId, collectionId, spId)
 
 	if status == datastore.StatusOk {
+		if !checkIfNoneMatch(c, sp.ETag) {
+			return
+		}
+
+		c.Header(headers.ETag, sp.ETag)
 		c.IndentedJSON(http.StatusOK, sp)
 		return
 	}
@@ -48,9 +56,9 @@ func (h *Handlers) GetStoredProcedure(c *gin.Context) {
 func (h *Handlers) DeleteStoredProcedure(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
-	spId := c.Param("spId")
+	spId := c.Param("sprocId")
 
-	status := h.dataStore.DeleteStoredProcedure(databaseId, collectionId, spId)
+	status := h.dataStore.DeleteStoredProcedure(databaseId, collectionId, spId, requestIfMatch(c))
 	if status == datastore.StatusOk {
 		c.Status(http.StatusNoContent)
 		return
@@ -61,13 +69,18 @@ func (h *Handlers) DeleteStoredProcedure(c *gin.Context) {
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
 func (h *Handlers) ReplaceStoredProcedure(c *gin.Context) {
 	databaseId := c.Param("databaseId")
 	collectionId := c.Param("collId")
-	spId := c.Param("spId")
+	spId := c.Param("sprocId")
 
 	var sp datastore.StoredProcedure
 	if err := c.BindJSON(&sp); err != nil {
@@ -75,12 +88,17 @@ func (h *Handlers) ReplaceStoredProcedure(c *gin.Context) {
 		return
 	}
 
-	status := h.dataStore.DeleteStoredProcedure(databaseId, collectionId, spId)
+	status := h.dataStore.DeleteStoredProcedure(databaseId, collectionId, spId, requestIfMatch(c))
 	if status == datastore.StatusNotFound {
 		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	createdSP, status := h.dataStore.CreateStoredProcedure(databaseId, collectionId, sp)
 	if status == datastore.Conflict {
 		c.IndentedJSON(http.StatusConflict, constants.ConflictResponse)
@@ -118,3 +136,90 @@ func (h *Handlers) CreateStoredProcedure(c *gin.Context) {
 
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
+
+func (h *Handlers) ExecuteStoredProcedure(c *gin.Context) {
+	databaseId := c.Param("databaseId")
+	collectionId := c.Param("collId")
+	spId := c.Param("sprocId")
+
+	sp, status := h.dataStore.GetStoredProcedure(databaseId, collectionId, spId)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return
+	}
+
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+
+	collection, status := h.dataStore.GetCollection(databaseId, collectionId)
+	if status == datastore.StatusNotFound {
+		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
+		return
+	}
+
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+
+	partitionKey, ok := h.requestPointPartitionKey(c, databaseId, collectionId)
+	if !ok {
+		return
+	}
+
+	if partitionKey == nil {
+		c.IndentedJSON(http.StatusBadRequest, constants.BadRequestResponse)
+		return
+	}
+
+	var parameters []interface{}
+	if body, err := c.GetRawData(); err == nil && len(body) > 0 {
+		if err := json.Unmarshal(body, &parameters); err != nil {
+			c.IndentedJSON(http.StatusBadRequest, constants.BadRequestResponse)
+			return
+		}
+	}
+
+	udfs, status := h.dataStore.GetAllUserDefinedFunctions(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+
+	// The writes of the script are staged in the transaction, reads of other requests don't wait for it
+	// but other document writes do, until the script finishes or runs into the script timeout
+	transaction, status := h.beginDocumentTransaction(databaseId, collection, partitionKey)
+	if status != datastore.StatusOk {
+		c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
+		return
+	}
+	defer transaction.Rollback()
+
+	script := h.newScriptContext(transaction, udfs)
+	scriptErr := script.run(sp.ID, sp.Body, parameters)
+	if scriptErr == nil {
+		if status := transaction.Commit(); status != datastore.StatusOk {
+			scriptErr = dataStoreStatusToScriptError(status)
+		}
+	}
+
+	if c.GetHeader(headers.ScriptEnableLogging) == "true" {
+		c.Header(headers.ScriptLogResults, url.QueryEscape(strings.Join(script.logs, "\n")))
+	}
+
+	if scriptErr != nil {
+		scriptErr.writeResponse(c)
+		return
+	}
+
+	h.setSessionHeaders(c, databaseId, collectionId, partitionKey)
+
+	if script.responseBody == nil {
+		c.Status(http.StatusOK)
+		return
+	}
+
+	c.JSON(http.StatusOK, script.responseBody)
+}
@@ -33,6 +33,11 @@ func (h *Handlers) GetTrigger(c *gin.Context) {
 	trigger, status := h.dataStore.GetTrigger(databaseId, collectionId, triggerId)
 
 	if status == datastore.StatusOk {
+		if !checkIfNoneMatch(c, trigger.ETag) {
+			return
+		}
+
+		c.Header(headers.ETag, trigger.ETag)
 		c.IndentedJSON(http.StatusOK, trigger)
 		return
 	}
@@ -50,7 +55,7 @@ func (h *Handlers) DeleteTrigger(c *gin.Context) {
 	collectionId := c.Param("collId")
 	triggerId := c.Param("triggerId")
 
-	status := h.dataStore.DeleteTrigger(databaseId, collectionId, triggerId)
+	status := h.dataStore.DeleteTrigger(databaseId, collectionId, triggerId, requestIfMatch(c))
 	if status == datastore.StatusOk {
 		c.Status(http.StatusNoContent)
 		return
@@ -61,6 +66,11 @@ func (h *Handlers) DeleteTrigger(c *gin.Context) {
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
@@ -75,12 +85,17 @@ func (h *Handlers) ReplaceTrigger(c *gin.Context) {
 		return
 	}
 
-	status := h.dataStore.DeleteTrigger(databaseId, collectionId, triggerId)
+	status := h.dataStore.DeleteTrigger(databaseId, collectionId, triggerId, requestIfMatch(c))
 	if status == datastore.StatusNotFound {
 		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	createdTrigger, status := h.dataStore.CreateTrigger(databaseId, collectionId, trigger)
 	if status == datastore.Conflict {
 		c.IndentedJSON(http.StatusConflict, constants.ConflictResponse)
@@ -33,6 +33,11 @@ func (h *Handlers) GetUserDefinedFunction(c *gin.Context) {
 	udf, status := h.dataStore.GetUserDefinedFunction(databaseId, collectionId, udfId)
 
 	if status == datastore.StatusOk {
+		if !checkIfNoneMatch(c, udf.ETag) {
+			return
+		}
+
+		c.Header(headers.ETag, udf.ETag)
 		c.IndentedJSON(http.StatusOK, udf)
 		return
 	}
@@ -50,7 +55,7 @@ func (h *Handlers) DeleteUserDefinedFunction(c *gin.Context) {
 	collectionId := c.Param("collId")
 	udfId := c.Param("udfId")
 
-	status := h.dataStore.DeleteUserDefinedFunction(databaseId, collectionId, udfId)
+	status := h.dataStore.DeleteUserDefinedFunction(databaseId, collectionId, udfId, requestIfMatch(c))
 	if status == datastore.StatusOk {
 		c.Status(http.StatusNoContent)
 		return
@@ -61,6 +66,11 @@ func (h *Handlers) DeleteUserDefinedFunction(c *gin.Context) {
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	c.IndentedJSON(http.StatusInternalServerError, constants.UnknownErrorResponse)
 }
 
@@ -75,12 +85,17 @@ func (h *Handlers) ReplaceUserDefinedFunction(c *gin.Context) {
 		return
 	}
 
-	status := h.dataStore.DeleteUserDefinedFunction(databaseId, collectionId, udfId)
+	status := h.dataStore.DeleteUserDefinedFunction(databaseId, collectionId, udfId, requestIfMatch(c))
 	if status == datastore.StatusNotFound {
 		c.IndentedJSON(http.StatusNotFound, constants.NotFoundResponse)
 		return
 	}
 
+	if status == datastore.PreconditionFailed {
+		writePreconditionFailedResponse(c)
+		return
+	}
+
 	createdUdf, status := h.dataStore.CreateUserDefinedFunction(databaseId, collectionId, udf)
 	if status == datastore.Conflict {
 		c.IndentedJSON(http.StatusConflict, constants.ConflictResponse)
@@ -1,22 +1,34 @@
 package headers
 
 const (
-	AIM                = "A-Im"
-	Authorization      = "authorization"
-	CosmosLsn          = "x-ms-cosmos-llsn"
-	ErrorCode          = "x-ms-error-code"
-	ETag               = "etag"
-	GlobalCommittedLsn = "x-ms-global-committed-lsn"
-	IfMatch            = "if-match"
-	IfNoneMatch        = "if-none-match"
-	IsBatchRequest     = "x-ms-cosmos-is-batch-request"
-	IsQueryPlanRequest = "x-ms-cosmos-is-query-plan-request"
-	IsUpsert           = "x-ms-documentdb-is-upsert"
-	ItemCount          = "x-ms-item-count"
-	LSN                = "lsn"
-	XDate              = "x-ms-date"
-	MaxItemCount       = "x-ms-max-item-count"
-	ContinuationToken  = "x-ms-continuation"
+	AIM                  = "A-Im"
+	Authorization        = "authorization"
+	CosmosLsn            = "x-ms-cosmos-llsn"
+	ErrorCode            = "x-ms-error-code"
+	ETag                 = "etag"
+	GlobalCommittedLsn   = "x-ms-global-committed-lsn"
+	IfMatch              = "if-match"
+	IfNoneMatch          = "if-none-match"
+	IsBatchAtomic        = "x-ms-cosmos-batch-atomic"
+	BatchContinueOnError = "x-ms-cosmos-batch-continue-on-error"
+	IsBatchRequest       = "x-ms-cosmos-is-batch-request"
+	IsPartitionKeyDelete = "x-ms-cosmos-is-partition-key-delete-request"
+	IsQueryPlanRequest   = "x-ms-cosmos-is-query-plan-request"
+	IsUpsert             = "x-ms-documentdb-is-upsert"
+	ItemCount            = "x-ms-item-count"
+	LSN                  = "lsn"
+	XDate                = "x-ms-date"
+	MaxItemCount         = "x-ms-max-item-count"
+	ContinuationToken    = "x-ms-continuation"
+	PartitionKey         = "x-ms-documentdb-partitionkey"
+	PartitionKeyRangeId  = "x-ms-documentdb-partitionkeyrangeid"
+	PreTriggerInclude    = "x-ms-documentdb-pre-trigger-include"
+	PostTriggerInclude   = "x-ms-documentdb-post-trigger-include"
+	SessionToken         = "x-ms-session-token"
+	SubStatus            = "x-ms-substatus"
+
+	ScriptEnableLogging = "x-ms-documentdb-script-enable-logging"
+	ScriptLogResults    = "x-ms-documentdb-script-log-results"
 
 	// Kinda retarded, but what can I do ¯\_(ツ)_/¯
 	IsQuery = "x-ms-documentdb-isquery" // Sent from python sdk and web explorer
@@ -0,0 +1,103 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Collections_ComputedProperties(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Collections_ComputedProperties", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		ts.DataStore.CreateDatabase(datastore.Database{ID: testDatabaseName})
+		ts.DataStore.CreateCollection(testDatabaseName, datastore.Collection{
+			ID: testCollectionName,
+			PartitionKey: datastore.CollectionPartitionKey{
+				Paths: []string{"/pk"},
+			},
+			ComputedProperties: []datastore.CollectionComputedProperty{
+				{Name: "cp_lowerName", Query: "SELECT VALUE LOWER(c.name) FROM c"},
+			},
+		})
+		ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "1", "pk": "a", "name": "Bob"})
+		ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "2", "pk": "a", "name": "alice"})
+
+		collectionClient, err := client.NewContainer(testDatabaseName, testCollectionName)
+		assert.Nil(t, err)
+
+		t.Run("Should filter, project and order by computed property", func(t *testing.T) {
+			testCosmosQuery(t, collectionClient,
+				`SELECT c.id, c.cp_lowerName FROM c WHERE c.cp_lowerName IN ("alice", "bob") ORDER BY c.cp_lowerName`,
+				nil,
+				[]interface{}{
+					map[string]interface{}{"id": "2", "cp_lowerName": "alice"},
+					map[string]interface{}{"id": "1", "cp_lowerName": "bob"},
+				},
+			)
+		})
+
+		t.Run("Should not include computed property in SELECT *", func(t *testing.T) {
+			pager := collectionClient.NewQueryItemsPager(
+				`SELECT * FROM c WHERE c.cp_lowerName = "bob"`,
+				azcosmos.PartitionKey{},
+				&azcosmos.QueryOptions{})
+
+			response, err := pager.NextPage(context.TODO())
+			assert.Nil(t, err)
+			assert.Len(t, response.Items, 1)
+
+			var document map[string]interface{}
+			json.Unmarshal(response.Items[0], &document)
+			assert.Equal(t, "1", document["id"])
+			assert.NotContains(t, document, "cp_lowerName")
+		})
+
+		t.Run("Should create collection with computed properties", func(t *testing.T) {
+			body, _ := json.Marshal(map[string]interface{}{
+				"id": "with-computed-properties",
+				"computedProperties": []map[string]string{
+					{"name": "cp_upperName", "query": "SELECT VALUE UPPER(c.name) FROM c"},
+				},
+			})
+
+			link := fmt.Sprintf("dbs/%s", testDatabaseName)
+			status, responseBody := sendSignedRequest(t, ts, "POST", "colls", link, link+"/colls", body, nil)
+			assert.Equal(t, http.StatusCreated, status)
+			assert.Contains(t, responseBody, "cp_upperName")
+		})
+
+		t.Run("Should reject invalid computed properties", func(t *testing.T) {
+			testCases := map[string]string{
+				"without VALUE":       "SELECT LOWER(c.name) FROM c",
+				"with WHERE":          "SELECT VALUE LOWER(c.name) FROM c WHERE c.id = '1'",
+				"with subquery":       "SELECT VALUE (SELECT VALUE 1) FROM c",
+				"with aggregate":      "SELECT VALUE COUNT(c.id) FROM c",
+				"referencing another": "SELECT VALUE c.cp_other FROM c",
+				"with syntax error":   "SELECT VALUE FROM c",
+			}
+
+			for name, query := range testCases {
+				t.Run(name, func(t *testing.T) {
+					body, _ := json.Marshal(map[string]interface{}{
+						"id": "invalid-" + name,
+						"computedProperties": []map[string]string{
+							{"name": "cp_invalid", "query": query},
+							{"name": "cp_other", "query": "SELECT VALUE 1 FROM c"},
+						},
+					})
+
+					link := fmt.Sprintf("dbs/%s", testDatabaseName)
+					status, _ := sendSignedRequest(t, ts, "POST", "colls", link, link+"/colls", body, nil)
+					assert.Equal(t, http.StatusBadRequest, status)
+				})
+			}
+		})
+	})
+}
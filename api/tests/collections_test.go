@@ -2,7 +2,9 @@ package tests_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -52,6 +54,19 @@ func Test_Collections(t *testing.T) {
 				panic(err)
 			}
 		})
+		t.Run("Should ignore partition count of the request", func(t *testing.T) {
+			path := fmt.Sprintf("dbs/%s", testDatabaseName)
+			body, _ := json.Marshal(map[string]interface{}{
+				"id":             "partition-count",
+				"partitionKey":   map[string]interface{}{"paths": []string{"/pk"}, "kind": "Hash"},
+				"partitionCount": 1000000,
+			})
+			status, _ := sendSignedRequest(t, ts, "POST", "colls", path, path+"/colls", body, nil)
+			assert.Equal(t, http.StatusCreated, status)
+
+			collection, _ := ts.DataStore.GetCollection(testDatabaseName, "partition-count")
+			assert.Equal(t, 1, datastore.GetPartitionCount(collection))
+		})
 	})
 
 	runTestsWithPresets(t, "Collection Read", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
@@ -72,7 +87,7 @@ func Test_Collections(t *testing.T) {
 		})
 
 		t.Run("Should return not found when collection does not exist", func(t *testing.T) {
-			ts.DataStore.DeleteCollection(testDatabaseName, testCollectionName)
+			ts.DataStore.DeleteCollection(testDatabaseName, testCollectionName, "")
 
 			collectionResponse, err := databaseClient.NewContainer(testCollectionName)
 			assert.Nil(t, err)
@@ -106,7 +121,7 @@ func Test_Collections(t *testing.T) {
 		})
 
 		t.Run("Should return not found when collection does not exist", func(t *testing.T) {
-			ts.DataStore.DeleteCollection(testDatabaseName, testCollectionName)
+			ts.DataStore.DeleteCollection(testDatabaseName, testCollectionName, "")
 
 			collectionResponse, err := databaseClient.NewContainer(testCollectionName)
 			assert.Nil(t, err)
@@ -76,8 +76,10 @@ const (
 )
 
 func runTestsWithPreset(t *testing.T, name string, testPreset testPreset, f testFunc) {
-	serverConfig := getDefaultTestServerConfig()
+	runTestsWithPresetConfig(t, name, testPreset, getDefaultTestServerConfig(), f)
+}
 
+func runTestsWithPresetConfig(t *testing.T, name string, testPreset testPreset, serverConfig *config.ServerConfig, f testFunc) {
 	serverConfig.LogLevel = "debug"
 	logger.SetLogLevel(logger.LogLevelDebug)
 
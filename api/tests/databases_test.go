@@ -17,7 +17,7 @@ func Test_Databases(t *testing.T) {
 
 	runTestsWithPresets(t, "Database Create", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
 		t.Run("Should create database", func(t *testing.T) {
-			ts.DataStore.DeleteDatabase(testDatabaseName)
+			ts.DataStore.DeleteDatabase(testDatabaseName, "")
 
 			createResponse, err := client.CreateDatabase(context.TODO(), azcosmos.DatabaseProperties{
 				ID: testDatabaseName,
@@ -62,7 +62,7 @@ func Test_Databases(t *testing.T) {
 		})
 
 		t.Run("Should return not found when database does not exist", func(t *testing.T) {
-			ts.DataStore.DeleteDatabase(testDatabaseName)
+			ts.DataStore.DeleteDatabase(testDatabaseName, "")
 
 			databaseResponse, err := client.NewDatabase(testDatabaseName)
 			assert.Nil(t, err)
@@ -94,7 +94,7 @@ func Test_Databases(t *testing.T) {
 		})
 
 		t.Run("Should return not found when database does not exist", func(t *testing.T) {
-			ts.DataStore.DeleteDatabase(testDatabaseName)
+			ts.DataStore.DeleteDatabase(testDatabaseName, "")
 
 			databaseResponse, err := client.NewDatabase(testDatabaseName)
 			assert.Nil(t, err)
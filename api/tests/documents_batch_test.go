@@ -0,0 +1,159 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_TransactionalBatch(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_TransactionalBatch", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+
+		t.Run("Should roll back atomic batch when an operation fails", func(t *testing.T) {
+			originalDocument, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "12345")
+			originalEtag, originalRid := originalDocument["_etag"], originalDocument["_rid"]
+			originalRanges, _ := ts.DataStore.GetPartitionKeyRanges(testDatabaseName, testCollectionName)
+
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("123"))
+
+			newItem, _ := json.Marshal(map[string]interface{}{"id": "new-item", "pk": "123"})
+			replacedItem, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": true})
+			conflictingItem, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123"})
+			batch.CreateItem(newItem, nil)
+			batch.ReplaceItem("12345", replacedItem, nil)
+			batch.CreateItem(conflictingItem, nil)
+			batch.ReadItem("12345", nil)
+
+			response, err := collectionClient.ExecuteTransactionalBatch(context.TODO(), batch, nil)
+			assert.Nil(t, err)
+			assert.False(t, response.Success)
+			assert.Len(t, response.OperationResults, 4)
+			assert.Equal(t, int32(http.StatusFailedDependency), response.OperationResults[0].StatusCode)
+			assert.Equal(t, int32(http.StatusFailedDependency), response.OperationResults[1].StatusCode)
+			assert.Equal(t, int32(http.StatusConflict), response.OperationResults[2].StatusCode)
+			assert.Equal(t, int32(http.StatusFailedDependency), response.OperationResults[3].StatusCode)
+
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "new-item")
+			assert.Equal(t, datastore.StatusNotFound, status)
+
+			document, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "12345")
+			assert.Equal(t, datastore.StatusOk, status)
+			assert.Equal(t, false, document["isCool"])
+			assert.Equal(t, originalEtag, document["_etag"])
+			assert.Equal(t, originalRid, document["_rid"])
+
+			ranges, _ := ts.DataStore.GetPartitionKeyRanges(testDatabaseName, testCollectionName)
+			assert.Equal(t, originalRanges[0].Lsn, ranges[0].Lsn)
+		})
+
+		t.Run("Should read staged changes within atomic batch", func(t *testing.T) {
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("123"))
+
+			newItem, _ := json.Marshal(map[string]interface{}{"id": "staged-item", "pk": "123"})
+			batch.CreateItem(newItem, nil)
+			batch.ReadItem("staged-item", nil)
+			batch.DeleteItem("staged-item", nil)
+
+			response, err := collectionClient.ExecuteTransactionalBatch(context.TODO(), batch, nil)
+			assert.Nil(t, err)
+			assert.True(t, response.Success)
+			assert.Equal(t, int32(http.StatusCreated), response.OperationResults[0].StatusCode)
+			assert.Equal(t, int32(http.StatusOK), response.OperationResults[1].StatusCode)
+			assert.Equal(t, int32(http.StatusNoContent), response.OperationResults[2].StatusCode)
+
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "staged-item")
+			assert.Equal(t, datastore.StatusNotFound, status)
+		})
+
+		t.Run("Should execute PATCH in transactional batch", func(t *testing.T) {
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("456"))
+
+			patch := azcosmos.PatchOperations{}
+			patch.AppendSet("/patched", true)
+			patch.AppendIncrement("/counter", 2)
+			batch.PatchItem("67890", patch, nil)
+
+			response, err := collectionClient.ExecuteTransactionalBatch(context.TODO(), batch, nil)
+			assert.Nil(t, err)
+			assert.True(t, response.Success)
+			assert.Equal(t, int32(http.StatusOK), response.OperationResults[0].StatusCode)
+			assert.NotEmpty(t, response.OperationResults[0].ETag)
+
+			document, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"456"}, "67890")
+			assert.Equal(t, true, document["patched"])
+			assert.Equal(t, float64(2), document["counter"])
+			assert.Equal(t, response.OperationResults[0].ETag, azcore.ETag(document["_etag"].(string)))
+		})
+
+		path := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+		sendBulk := func(t *testing.T, id string, continueOnError bool) []map[string]interface{} {
+			operations, _ := json.Marshal([]map[string]interface{}{
+				{"operationType": "Create", "resourceBody": map[string]interface{}{"id": "12345", "pk": "123"}},
+				{"operationType": "Create", "resourceBody": map[string]interface{}{"id": id, "pk": "123"}},
+			})
+
+			status, body := sendSignedRequest(t, ts, "POST", "docs", path, path+"/docs", operations, map[string]string{
+				headers.IsBatchRequest:       "true",
+				headers.IsBatchAtomic:        "false",
+				headers.BatchContinueOnError: fmt.Sprint(continueOnError),
+				headers.PartitionKey:         `["123"]`,
+			})
+			assert.Equal(t, http.StatusMultiStatus, status)
+
+			var results []map[string]interface{}
+			json.Unmarshal([]byte(body), &results)
+			return results
+		}
+
+		t.Run("Should reject operation without resource body", func(t *testing.T) {
+			operations, _ := json.Marshal([]map[string]interface{}{
+				{"operationType": "Create", "resourceBody": map[string]interface{}{"id": "no-body-1", "pk": "123"}},
+				{"operationType": "Upsert"},
+			})
+
+			status, body := sendSignedRequest(t, ts, "POST", "docs", path, path+"/docs", operations, map[string]string{
+				headers.IsBatchRequest: "true",
+				headers.IsBatchAtomic:  "true",
+				headers.PartitionKey:   `["123"]`,
+			})
+			assert.Equal(t, http.StatusMultiStatus, status)
+
+			var results []map[string]interface{}
+			json.Unmarshal([]byte(body), &results)
+			assert.Equal(t, float64(http.StatusFailedDependency), results[0]["statusCode"])
+			assert.Equal(t, float64(http.StatusBadRequest), results[1]["statusCode"])
+
+			_, dataStoreStatus := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "no-body-1")
+			assert.Equal(t, datastore.StatusNotFound, dataStoreStatus)
+		})
+
+		t.Run("Should continue non-atomic batch on error when requested", func(t *testing.T) {
+			results := sendBulk(t, "bulk-1", true)
+			assert.Equal(t, float64(http.StatusConflict), results[0]["statusCode"])
+			assert.Equal(t, float64(http.StatusCreated), results[1]["statusCode"])
+
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "bulk-1")
+			assert.Equal(t, datastore.StatusOk, status)
+		})
+
+		t.Run("Should stop non-atomic batch at the first error", func(t *testing.T) {
+			results := sendBulk(t, "bulk-2", false)
+			assert.Equal(t, float64(http.StatusConflict), results[0]["statusCode"])
+			assert.Equal(t, float64(http.StatusFailedDependency), results[1]["statusCode"])
+
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "bulk-2")
+			assert.Equal(t, datastore.StatusNotFound, status)
+		})
+	})
+}
@@ -0,0 +1,206 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_ConsistencyLevels(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+	replicationLag := 300 * time.Millisecond
+
+	for _, preset := range presets {
+		serverConfig := getDefaultTestServerConfig()
+		serverConfig.DefaultConsistencyLevel = config.ConsistencyLevelEventual
+		serverConfig.ReplicationLag = replicationLag
+		serverConfig.MaxStalenessPrefix = 100
+		serverConfig.MaxStalenessInterval = time.Minute
+
+		runTestsWithPresetConfig(t, "Test_Documents_ConsistencyLevels", preset, serverConfig, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+			collectionClient := documents_InitializeDb(t, ts)
+
+			readItem := func(id string, pk string, options *azcosmos.ItemOptions) (map[string]interface{}, error) {
+				response, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString(pk), id, options)
+				if err != nil {
+					return nil, err
+				}
+
+				var item map[string]interface{}
+				err = json.Unmarshal(response.Value, &item)
+				return item, err
+			}
+
+			withConsistency := func(level azcosmos.ConsistencyLevel) *azcosmos.ItemOptions {
+				return &azcosmos.ItemOptions{ConsistencyLevel: level.ToPtr()}
+			}
+
+			assertNotFound := func(t *testing.T, err error) {
+				var respErr *azcore.ResponseError
+				if errors.As(err, &respErr) {
+					assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+				} else {
+					panic(err)
+				}
+			}
+
+			t.Run("Should report configured consistency policy", func(t *testing.T) {
+				status, body := sendSignedRequest(t, ts, "GET", "", "", "", nil, nil)
+				assert.Equal(t, http.StatusOK, status)
+
+				var serverInfo map[string]interface{}
+				json.Unmarshal([]byte(body), &serverInfo)
+				consistencyPolicy := serverInfo["userConsistencyPolicy"].(map[string]interface{})
+				assert.Equal(t, "Eventual", consistencyPolicy["defaultConsistencyLevel"])
+				assert.EqualValues(t, 100, consistencyPolicy["maxStalenessPrefix"])
+				assert.EqualValues(t, 60, consistencyPolicy["maxIntervalInSeconds"])
+			})
+
+			t.Run("Should hide created document from weak reads until replicated", func(t *testing.T) {
+				item, _ := json.Marshal(map[string]interface{}{"id": "lagging", "pk": "123"})
+				response, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), item, nil)
+				assert.Nil(t, err)
+
+				_, err = readItem("lagging", "123", nil)
+				assertNotFound(t, err)
+
+				_, err = readItem("lagging", "123", withConsistency(azcosmos.ConsistencyLevelBoundedStaleness))
+				assertNotFound(t, err)
+
+				_, err = readItem("lagging", "123", withConsistency(azcosmos.ConsistencyLevelSession))
+				assertNotFound(t, err)
+
+				_, err = readItem("lagging", "123", withConsistency(azcosmos.ConsistencyLevelStrong))
+				assert.Nil(t, err)
+
+				options := withConsistency(azcosmos.ConsistencyLevelSession)
+				options.SessionToken = response.SessionToken
+				_, err = readItem("lagging", "123", options)
+				assert.Nil(t, err)
+
+				time.Sleep(replicationLag)
+
+				_, err = readItem("lagging", "123", nil)
+				assert.Nil(t, err)
+			})
+
+			t.Run("Should serve stale versions of replaced and deleted documents", func(t *testing.T) {
+				item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": true})
+				_, err := collectionClient.ReplaceItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", item, nil)
+				assert.Nil(t, err)
+
+				_, err = collectionClient.DeleteItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "67890", nil)
+				assert.Nil(t, err)
+
+				document, err := readItem("12345", "123", nil)
+				assert.Nil(t, err)
+				assert.Equal(t, false, document["isCool"])
+
+				_, err = readItem("67890", "456", nil)
+				assert.Nil(t, err)
+
+				document, err = readItem("12345", "123", withConsistency(azcosmos.ConsistencyLevelStrong))
+				assert.Nil(t, err)
+				assert.Equal(t, true, document["isCool"])
+
+				time.Sleep(replicationLag)
+
+				document, err = readItem("12345", "123", nil)
+				assert.Nil(t, err)
+				assert.Equal(t, true, document["isCool"])
+
+				_, err = readItem("67890", "456", nil)
+				assertNotFound(t, err)
+			})
+
+			t.Run("Should query replica view", func(t *testing.T) {
+				item, _ := json.Marshal(map[string]interface{}{"id": "query-lagging", "pk": "789"})
+				_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("789"), item, nil)
+				assert.Nil(t, err)
+
+				testCosmosQuery(t, collectionClient,
+					"SELECT c.id FROM c ORDER BY c.id",
+					nil,
+					[]interface{}{
+						map[string]interface{}{"id": "12345"},
+						map[string]interface{}{"id": "lagging"},
+					},
+				)
+
+				time.Sleep(replicationLag)
+
+				testCosmosQuery(t, collectionClient,
+					"SELECT c.id FROM c ORDER BY c.id",
+					nil,
+					[]interface{}{
+						map[string]interface{}{"id": "12345"},
+						map[string]interface{}{"id": "lagging"},
+						map[string]interface{}{"id": "query-lagging"},
+					},
+				)
+			})
+
+			t.Run("Should replicate batch writes together", func(t *testing.T) {
+				batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("123"))
+				firstItem, _ := json.Marshal(map[string]interface{}{"id": "batch-lagging-1", "pk": "123"})
+				secondItem, _ := json.Marshal(map[string]interface{}{"id": "batch-lagging-2", "pk": "123"})
+				batch.CreateItem(firstItem, nil)
+				batch.CreateItem(secondItem, nil)
+
+				response, err := collectionClient.ExecuteTransactionalBatch(context.TODO(), batch, nil)
+				assert.Nil(t, err)
+				assert.True(t, response.Success)
+
+				_, err = readItem("batch-lagging-1", "123", nil)
+				assertNotFound(t, err)
+
+				_, err = readItem("batch-lagging-2", "123", withConsistency(azcosmos.ConsistencyLevelStrong))
+				assert.Nil(t, err)
+
+				time.Sleep(replicationLag)
+
+				_, err = readItem("batch-lagging-1", "123", nil)
+				assert.Nil(t, err)
+				_, err = readItem("batch-lagging-2", "123", nil)
+				assert.Nil(t, err)
+			})
+
+			t.Run("Should report replica LSN in session token", func(t *testing.T) {
+				item, _ := json.Marshal(map[string]interface{}{"id": "lsn-lagging", "pk": "123"})
+				response, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), item, nil)
+				assert.Nil(t, err)
+
+				readResponse, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+				assert.Nil(t, err)
+				assert.NotEqual(t, *response.SessionToken, *readResponse.SessionToken)
+
+				readResponse, err = collectionClient.ReadItem(
+					context.TODO(),
+					azcosmos.NewPartitionKeyString("123"),
+					"12345",
+					withConsistency(azcosmos.ConsistencyLevelStrong),
+				)
+				assert.Nil(t, err)
+				assert.Equal(t, *response.SessionToken, *readResponse.SessionToken)
+			})
+
+			t.Run("Should reject unknown consistency level", func(t *testing.T) {
+				status, _ := sendSignedRequest(t, ts, "GET", "docs", "dbs/"+testDatabaseName+"/colls/"+testCollectionName+"/docs/12345",
+					"dbs/"+testDatabaseName+"/colls/"+testCollectionName+"/docs/12345", nil, map[string]string{
+						headers.PartitionKey:     `["123"]`,
+						headers.ConsistencyLevel: "Linearizable",
+					})
+				assert.Equal(t, http.StatusBadRequest, status)
+			})
+		})
+	}
+}
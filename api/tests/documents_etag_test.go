@@ -0,0 +1,176 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_ETag_Preconditions(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_ETag_Preconditions", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+		wrongETag := azcore.ETag("\"incorrect-etag\"")
+		documentPath := fmt.Sprintf("dbs/%s/colls/%s/docs/12345", testDatabaseName, testCollectionName)
+
+		assertPreconditionFailed := func(t *testing.T, err error) {
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusPreconditionFailed, respErr.StatusCode)
+				assert.Equal(t, "PreconditionFailed", respErr.RawResponse.Header.Get(headers.ErrorCode))
+				assert.Equal(t, "0", respErr.RawResponse.Header.Get(headers.SubStatus))
+			} else {
+				panic(err)
+			}
+		}
+
+		t.Run("Should return NotModified when If-None-Match matches", func(t *testing.T) {
+			document, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			requestHeaders := map[string]string{
+				headers.PartitionKey: `["123"]`,
+				headers.IfNoneMatch:  document["_etag"].(string),
+			}
+
+			status, body, responseHeaders := sendSignedRequestWithResponseHeaders(t, ts, "GET", "docs", documentPath, documentPath, nil, requestHeaders)
+			assert.Equal(t, http.StatusNotModified, status)
+			assert.Empty(t, body)
+			assert.Equal(t, document["_etag"], responseHeaders.Get(headers.ETag))
+
+			requestHeaders[headers.IfNoneMatch] = string(wrongETag)
+			status, _ = sendSignedRequest(t, ts, "GET", "docs", documentPath, documentPath, nil, requestHeaders)
+			assert.Equal(t, http.StatusOK, status)
+		})
+
+		t.Run("Should fail delete with incorrect etag", func(t *testing.T) {
+			_, err := collectionClient.DeleteItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				"12345",
+				&azcosmos.ItemOptions{IfMatchEtag: &wrongETag},
+			)
+			assertPreconditionFailed(t, err)
+
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			assert.Equal(t, datastore.StatusOk, status)
+		})
+
+		t.Run("Should fail patch with incorrect etag", func(t *testing.T) {
+			patch := azcosmos.PatchOperations{}
+			patch.AppendSet("/isCool", true)
+			patch.SetCondition("FROM c WHERE true")
+
+			_, err := collectionClient.PatchItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				"12345",
+				patch,
+				&azcosmos.ItemOptions{IfMatchEtag: &wrongETag},
+			)
+			assertPreconditionFailed(t, err)
+
+			document, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			assert.Equal(t, false, document["isCool"])
+		})
+
+		t.Run("Should fail upsert with incorrect etag", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": true})
+			_, err := collectionClient.UpsertItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				item,
+				&azcosmos.ItemOptions{IfMatchEtag: &wrongETag},
+			)
+			assertPreconditionFailed(t, err)
+
+			document, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			assert.Equal(t, false, document["isCool"])
+		})
+
+		t.Run("Should upsert with correct etag", func(t *testing.T) {
+			document, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			etag := azcore.ETag(document["_etag"].(string))
+
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": true})
+			response, err := collectionClient.UpsertItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				item,
+				&azcosmos.ItemOptions{IfMatchEtag: &etag},
+			)
+			assert.Nil(t, err)
+			assert.NotEqual(t, etag, response.ETag)
+
+			document, _ = ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			assert.Equal(t, true, document["isCool"])
+			assert.Equal(t, string(response.ETag), document["_etag"])
+		})
+
+		t.Run("Should fail batch when an operation has incorrect etag", func(t *testing.T) {
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("123"))
+
+			newItem, _ := json.Marshal(map[string]interface{}{"id": "batch-item", "pk": "123"})
+			replacedItem, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": false})
+			batch.CreateItem(newItem, nil)
+			batch.ReplaceItem("12345", replacedItem, &azcosmos.TransactionalBatchItemOptions{IfMatchETag: &wrongETag})
+
+			response, err := collectionClient.ExecuteTransactionalBatch(context.TODO(), batch, nil)
+			assert.Nil(t, err)
+			assert.False(t, response.Success)
+			assert.Equal(t, int32(http.StatusFailedDependency), response.OperationResults[0].StatusCode)
+			assert.Equal(t, int32(http.StatusPreconditionFailed), response.OperationResults[1].StatusCode)
+
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "batch-item")
+			assert.Equal(t, datastore.StatusNotFound, status)
+		})
+
+		t.Run("Should fail stored procedure replace with incorrect etag", func(t *testing.T) {
+			ts.DataStore.CreateStoredProcedure(testDatabaseName, testCollectionName, datastore.StoredProcedure{ID: "sproc", Body: "function () {}"})
+
+			path := fmt.Sprintf("dbs/%s/colls/%s/sprocs/sproc", testDatabaseName, testCollectionName)
+			body, _ := json.Marshal(map[string]interface{}{"id": "sproc", "body": "function () { return 1; }"})
+			status, _ := sendSignedRequest(t, ts, "PUT", "sprocs", path, path, body, map[string]string{
+				headers.IfMatch: string(wrongETag),
+			})
+			assert.Equal(t, http.StatusPreconditionFailed, status)
+
+			storedProcedure, _ := ts.DataStore.GetStoredProcedure(testDatabaseName, testCollectionName, "sproc")
+			assert.Equal(t, "function () {}", storedProcedure.Body)
+		})
+
+		t.Run("Should delete trigger only with matching etag", func(t *testing.T) {
+			trigger, _ := ts.DataStore.CreateTrigger(testDatabaseName, testCollectionName, datastore.Trigger{ID: "trigger", Body: "function () {}"})
+
+			path := fmt.Sprintf("dbs/%s/colls/%s/triggers/trigger", testDatabaseName, testCollectionName)
+			status, _ := sendSignedRequest(t, ts, "DELETE", "triggers", path, path, nil, map[string]string{
+				headers.IfMatch: string(wrongETag),
+			})
+			assert.Equal(t, http.StatusPreconditionFailed, status)
+
+			status, _ = sendSignedRequest(t, ts, "DELETE", "triggers", path, path, nil, map[string]string{
+				headers.IfMatch: trigger.ETag,
+			})
+			assert.Equal(t, http.StatusNoContent, status)
+		})
+
+		t.Run("Should fail collection delete with incorrect etag", func(t *testing.T) {
+			path := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+			status, _ := sendSignedRequest(t, ts, "DELETE", "colls", path, path, nil, map[string]string{
+				headers.IfMatch: string(wrongETag),
+			})
+			assert.Equal(t, http.StatusPreconditionFailed, status)
+
+			_, dataStoreStatus := ts.DataStore.GetCollection(testDatabaseName, testCollectionName)
+			assert.Equal(t, datastore.StatusOk, dataStoreStatus)
+		})
+	})
+}
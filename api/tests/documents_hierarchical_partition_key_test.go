@@ -0,0 +1,199 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_HierarchicalPartitionKey(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_HierarchicalPartitionKey", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		ts.DataStore.CreateDatabase(datastore.Database{ID: testDatabaseName})
+		databaseClient, err := client.NewDatabase(testDatabaseName)
+		assert.Nil(t, err)
+
+		_, err = databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+			ID: testCollectionName,
+			PartitionKeyDefinition: azcosmos.PartitionKeyDefinition{
+				Kind:    azcosmos.PartitionKeyKindMultiHash,
+				Paths:   []string{"/tenant", "/user", "/session"},
+				Version: 2,
+			},
+		}, &azcosmos.CreateContainerOptions{})
+		assert.Nil(t, err)
+
+		collectionClient, err := client.NewContainer(testDatabaseName, testCollectionName)
+		assert.Nil(t, err)
+
+		for _, tenant := range []string{"t1", "t2"} {
+			for _, user := range []string{"u1", "u2"} {
+				item, _ := json.Marshal(map[string]interface{}{
+					"id":      "1",
+					"tenant":  tenant,
+					"user":    user,
+					"session": "s1",
+				})
+				partitionKey := azcosmos.NewPartitionKeyString(tenant).AppendString(user).AppendString("s1")
+				_, err := collectionClient.CreateItem(context.TODO(), partitionKey, item, nil)
+				assert.Nil(t, err)
+			}
+		}
+
+		t.Run("Should read item by full partition key", func(t *testing.T) {
+			partitionKey := azcosmos.NewPartitionKeyString("t2").AppendString("u1").AppendString("s1")
+			response, err := collectionClient.ReadItem(context.TODO(), partitionKey, "1", nil)
+			assert.Nil(t, err)
+
+			var item map[string]interface{}
+			json.Unmarshal(response.Value, &item)
+			assert.Equal(t, "t2", item["tenant"])
+			assert.Equal(t, "u1", item["user"])
+		})
+
+		t.Run("Should reject point read with prefix partition key", func(t *testing.T) {
+			_, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("t2"), "1", nil)
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		})
+
+		t.Run("Should scope query to prefix partition key", func(t *testing.T) {
+			testCosmosQuery(t, collectionClient,
+				`SELECT VALUE c.user FROM c ORDER BY c.user`,
+				nil,
+				[]interface{}{"u1", "u1", "u2", "u2"},
+			)
+
+			pager := collectionClient.NewQueryItemsPager(`SELECT c.tenant, c.user FROM c ORDER BY c.user`, azcosmos.NewPartitionKeyString("t1"), nil)
+			response, err := pager.NextPage(context.TODO())
+			assert.Nil(t, err)
+			assert.Len(t, response.Items, 2)
+			for _, rawItem := range response.Items {
+				var item map[string]interface{}
+				json.Unmarshal(rawItem, &item)
+				assert.Equal(t, "t1", item["tenant"])
+			}
+
+			pager = collectionClient.NewQueryItemsPager(`SELECT VALUE c.user FROM c`, azcosmos.NewPartitionKeyString("t1").AppendString("u2"), nil)
+			response, err = pager.NextPage(context.TODO())
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{[]byte(`"u2"`)}, response.Items)
+		})
+
+		t.Run("Should delete all partitions below a prefix partition key", func(t *testing.T) {
+			path := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+			status, _ := sendSignedRequest(t, ts, "POST", "docs", path, path+"/docs", nil, map[string]string{
+				headers.IsPartitionKeyDelete: "true",
+				headers.PartitionKey:         `["t1"]`,
+			})
+			assert.Equal(t, http.StatusOK, status)
+
+			testCosmosQuery(t, collectionClient, "SELECT VALUE c.tenant FROM c", nil, []interface{}{"t2", "t2"})
+		})
+
+		t.Run("Should place documents with the same first level in the same partition key range", func(t *testing.T) {
+			ts.DataStore.CreateCollection(testDatabaseName, datastore.Collection{
+				ID: "multi-range",
+				PartitionKey: datastore.CollectionPartitionKey{
+					Kind:  datastore.PartitionKeyKindMultiHash,
+					Paths: []string{"/tenant", "/user"},
+				},
+				PartitionCount: 8,
+			})
+			for i := 0; i < 10; i++ {
+				ts.DataStore.CreateDocument(testDatabaseName, "multi-range", nil, map[string]interface{}{
+					"id":     fmt.Sprintf("%d", i),
+					"tenant": "t1",
+					"user":   fmt.Sprintf("u%d", i),
+				})
+			}
+
+			collectionPath := fmt.Sprintf("dbs/%s/colls/multi-range", testDatabaseName)
+			nonEmptyRanges := 0
+			for i := 0; i < 8; i++ {
+				status, body := sendSignedRequest(t, ts, "GET", "docs", collectionPath, collectionPath+"/docs", nil, map[string]string{
+					headers.PartitionKeyRangeId: fmt.Sprintf("%d", i),
+				})
+				assert.Equal(t, http.StatusOK, status)
+
+				var response struct {
+					Count int `json:"_count"`
+				}
+				json.Unmarshal([]byte(body), &response)
+				if response.Count > 0 {
+					assert.Equal(t, 10, response.Count)
+					nonEmptyRanges++
+				}
+			}
+			assert.Equal(t, 1, nonEmptyRanges)
+		})
+	})
+
+	runTestsWithPresets(t, "Test_Collections_PartitionKeyValidation", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		ts.DataStore.CreateDatabase(datastore.Database{ID: testDatabaseName})
+		databaseClient, err := client.NewDatabase(testDatabaseName)
+		assert.Nil(t, err)
+
+		testCases := []struct {
+			name       string
+			definition azcosmos.PartitionKeyDefinition
+		}{
+			{"Should reject too many MultiHash paths", azcosmos.PartitionKeyDefinition{
+				Kind:    azcosmos.PartitionKeyKindMultiHash,
+				Paths:   []string{"/a", "/b", "/c", "/d"},
+				Version: 2,
+			}},
+			{"Should reject multiple Hash paths", azcosmos.PartitionKeyDefinition{
+				Kind:  azcosmos.PartitionKeyKindHash,
+				Paths: []string{"/a", "/b"},
+			}},
+			{"Should reject MultiHash version 1", azcosmos.PartitionKeyDefinition{
+				Kind:    azcosmos.PartitionKeyKindMultiHash,
+				Paths:   []string{"/a", "/b"},
+				Version: 1,
+			}},
+			{"Should reject duplicate paths", azcosmos.PartitionKeyDefinition{
+				Kind:    azcosmos.PartitionKeyKindMultiHash,
+				Paths:   []string{"/a", "/a"},
+				Version: 2,
+			}},
+			{"Should reject invalid path", azcosmos.PartitionKeyDefinition{
+				Kind:  azcosmos.PartitionKeyKindHash,
+				Paths: []string{"a"},
+			}},
+		}
+
+		for _, testCase := range testCases {
+			t.Run(testCase.name, func(t *testing.T) {
+				_, err := databaseClient.CreateContainer(context.TODO(), azcosmos.ContainerProperties{
+					ID:                     testCollectionName,
+					PartitionKeyDefinition: testCase.definition,
+				}, &azcosmos.CreateContainerOptions{})
+				assert.NotNil(t, err)
+
+				var respErr *azcore.ResponseError
+				if errors.As(err, &respErr) {
+					assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+				} else {
+					panic(err)
+				}
+			})
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_PartitionKey(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_PartitionKey", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+
+		t.Run("Should store documents with the same id in different partitions", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "456", "name": "other"})
+			_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), item, nil)
+			assert.Nil(t, err)
+
+			first, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+			assert.Nil(t, err)
+			second, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "12345", nil)
+			assert.Nil(t, err)
+
+			var firstItem, secondItem map[string]interface{}
+			json.Unmarshal(first.Value, &firstItem)
+			json.Unmarshal(second.Value, &secondItem)
+			assert.Equal(t, "123", firstItem["pk"])
+			assert.Equal(t, "456", secondItem["pk"])
+			assert.Equal(t, "other", secondItem["name"])
+
+			_, err = collectionClient.DeleteItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "12345", nil)
+			assert.Nil(t, err)
+
+			_, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+			assert.Nil(t, err)
+		})
+
+		t.Run("Should match the exact id when reading without partition key", func(t *testing.T) {
+			ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "prefixed/12345", "pk": "000"})
+			defer ts.DataStore.DeleteDocument(testDatabaseName, testCollectionName, []interface{}{"000"}, "prefixed/12345")
+
+			document, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			assert.Equal(t, datastore.StatusOk, status)
+			assert.Equal(t, "123", document["pk"])
+		})
+
+		t.Run("Should return BadRequest when reading an id of several partitions without partition key", func(t *testing.T) {
+			ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "12345", "pk": "456"})
+			defer ts.DataStore.DeleteDocument(testDatabaseName, testCollectionName, []interface{}{"456"}, "12345")
+
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
+			assert.Equal(t, datastore.BadRequest, status)
+		})
+
+		t.Run("Should return NotFound when reading from another partition", func(t *testing.T) {
+			_, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "12345", nil)
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		})
+
+		t.Run("Should return BadRequest when partition key does not match the document", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "mismatch", "pk": "123"})
+			_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), item, nil)
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+				assert.Equal(t, "1001", respErr.RawResponse.Header.Get(headers.SubStatus))
+			} else {
+				panic(err)
+			}
+
+			_, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "mismatch", nil)
+			assert.NotNil(t, err)
+		})
+
+		t.Run("Should return BadRequest for malformed partition key header", func(t *testing.T) {
+			path := fmt.Sprintf("dbs/%s/colls/%s/docs/12345", testDatabaseName, testCollectionName)
+			status, _ := sendSignedRequest(t, ts, "GET", "docs", path, path, nil, map[string]string{
+				headers.PartitionKey: "not-json",
+			})
+			assert.Equal(t, http.StatusBadRequest, status)
+		})
+	})
+}
+
+func Test_Documents_DeleteByPartitionKey(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_DeleteByPartitionKey", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+		ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "11111", "pk": "123"})
+
+		path := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+		deleteByPartitionKey := func(partitionKey string) int {
+			requestHeaders := map[string]string{headers.IsPartitionKeyDelete: "true"}
+			if partitionKey != "" {
+				requestHeaders[headers.PartitionKey] = partitionKey
+			}
+
+			status, _ := sendSignedRequest(t, ts, "POST", "docs", path, path+"/docs", nil, requestHeaders)
+			return status
+		}
+
+		t.Run("Should delete all items of the logical partition", func(t *testing.T) {
+			assert.Equal(t, http.StatusOK, deleteByPartitionKey(`["123"]`))
+
+			testCosmosQuery(t, collectionClient, "SELECT VALUE c.id FROM c", nil, []interface{}{"67890"})
+		})
+
+		t.Run("Should delete partitions larger than a single transaction", func(t *testing.T) {
+			for i := 0; i < 2500; i++ {
+				ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": fmt.Sprintf("large-%d", i), "pk": "large"})
+			}
+
+			assert.Equal(t, http.StatusOK, deleteByPartitionKey(`["large"]`))
+
+			testCosmosQuery(t, collectionClient, "SELECT VALUE c.id FROM c WHERE c.pk = 'large'", nil, []interface{}{})
+		})
+
+		t.Run("Should succeed for empty logical partition", func(t *testing.T) {
+			assert.Equal(t, http.StatusOK, deleteByPartitionKey(`["999"]`))
+		})
+
+		t.Run("Should return BadRequest without partition key", func(t *testing.T) {
+			assert.Equal(t, http.StatusBadRequest, deleteByPartitionKey(""))
+		})
+	})
+}
@@ -0,0 +1,165 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_PatchOperations(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_PatchOperations", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+		partitionKey := azcosmos.NewPartitionKeyString("456")
+
+		patchItem := func(t *testing.T, patch azcosmos.PatchOperations) (map[string]interface{}, error) {
+			response, err := collectionClient.PatchItem(context.TODO(), partitionKey, "67890", patch, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			var item map[string]interface{}
+			json.Unmarshal(response.Value, &item)
+			return item, nil
+		}
+
+		assertStatusCode := func(t *testing.T, err error, statusCode int) {
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, statusCode, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		}
+
+		t.Run("Should create intermediate objects with set", func(t *testing.T) {
+			patch := azcosmos.PatchOperations{}
+			patch.AppendSet("/address/city/name", "Vilnius")
+
+			item, err := patchItem(t, patch)
+			assert.Nil(t, err)
+			assert.Equal(t, map[string]interface{}{"city": map[string]interface{}{"name": "Vilnius"}}, item["address"])
+		})
+
+		t.Run("Should insert into array with add and overwrite with set", func(t *testing.T) {
+			patch := azcosmos.PatchOperations{}
+			patch.AppendAdd("/arr/1", 100)
+			patch.AppendAdd("/arr/-", 200)
+			patch.AppendSet("/arr/0", 300)
+
+			item, err := patchItem(t, patch)
+			assert.Nil(t, err)
+			assert.Equal(t, []interface{}{300.0, 100.0, 7.0, 8.0, 200.0}, item["arr"])
+		})
+
+		t.Run("Should increment numbers", func(t *testing.T) {
+			patch := azcosmos.PatchOperations{}
+			patch.AppendIncrement("/counter", 5)
+			patch.AppendIncrement("/counter", -2)
+
+			item, err := patchItem(t, patch)
+			assert.Nil(t, err)
+			assert.Equal(t, 3.0, item["counter"])
+		})
+
+		t.Run("Should not lose concurrent increments", func(t *testing.T) {
+			var wg sync.WaitGroup
+			for i := 0; i < 20; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					patch := azcosmos.PatchOperations{}
+					patch.AppendIncrement("/concurrentCounter", 1)
+					_, err := collectionClient.PatchItem(context.TODO(), partitionKey, "67890", patch, nil)
+					assert.Nil(t, err)
+				}()
+			}
+			wg.Wait()
+
+			response, err := collectionClient.ReadItem(context.TODO(), partitionKey, "67890", nil)
+			assert.Nil(t, err)
+
+			var item map[string]interface{}
+			json.Unmarshal(response.Value, &item)
+			assert.Equal(t, 20.0, item["concurrentCounter"])
+		})
+
+		t.Run("Should return BadRequest for invalid operations", func(t *testing.T) {
+			removeMissing := azcosmos.PatchOperations{}
+			removeMissing.AppendRemove("/missing")
+
+			replaceMissing := azcosmos.PatchOperations{}
+			replaceMissing.AppendReplace("/missing", 1)
+
+			incrementString := azcosmos.PatchOperations{}
+			incrementString.AppendIncrement("/pk", 1)
+
+			addWithoutParent := azcosmos.PatchOperations{}
+			addWithoutParent.AppendAdd("/missing/child", 1)
+
+			tooManyOperations := azcosmos.PatchOperations{}
+			for i := 0; i < 11; i++ {
+				tooManyOperations.AppendSet(fmt.Sprintf("/field%d", i), i)
+			}
+
+			for _, patch := range []azcosmos.PatchOperations{removeMissing, replaceMissing, incrementString, addWithoutParent, tooManyOperations} {
+				_, err := patchItem(t, patch)
+				assert.NotNil(t, err)
+				assertStatusCode(t, err, http.StatusBadRequest)
+			}
+
+			item, err := collectionClient.ReadItem(context.TODO(), partitionKey, "67890", nil)
+			assert.Nil(t, err)
+			assert.NotContains(t, string(item.Value), "field0")
+		})
+
+		t.Run("Should apply patch when condition holds", func(t *testing.T) {
+			patch := azcosmos.PatchOperations{}
+			patch.SetCondition("FROM c WHERE c.isCool = true")
+			patch.AppendSet("/conditional", true)
+
+			item, err := patchItem(t, patch)
+			assert.Nil(t, err)
+			assert.Equal(t, true, item["conditional"])
+		})
+
+		t.Run("Should return PreconditionFailed when condition does not hold", func(t *testing.T) {
+			patch := azcosmos.PatchOperations{}
+			patch.SetCondition("FROM c WHERE c.isCool = false")
+			patch.AppendSet("/conditional", false)
+
+			_, err := patchItem(t, patch)
+			assert.NotNil(t, err)
+			assertStatusCode(t, err, http.StatusPreconditionFailed)
+		})
+
+		t.Run("Should move values", func(t *testing.T) {
+			path := fmt.Sprintf("dbs/%s/colls/%s/docs/67890", testDatabaseName, testCollectionName)
+			body, _ := json.Marshal(map[string]interface{}{
+				"operations": []map[string]interface{}{
+					{"op": "move", "from": "/isCool", "path": "/wasCool"},
+				},
+			})
+
+			status, responseBody := sendSignedRequest(t, ts, "PATCH", "docs", path, path, body, map[string]string{
+				headers.PartitionKey: `["456"]`,
+			})
+			assert.Equal(t, http.StatusOK, status)
+
+			var item map[string]interface{}
+			json.Unmarshal([]byte(responseBody), &item)
+			assert.Equal(t, true, item["wasCool"])
+			assert.NotContains(t, item, "isCool")
+		})
+	})
+}
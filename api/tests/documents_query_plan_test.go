@@ -0,0 +1,97 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	queryplan "github.com/pikami/cosmium/internal/query_plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func documents_QueryPlan(t *testing.T, ts *TestServer, query string) (int, queryplan.QueryPlan) {
+	path := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+	body, _ := json.Marshal(map[string]interface{}{"query": query})
+
+	status, responseBody := sendSignedRequest(t, ts, "POST", "docs", path, path+"/docs", body, map[string]string{
+		headers.IsQueryPlanRequest: "true",
+		headers.IsQuery:            "true",
+	})
+
+	var plan queryplan.QueryPlan
+	json.Unmarshal([]byte(responseBody), &plan)
+
+	return status, plan
+}
+
+func Test_Documents_QueryPlan(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_QueryPlan", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+
+		queryRewrittenQuery := func(t *testing.T, plan queryplan.QueryPlan) []map[string]interface{} {
+			query := strings.ReplaceAll(plan.QueryInfo.RewrittenQuery, "{documentdb-formattableorderbyquery-filter}", "true")
+			pager := collectionClient.NewQueryItemsPager(query, azcosmos.PartitionKey{}, &azcosmos.QueryOptions{})
+
+			response, err := pager.NextPage(context.TODO())
+			assert.Nil(t, err)
+
+			items := make([]map[string]interface{}, len(response.Items))
+			for i, item := range response.Items {
+				json.Unmarshal(item, &items[i])
+			}
+
+			return items
+		}
+
+		t.Run("Should return plan for ORDER BY query", func(t *testing.T) {
+			status, plan := documents_QueryPlan(t, ts, "SELECT c.id FROM c ORDER BY c.pk DESC")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, []string{"Descending"}, plan.QueryInfo.OrderBy)
+			assert.Equal(t, []string{"c.pk"}, plan.QueryInfo.OrderByExpressions)
+			assert.Equal(t, []queryplan.QueryRange{queryplan.FullRange}, plan.QueryRanges)
+
+			items := queryRewrittenQuery(t, plan)
+			assert.Len(t, items, 2)
+			assert.Equal(t, []interface{}{map[string]interface{}{"item": "456"}}, items[0]["orderByItems"])
+			assert.Equal(t, map[string]interface{}{"id": "67890"}, items[0]["payload"])
+			assert.NotEmpty(t, items[0]["_rid"])
+		})
+
+		t.Run("Should return plan for aggregate query", func(t *testing.T) {
+			status, plan := documents_QueryPlan(t, ts, "SELECT VALUE COUNT(1) FROM c")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, []string{"Count"}, plan.QueryInfo.Aggregates)
+			assert.True(t, plan.QueryInfo.HasSelectValue)
+
+			pager := collectionClient.NewQueryItemsPager(plan.QueryInfo.RewrittenQuery, azcosmos.PartitionKey{}, &azcosmos.QueryOptions{})
+			response, err := pager.NextPage(context.TODO())
+			assert.Nil(t, err)
+			assert.Equal(t, []byte(`[{"item":2}]`), response.Items[0])
+		})
+
+		t.Run("Should return plan for GROUP BY query", func(t *testing.T) {
+			status, plan := documents_QueryPlan(t, ts, "SELECT c.isCool, COUNT(1) AS total FROM c GROUP BY c.isCool")
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, []string{"c.isCool"}, plan.QueryInfo.GroupByExpressions)
+			assert.Equal(t, []string{"isCool", "total"}, plan.QueryInfo.GroupByAliases)
+
+			items := queryRewrittenQuery(t, plan)
+			assert.Len(t, items, 2)
+			for _, item := range items {
+				assert.Equal(t, map[string]interface{}{"item": float64(1)}, item["payload"].(map[string]interface{})["total"])
+			}
+		})
+
+		t.Run("Should return BadRequest for invalid query", func(t *testing.T) {
+			status, _ := documents_QueryPlan(t, ts, "SELECT * FROM c WHERE")
+			assert.Equal(t, http.StatusBadRequest, status)
+		})
+	})
+}
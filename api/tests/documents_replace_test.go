@@ -0,0 +1,112 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_Replace(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_Replace", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+		partitionKey := azcosmos.NewPartitionKeyString("123")
+
+		readItem := func(t *testing.T) map[string]interface{} {
+			response, err := collectionClient.ReadItem(context.TODO(), partitionKey, "12345", nil)
+			assert.Nil(t, err)
+
+			var item map[string]interface{}
+			json.Unmarshal(response.Value, &item)
+			return item
+		}
+
+		t.Run("Should keep resource id on replace", func(t *testing.T) {
+			original := readItem(t)
+
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": true})
+			_, err := collectionClient.ReplaceItem(context.TODO(), partitionKey, "12345", item, nil)
+			assert.Nil(t, err)
+
+			replaced := readItem(t)
+			assert.Equal(t, original["_rid"], replaced["_rid"])
+			assert.Equal(t, original["_self"], replaced["_self"])
+			assert.NotEqual(t, original["_etag"], replaced["_etag"])
+			assert.Equal(t, true, replaced["isCool"])
+		})
+
+		t.Run("Should keep resource id on upsert", func(t *testing.T) {
+			original := readItem(t)
+
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123", "isCool": false})
+			_, err := collectionClient.UpsertItem(context.TODO(), partitionKey, item, nil)
+			assert.Nil(t, err)
+
+			upserted := readItem(t)
+			assert.Equal(t, original["_rid"], upserted["_rid"])
+			assert.NotEqual(t, original["_etag"], upserted["_etag"])
+			assert.Equal(t, false, upserted["isCool"])
+		})
+
+		t.Run("Should reject replace with stale etag in data store", func(t *testing.T) {
+			original := readItem(t)
+
+			_, status := ts.DataStore.ReplaceDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "12345",
+				map[string]interface{}{"id": "12345", "pk": "123", "step": 1}, original["_etag"].(string))
+			assert.Equal(t, datastore.StatusOk, status)
+
+			_, status = ts.DataStore.ReplaceDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "12345",
+				map[string]interface{}{"id": "12345", "pk": "123", "step": 2}, original["_etag"].(string))
+			assert.Equal(t, datastore.PreconditionFailed, status)
+
+			_, status = ts.DataStore.UpsertDocument(testDatabaseName, testCollectionName, nil,
+				map[string]interface{}{"id": "12345", "pk": "123", "step": 3}, original["_etag"].(string))
+			assert.Equal(t, datastore.PreconditionFailed, status)
+
+			assert.Equal(t, float64(1), readItem(t)["step"])
+		})
+
+		t.Run("Should reject replace that moves the document out of the requested partition", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "456"})
+			_, err := collectionClient.ReplaceItem(context.TODO(), partitionKey, "12345", item, nil)
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+
+			assert.Equal(t, "123", readItem(t)["pk"])
+		})
+
+		t.Run("Should never expose a missing document to concurrent readers", func(t *testing.T) {
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					ts.DataStore.ReplaceDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "12345",
+						map[string]interface{}{"id": "12345", "pk": "123", "step": fmt.Sprint(i)}, "")
+				}
+			}()
+
+			for i := 0; i < 100; i++ {
+				_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"123"}, "12345")
+				assert.Equal(t, datastore.StatusOk, status)
+			}
+			wg.Wait()
+		})
+	})
+}
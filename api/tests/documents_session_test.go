@@ -0,0 +1,117 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_SessionTokens(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_SessionTokens", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+
+		t.Run("Should return session token of the write", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "session-1", "pk": "123"})
+			response, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), item, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, "0:-1#3", *response.SessionToken)
+
+			document, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "session-1")
+			assert.EqualValues(t, 3, document["_lsn"])
+
+			response, err = collectionClient.DeleteItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "session-1", nil)
+			assert.Nil(t, err)
+			assert.Equal(t, "0:-1#4", *response.SessionToken)
+		})
+
+		t.Run("Should read with session token of the last write", func(t *testing.T) {
+			sessionToken := "0:-1#4"
+			response, err := collectionClient.ReadItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				"12345",
+				&azcosmos.ItemOptions{SessionToken: &sessionToken},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, "0:-1#4", *response.SessionToken)
+		})
+
+		t.Run("Should return ReadSessionNotAvailable for session token ahead of the server", func(t *testing.T) {
+			sessionToken := "0:-1#100"
+			_, err := collectionClient.ReadItem(
+				context.TODO(),
+				azcosmos.NewPartitionKeyString("123"),
+				"12345",
+				&azcosmos.ItemOptions{SessionToken: &sessionToken},
+			)
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+				assert.Equal(t, "1002", respErr.RawResponse.Header.Get(headers.SubStatus))
+			} else {
+				panic(err)
+			}
+
+			path := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+			query, _ := json.Marshal(map[string]interface{}{"query": "SELECT * FROM c"})
+			status, _ := sendSignedRequest(t, ts, "POST", "docs", path, path+"/docs", query, map[string]string{
+				headers.Query:        "true",
+				headers.SessionToken: "0:100",
+			})
+			assert.Equal(t, http.StatusNotFound, status)
+		})
+
+		t.Run("Should report LSN of partition key ranges", func(t *testing.T) {
+			path := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+			status, body, responseHeaders := sendSignedRequestWithResponseHeaders(t, ts, "GET", "pkranges", path, path+"/pkranges", nil, nil)
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, "4", responseHeaders.Get(headers.LSN))
+			assert.Equal(t, "0:-1#4", responseHeaders.Get(headers.SessionToken))
+
+			var response struct {
+				PartitionKeyRanges []datastore.PartitionKeyRange `json:"PartitionKeyRanges"`
+			}
+			json.Unmarshal([]byte(body), &response)
+			assert.Equal(t, int64(4), response.PartitionKeyRanges[0].Lsn)
+		})
+
+		t.Run("Should track LSN per partition key range", func(t *testing.T) {
+			ts.DataStore.CreateCollection(testDatabaseName, datastore.Collection{
+				ID: "multi-range",
+				PartitionKey: datastore.CollectionPartitionKey{
+					Paths: []string{"/pk"},
+				},
+				PartitionCount: 4,
+			})
+			for i := 0; i < 8; i++ {
+				ts.DataStore.CreateDocument(testDatabaseName, "multi-range", nil, map[string]interface{}{
+					"id": fmt.Sprintf("%d", i),
+					"pk": fmt.Sprintf("%d", i),
+				})
+			}
+
+			partitionKeyRanges, status := ts.DataStore.GetPartitionKeyRanges(testDatabaseName, "multi-range")
+			assert.Equal(t, datastore.StatusOk, status)
+
+			var totalLSN int64
+			for _, partitionKeyRange := range partitionKeyRanges {
+				assert.Less(t, partitionKeyRange.Lsn, int64(8))
+				totalLSN += partitionKeyRange.Lsn
+			}
+			assert.Equal(t, int64(8), totalLSN)
+		})
+	})
+}
@@ -1,9 +1,13 @@
 package tests_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
 	"github.com/pikami/cosmium/api/config"
 	"github.com/pikami/cosmium/internal/datastore"
@@ -22,7 +26,7 @@ func documents_InitializeSingleDocumentDb(t *testing.T, ts *TestServer) *azcosmo
 			Paths: []string{"/pk"},
 		},
 	})
-	ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "regexmatch-test", "pk": "regexmatch-test"})
+	ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "regexmatch-test", "pk": "regexmatch-test"})
 
 	client, err := azcosmos.NewClientFromConnectionString(
 		fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.DefaultAccountKey),
@@ -69,5 +73,22 @@ func Test_Documents_RegexMatch(t *testing.T) {
 				},
 			)
 		})
+
+		t.Run("Should return BadRequest when REGEXMATCH() uses unsupported pattern", func(t *testing.T) {
+			pager := collectionClient.NewQueryItemsPager(
+				`SELECT VALUE REGEXMATCH("abcd", "a(?=b)")`,
+				azcosmos.PartitionKey{},
+				&azcosmos.QueryOptions{})
+
+			_, err := pager.NextPage(context.TODO())
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		})
 	})
 }
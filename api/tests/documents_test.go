@@ -67,8 +67,8 @@ func documents_InitializeDb(t *testing.T, ts *TestServer) *azcosmos.ContainerCli
 			Paths: []string{"/pk"},
 		},
 	})
-	ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "12345", "pk": "123", "isCool": false, "arr": []int{1, 2, 3}})
-	ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, map[string]interface{}{"id": "67890", "pk": "456", "isCool": true, "arr": []int{6, 7, 8}})
+	ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "12345", "pk": "123", "isCool": false, "arr": []int{1, 2, 3}})
+	ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{"id": "67890", "pk": "456", "isCool": true, "arr": []int{6, 7, 8}})
 
 	client, err := azcosmos.NewClientFromConnectionString(
 		fmt.Sprintf("AccountEndpoint=%s;AccountKey=%s", ts.URL, config.DefaultAccountKey),
@@ -180,6 +180,24 @@ func Test_Documents(t *testing.T) {
 			)
 		})
 
+		t.Run("Should return BadRequest for invalid query", func(t *testing.T) {
+			pager := collectionClient.NewQueryItemsPager(
+				"SELECT * FORM c",
+				azcosmos.PartitionKey{},
+				&azcosmos.QueryOptions{})
+
+			_, err := pager.NextPage(context.TODO())
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+				assert.Contains(t, respErr.Error(), `{\"severity\":\"Error\",\"location\":{\"start\":9,\"end\":13},\"code\":\"SC1001\",\"message\":\"Syntax error, incorrect syntax near 'FORM'.\"}`)
+			} else {
+				panic(err)
+			}
+		})
+
 		t.Run("Should handle parallel writes", func(t *testing.T) {
 			var wg sync.WaitGroup
 			rutineCount := 100
@@ -415,7 +433,7 @@ func Test_Documents(t *testing.T) {
 				panic(err)
 			}
 
-			document, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, "12345")
+			document, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
 			assert.Equal(t, datastore.StatusOk, status)
 			assert.Equal(t, false, document["isCool"])
 		})
@@ -447,7 +465,7 @@ func Test_Documents(t *testing.T) {
 			)
 			assert.Nil(t, err)
 
-			document, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, "12345")
+			document, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
 			assert.Equal(t, datastore.StatusOk, status)
 			assert.Equal(t, "999", document["pk"])
 			assert.Equal(t, true, document["isCool"])
@@ -459,10 +477,11 @@ func Test_Documents(t *testing.T) {
 
 		t.Run("Should execute CREATE transactional batch", func(t *testing.T) {
 			context := context.TODO()
-			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("pk"))
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("123"))
 
 			newItem := map[string]interface{}{
 				"id": "678901",
+				"pk": "123",
 			}
 			bytes, err := json.Marshal(newItem)
 			assert.Nil(t, err)
@@ -482,13 +501,13 @@ func Test_Documents(t *testing.T) {
 			json.Unmarshal(operationResponse.ResourceBody, &itemResponseBody)
 			assert.Equal(t, newItem["id"], itemResponseBody["id"])
 
-			createdDoc, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, newItem["id"].(string))
+			createdDoc, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, newItem["id"].(string))
 			assert.Equal(t, newItem["id"], createdDoc["id"])
 		})
 
 		t.Run("Should execute DELETE transactional batch", func(t *testing.T) {
 			context := context.TODO()
-			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("pk"))
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("123"))
 
 			batch.DeleteItem("12345", nil)
 			response, err := collectionClient.ExecuteTransactionalBatch(context, batch, &azcosmos.TransactionalBatchOptions{})
@@ -500,17 +519,18 @@ func Test_Documents(t *testing.T) {
 			assert.NotNil(t, operationResponse)
 			assert.Equal(t, int32(http.StatusNoContent), operationResponse.StatusCode)
 
-			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, "12345")
+			_, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, nil, "12345")
 			assert.Equal(t, datastore.StatusNotFound, status)
 		})
 
 		t.Run("Should execute REPLACE transactional batch", func(t *testing.T) {
 			context := context.TODO()
-			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("pk"))
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("456"))
 
 			newItem := map[string]interface{}{
-				"id": "67890",
-				"pk": "666",
+				"id":     "67890",
+				"pk":     "456",
+				"isCool": false,
 			}
 			bytes, err := json.Marshal(newItem)
 			assert.Nil(t, err)
@@ -531,14 +551,14 @@ func Test_Documents(t *testing.T) {
 			assert.Equal(t, newItem["id"], itemResponseBody["id"])
 			assert.Equal(t, newItem["pk"], itemResponseBody["pk"])
 
-			updatedDoc, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, newItem["id"].(string))
+			updatedDoc, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"456"}, newItem["id"].(string))
 			assert.Equal(t, newItem["id"], updatedDoc["id"])
-			assert.Equal(t, newItem["pk"], updatedDoc["pk"])
+			assert.Equal(t, false, updatedDoc["isCool"])
 		})
 
 		t.Run("Should execute UPSERT transactional batch", func(t *testing.T) {
 			context := context.TODO()
-			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("pk"))
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("666"))
 
 			newItem := map[string]interface{}{
 				"id": "678901",
@@ -563,14 +583,14 @@ func Test_Documents(t *testing.T) {
 			assert.Equal(t, newItem["id"], itemResponseBody["id"])
 			assert.Equal(t, newItem["pk"], itemResponseBody["pk"])
 
-			updatedDoc, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, newItem["id"].(string))
+			updatedDoc, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"666"}, newItem["id"].(string))
 			assert.Equal(t, newItem["id"], updatedDoc["id"])
 			assert.Equal(t, newItem["pk"], updatedDoc["pk"])
 		})
 
 		t.Run("Should execute READ transactional batch", func(t *testing.T) {
 			context := context.TODO()
-			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("pk"))
+			batch := collectionClient.NewTransactionalBatch(azcosmos.NewPartitionKeyString("456"))
 
 			batch.ReadItem("67890", nil)
 			response, err := collectionClient.ExecuteTransactionalBatch(context, batch, &azcosmos.TransactionalBatchOptions{})
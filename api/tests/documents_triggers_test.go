@@ -0,0 +1,138 @@
+package tests_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_Triggers(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_Triggers", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+		createTrigger := func(id string, triggerType datastore.TriggerType, operation datastore.TriggerOperation, body string) {
+			ts.DataStore.CreateTrigger(testDatabaseName, testCollectionName, datastore.Trigger{
+				ID:               id,
+				TriggerType:      triggerType,
+				TriggerOperation: operation,
+				Body:             body,
+			})
+		}
+
+		createTrigger("addOperation", datastore.Pre, datastore.All, `function () {
+			var request = getContext().getRequest();
+			var document = request.getBody();
+			document.operation = request.getOperationType();
+			request.setBody(document);
+		}`)
+		createTrigger("rejectDelete", datastore.Pre, datastore.Delete, `function () {
+			throw new Error("deletes are not allowed");
+		}`)
+		createTrigger("failAfterWrite", datastore.Post, datastore.All, `function () {
+			var document = getContext().getResponse().getBody();
+			if (document.fail) throw new Error("rejected " + document.id);
+		}`)
+
+		t.Run("Should run pre-trigger on create", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "trigger-created", "pk": "123"})
+			_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), item,
+				&azcosmos.ItemOptions{PreTriggers: []string{"addOperation"}})
+			assert.Nil(t, err)
+
+			response, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "trigger-created", nil)
+			assert.Nil(t, err)
+
+			var document map[string]interface{}
+			json.Unmarshal(response.Value, &document)
+			assert.Equal(t, "Create", document["operation"])
+		})
+
+		t.Run("Should run pre-trigger on replace", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "12345", "pk": "123"})
+			response, err := collectionClient.ReplaceItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", item,
+				&azcosmos.ItemOptions{PreTriggers: []string{"addOperation"}, EnableContentResponseOnWrite: true})
+			assert.Nil(t, err)
+
+			var document map[string]interface{}
+			json.Unmarshal(response.Value, &document)
+			assert.Equal(t, "Replace", document["operation"])
+		})
+
+		t.Run("Should abort write when post-trigger fails", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "trigger-rejected", "pk": "123", "fail": true})
+			_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), item,
+				&azcosmos.ItemOptions{PostTriggers: []string{"failAfterWrite"}})
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+				assert.Contains(t, respErr.Error(), "rejected trigger-rejected")
+			} else {
+				panic(err)
+			}
+
+			_, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "trigger-rejected", nil)
+			assert.NotNil(t, err)
+		})
+
+		t.Run("Should keep previous version when post-trigger fails on replace", func(t *testing.T) {
+			originalDocument, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"456"}, "67890")
+			originalEtag, originalLsn := originalDocument["_etag"], originalDocument["_lsn"]
+
+			item, _ := json.Marshal(map[string]interface{}{"id": "67890", "pk": "456", "fail": true})
+			_, err := collectionClient.ReplaceItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "67890", item,
+				&azcosmos.ItemOptions{PostTriggers: []string{"failAfterWrite"}})
+			assert.NotNil(t, err)
+
+			document, status := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"456"}, "67890")
+			assert.Equal(t, datastore.StatusOk, status)
+			assert.Nil(t, document["fail"])
+			assert.Equal(t, originalEtag, document["_etag"])
+			assert.EqualValues(t, originalLsn, document["_lsn"])
+		})
+
+		t.Run("Should abort delete when pre-trigger fails", func(t *testing.T) {
+			_, err := collectionClient.DeleteItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "67890",
+				&azcosmos.ItemOptions{PreTriggers: []string{"rejectDelete"}})
+			assert.NotNil(t, err)
+
+			_, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("456"), "67890", nil)
+			assert.Nil(t, err)
+		})
+
+		t.Run("Should reject trigger with mismatched operation", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "trigger-mismatch", "pk": "123"})
+			_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), item,
+				&azcosmos.ItemOptions{PreTriggers: []string{"rejectDelete"}})
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		})
+
+		t.Run("Should return NotFound for missing trigger", func(t *testing.T) {
+			item, _ := json.Marshal(map[string]interface{}{"id": "trigger-missing", "pk": "123"})
+			_, err := collectionClient.CreateItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), item,
+				&azcosmos.ItemOptions{PreTriggers: []string{"missing"}})
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+			} else {
+				panic(err)
+			}
+		})
+	})
+}
@@ -0,0 +1,57 @@
+package tests_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Documents_UserDefinedFunctions(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_Documents_UserDefinedFunctions", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+		ts.DataStore.CreateUserDefinedFunction(testDatabaseName, testCollectionName, datastore.UserDefinedFunction{
+			ID:   "sum",
+			Body: "function (values) { return values.reduce(function (a, b) { return a + b; }, 0); }",
+		})
+		ts.DataStore.CreateUserDefinedFunction(testDatabaseName, testCollectionName, datastore.UserDefinedFunction{
+			ID:   "fail",
+			Body: "function () { throw new Error('boom'); }",
+		})
+
+		t.Run("Should call user defined function in query", func(t *testing.T) {
+			testCosmosQuery(t, collectionClient,
+				`SELECT c.id, udf.sum(c.arr) AS total FROM c WHERE udf.sum(c.arr) > 10 ORDER BY c.id`,
+				nil,
+				[]interface{}{
+					map[string]interface{}{"id": "67890", "total": 21.0},
+				},
+			)
+		})
+
+		t.Run("Should return BadRequest when user defined function throws", func(t *testing.T) {
+			pager := collectionClient.NewQueryItemsPager(
+				`SELECT udf.fail() FROM c`,
+				azcosmos.PartitionKey{},
+				&azcosmos.QueryOptions{})
+
+			_, err := pager.NextPage(context.TODO())
+			assert.NotNil(t, err)
+
+			var respErr *azcore.ResponseError
+			if errors.As(err, &respErr) {
+				assert.Equal(t, http.StatusBadRequest, respErr.StatusCode)
+				assert.Contains(t, respErr.Error(), "Exception = Error: boom")
+			} else {
+				panic(err)
+			}
+		})
+	})
+}
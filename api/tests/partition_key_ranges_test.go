@@ -0,0 +1,126 @@
+package tests_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/headers"
+	continuationtoken "github.com/pikami/cosmium/internal/continuation_token"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_PartitionKeyRanges(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_PartitionKeyRanges", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		const documentCount = 40
+
+		ts.DataStore.CreateDatabase(datastore.Database{ID: testDatabaseName})
+		ts.DataStore.CreateCollection(testDatabaseName, datastore.Collection{
+			ID: testCollectionName,
+			PartitionKey: datastore.CollectionPartitionKey{
+				Paths: []string{"/pk"},
+			},
+			PartitionCount: 4,
+		})
+		for i := 0; i < documentCount; i++ {
+			ts.DataStore.CreateDocument(testDatabaseName, testCollectionName, nil, map[string]interface{}{
+				"id": fmt.Sprintf("%d", i),
+				"pk": fmt.Sprintf("pk-%d", i),
+			})
+		}
+
+		collectionPath := fmt.Sprintf("dbs/%s/colls/%s", testDatabaseName, testCollectionName)
+
+		var partitionKeyRanges []datastore.PartitionKeyRange
+		t.Run("Should split the hash space between partition key ranges", func(t *testing.T) {
+			status, body := sendSignedRequest(t, ts, "GET", "pkranges", collectionPath, collectionPath+"/pkranges", nil, nil)
+			assert.Equal(t, http.StatusOK, status)
+
+			var response struct {
+				PartitionKeyRanges []datastore.PartitionKeyRange `json:"PartitionKeyRanges"`
+			}
+			json.Unmarshal([]byte(body), &response)
+			partitionKeyRanges = response.PartitionKeyRanges
+
+			assert.Len(t, partitionKeyRanges, 4)
+			assert.Equal(t, "", partitionKeyRanges[0].MinInclusive)
+			assert.Equal(t, "FF", partitionKeyRanges[3].MaxExclusive)
+			for i := 1; i < len(partitionKeyRanges); i++ {
+				assert.Equal(t, fmt.Sprintf("%d", i), partitionKeyRanges[i].ID)
+				assert.Equal(t, partitionKeyRanges[i-1].MaxExclusive, partitionKeyRanges[i].MinInclusive)
+			}
+		})
+
+		t.Run("Should read feed of a single partition key range", func(t *testing.T) {
+			seenIds := map[string]bool{}
+			for _, partitionKeyRange := range partitionKeyRanges {
+				status, body := sendSignedRequest(t, ts, "GET", "docs", collectionPath, collectionPath+"/docs", nil, map[string]string{
+					headers.PartitionKeyRangeId: partitionKeyRange.ID,
+				})
+				assert.Equal(t, http.StatusOK, status)
+
+				var response struct {
+					Documents []map[string]interface{} `json:"Documents"`
+				}
+				json.Unmarshal([]byte(body), &response)
+				assert.NotEmpty(t, response.Documents)
+
+				for _, document := range response.Documents {
+					id := document["id"].(string)
+					assert.False(t, seenIds[id])
+					seenIds[id] = true
+				}
+			}
+
+			assert.Len(t, seenIds, documentCount)
+		})
+
+		t.Run("Should query a single partition key range", func(t *testing.T) {
+			total := 0
+			for _, partitionKeyRange := range partitionKeyRanges {
+				query, _ := json.Marshal(map[string]interface{}{"query": "SELECT VALUE COUNT(1) FROM c"})
+				status, body := sendSignedRequest(t, ts, "POST", "docs", collectionPath, collectionPath+"/docs", query, map[string]string{
+					headers.IsQuery:             "true",
+					headers.PartitionKeyRangeId: partitionKeyRange.ID,
+				})
+				assert.Equal(t, http.StatusOK, status)
+
+				var response struct {
+					Documents []int `json:"Documents"`
+				}
+				json.Unmarshal([]byte(body), &response)
+				total += response.Documents[0]
+			}
+
+			assert.Equal(t, documentCount, total)
+		})
+
+		t.Run("Should return continuation token scoped to the partition key range", func(t *testing.T) {
+			partitionKeyRange := partitionKeyRanges[2]
+			query, _ := json.Marshal(map[string]interface{}{"query": "SELECT * FROM c"})
+
+			status, _, responseHeaders := sendSignedRequestWithResponseHeaders(t, ts, "POST", "docs", collectionPath, collectionPath+"/docs", query, map[string]string{
+				headers.IsQuery:             "true",
+				headers.PartitionKeyRangeId: partitionKeyRange.ID,
+				headers.MaxItemCount:        "1",
+			})
+			assert.Equal(t, http.StatusOK, status)
+
+			token := continuationtoken.FromString(responseHeaders.Get(headers.ContinuationToken))
+			assert.Equal(t, partitionKeyRange.MinInclusive, token.Range.Min)
+			assert.Equal(t, partitionKeyRange.MaxExclusive, token.Range.Max)
+		})
+
+		t.Run("Should return Gone for unknown partition key range", func(t *testing.T) {
+			status, _ := sendSignedRequest(t, ts, "GET", "docs", collectionPath, collectionPath+"/docs", nil, map[string]string{
+				headers.PartitionKeyRangeId: "42",
+			})
+			assert.Equal(t, http.StatusGone, status)
+		})
+	})
+}
@@ -0,0 +1,216 @@
+package tests_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/data/azcosmos"
+	"github.com/pikami/cosmium/api/config"
+	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/authentication"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/stretchr/testify/assert"
+)
+
+// sendSignedRequest sends a raw request for operations that are not supported by the Go SDK
+func sendSignedRequest(
+	t *testing.T,
+	ts *TestServer,
+	method string,
+	resourceType string,
+	resourceLink string,
+	path string,
+	body []byte,
+	requestHeaders map[string]string,
+) (int, string) {
+	status, responseBody, _ := sendSignedRequestWithResponseHeaders(t, ts, method, resourceType, resourceLink, path, body, requestHeaders)
+	return status, responseBody
+}
+
+func sendSignedRequestWithResponseHeaders(
+	t *testing.T,
+	ts *TestServer,
+	method string,
+	resourceType string,
+	resourceLink string,
+	path string,
+	body []byte,
+	requestHeaders map[string]string,
+) (int, string, http.Header) {
+	date := time.Now().Format(time.RFC1123)
+	signature := authentication.GenerateSignature(method, resourceType, resourceLink, date, config.DefaultAccountKey)
+
+	req, _ := http.NewRequest(method, ts.URL+"/"+path, bytes.NewReader(body))
+	req.Header.Add(headers.XDate, date)
+	req.Header.Add(headers.Authorization, "sig="+url.QueryEscape(signature))
+	for name, value := range requestHeaders {
+		req.Header.Add(name, value)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	defer res.Body.Close()
+
+	responseBody, err := io.ReadAll(res.Body)
+	assert.Nil(t, err)
+
+	return res.StatusCode, string(responseBody), res.Header
+}
+
+// The Go SDK does not support stored procedures, so they are executed with raw requests
+func storedProcedures_Execute(t *testing.T, ts *TestServer, sprocId string, partitionKey string, params []interface{}) (int, string) {
+	path := fmt.Sprintf("dbs/%s/colls/%s/sprocs/%s", testDatabaseName, testCollectionName, sprocId)
+	body, _ := json.Marshal(params)
+
+	requestHeaders := map[string]string{}
+	if partitionKey != "" {
+		requestHeaders[headers.PartitionKey] = partitionKey
+	}
+
+	return sendSignedRequest(t, ts, "POST", "sprocs", path, path, body, requestHeaders)
+}
+
+func Test_StoredProcedures_Execute(t *testing.T) {
+	presets := []testPreset{PresetJsonStore, PresetBadgerStore}
+
+	runTestsWithPresets(t, "Test_StoredProcedures_Execute", presets, func(t *testing.T, ts *TestServer, client *azcosmos.Client) {
+		collectionClient := documents_InitializeDb(t, ts)
+		createStoredProcedure := func(id string, body string) {
+			ts.DataStore.CreateStoredProcedure(testDatabaseName, testCollectionName, datastore.StoredProcedure{ID: id, Body: body})
+		}
+
+		createStoredProcedure("echo", `function (name) {
+			getContext().getResponse().setBody("Hello, " + name);
+		}`)
+		createStoredProcedure("createAndRead", `function (id) {
+			var collection = getContext().getCollection();
+			collection.createDocument(collection.getSelfLink(), { id: id, pk: "123" }, {}, function (err, created) {
+				if (err) throw err;
+				collection.readDocument(collection.getAltLink() + "/docs/" + created.id, {}, function (err, read) {
+					if (err) throw err;
+					getContext().getResponse().setBody(read.id);
+				});
+			});
+		}`)
+		createStoredProcedure("query", `function () {
+			var collection = getContext().getCollection();
+			collection.queryDocuments(collection.getSelfLink(), "SELECT VALUE c.id FROM c", {}, function (err, documents) {
+				if (err) throw err;
+				getContext().getResponse().setBody(documents);
+			});
+		}`)
+		createStoredProcedure("createAndThrow", `function () {
+			var collection = getContext().getCollection();
+			collection.createDocument(collection.getSelfLink(), { id: "rolled-back", pk: "123" });
+			collection.deleteDocument(collection.getAltLink() + "/docs/12345");
+			throw new Error("abort");
+		}`)
+		createStoredProcedure("readOtherPartition", `function () {
+			var collection = getContext().getCollection();
+			collection.readDocument(collection.getAltLink() + "/docs/67890");
+		}`)
+		createStoredProcedure("readByResourceId", `function (rid) {
+			var collection = getContext().getCollection();
+			collection.readDocument(collection.getAltLink() + "/docs/" + rid, {}, function (err, read) {
+				if (err) throw err;
+				getContext().getResponse().setBody(read.id);
+			});
+		}`)
+
+		t.Run("Should return response body", func(t *testing.T) {
+			status, body := storedProcedures_Execute(t, ts, "echo", `["123"]`, []interface{}{"World"})
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, `"Hello, World"`, body)
+		})
+
+		t.Run("Should create and read document", func(t *testing.T) {
+			status, body := storedProcedures_Execute(t, ts, "createAndRead", `["123"]`, []interface{}{"sproc-created"})
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, `"sproc-created"`, body)
+
+			_, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "sproc-created", nil)
+			assert.Nil(t, err)
+		})
+
+		t.Run("Should only query documents in partition", func(t *testing.T) {
+			status, body := storedProcedures_Execute(t, ts, "query", `["456"]`, nil)
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, `["67890"]`, body)
+		})
+
+		t.Run("Should roll back changes when script throws", func(t *testing.T) {
+			status, body := storedProcedures_Execute(t, ts, "createAndThrow", `["123"]`, nil)
+			assert.Equal(t, http.StatusBadRequest, status)
+			assert.Contains(t, body, "Exception = Error: abort")
+
+			_, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "rolled-back", nil)
+			assert.NotNil(t, err)
+
+			_, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+			assert.Nil(t, err)
+		})
+
+		t.Run("Should not read document from another partition", func(t *testing.T) {
+			status, _ := storedProcedures_Execute(t, ts, "readOtherPartition", `["123"]`, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+		})
+
+		t.Run("Should only read documents by resource id in partition", func(t *testing.T) {
+			document, _ := ts.DataStore.GetDocument(testDatabaseName, testCollectionName, []interface{}{"456"}, "67890")
+			resourceId := document["_rid"].(string)
+
+			status, body := storedProcedures_Execute(t, ts, "readByResourceId", `["456"]`, []interface{}{resourceId})
+			assert.Equal(t, http.StatusOK, status)
+			assert.Equal(t, `"67890"`, body)
+
+			status, _ = storedProcedures_Execute(t, ts, "readByResourceId", `["123"]`, []interface{}{resourceId})
+			assert.Equal(t, http.StatusNotFound, status)
+		})
+
+		t.Run("Should return BadRequest without partition key", func(t *testing.T) {
+			status, _ := storedProcedures_Execute(t, ts, "echo", "", []interface{}{"World"})
+			assert.Equal(t, http.StatusBadRequest, status)
+		})
+
+		t.Run("Should not block reads while running", func(t *testing.T) {
+			createStoredProcedure("slowCreate", `function () {
+				var collection = getContext().getCollection();
+				collection.createDocument(collection.getSelfLink(), { id: "slow-created", pk: "123" });
+				var end = Date.now() + 1000;
+				while (Date.now() < end) {}
+			}`)
+
+			done := make(chan int)
+			go func() {
+				status, _ := storedProcedures_Execute(t, ts, "slowCreate", `["123"]`, nil)
+				done <- status
+			}()
+			time.Sleep(200 * time.Millisecond)
+
+			start := time.Now()
+			_, err := collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "12345", nil)
+			assert.Nil(t, err)
+			assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+			_, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "slow-created", nil)
+			assert.NotNil(t, err)
+
+			assert.Equal(t, http.StatusOK, <-done)
+
+			_, err = collectionClient.ReadItem(context.TODO(), azcosmos.NewPartitionKeyString("123"), "slow-created", nil)
+			assert.Nil(t, err)
+		})
+
+		t.Run("Should return NotFound for missing stored procedure", func(t *testing.T) {
+			status, _ := storedProcedures_Execute(t, ts, "missing", `["123"]`, nil)
+			assert.Equal(t, http.StatusNotFound, status)
+		})
+	})
+}
@@ -4,33 +4,6 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-var QueryPlanResponse = gin.H{
-	"partitionedQueryExecutionInfoVersion": 2,
-	"queryInfo": map[string]interface{}{
-		"distinctType":                "None",
-		"top":                         nil,
-		"offset":                      nil,
-		"limit":                       nil,
-		"orderBy":                     []interface{}{},
-		"orderByExpressions":          []interface{}{},
-		"groupByExpressions":          []interface{}{},
-		"groupByAliases":              []interface{}{},
-		"aggregates":                  []interface{}{},
-		"groupByAliasToAggregateType": map[string]interface{}{},
-		"rewrittenQuery":              "",
-		"hasSelectValue":              false,
-		"dCountInfo":                  nil,
-	},
-	"queryRanges": []interface{}{
-		map[string]interface{}{
-			"min":            "",
-			"max":            "FF",
-			"isMinInclusive": true,
-			"isMaxInclusive": false,
-		},
-	},
-}
-
 var UnknownErrorResponse = gin.H{"message": "Unknown error"}
 var NotFoundResponse = gin.H{"message": "NotFound"}
 var ConflictResponse = gin.H{"message": "Conflict"}
@@ -39,3 +12,19 @@ var PreconditionFailedResponse = gin.H{
 	"code":    "PreconditionFailed",
 	"message": "Operation cannot be performed because one of the specified precondition is not met.",
 }
+var PartitionKeyMismatchResponse = gin.H{
+	"code":    "BadRequest",
+	"message": "PartitionKey extracted from document doesn't match the one specified in the header. Learn more: https://aka.ms/CosmosDB/sql/errors/wrong-pk-value",
+}
+var PartitionKeyRangeGoneResponse = gin.H{
+	"code":    "Gone",
+	"message": "The requested partition key range is gone.",
+}
+var ReadSessionNotAvailableResponse = gin.H{
+	"code":    "NotFound",
+	"message": "The read session is not available for the input session token.",
+}
+var InvalidPartitionKeyResponse = gin.H{
+	"code":    "BadRequest",
+	"message": "Partition key provided either doesn't correspond to definition in the collection or doesn't match partition key field values specified in the document.",
+}
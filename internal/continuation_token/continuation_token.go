@@ -34,6 +34,11 @@ type ContinuationToken struct {
 }
 
 func Generate(resourceid string, pageIndex int, totalResults int) ContinuationToken {
+	return GenerateForRange(resourceid, pageIndex, totalResults, "", "FF")
+}
+
+// GenerateForRange creates a continuation token scoped to a single partition key range
+func GenerateForRange(resourceid string, pageIndex int, totalResults int, minRange string, maxRange string) ContinuationToken {
 	ct := ContinuationToken{}
 	ct.Token.ResourceId = resourceid
 	ct.Token.PageIndex = pageIndex
@@ -42,8 +47,8 @@ func Generate(resourceid string, pageIndex int, totalResults int) ContinuationTo
 	ct.Token.IEO = 65567
 	ct.Token.QCF = 8
 	ct.Token.LR = 1
-	ct.Range.Min = ""
-	ct.Range.Max = "FF"
+	ct.Range.Min = minRange
+	ct.Range.Max = maxRange
 
 	return ct
 }
@@ -33,3 +33,14 @@ func Test_GenerateDefault(t *testing.T) {
 	assert.Equal(t, 0, token.Token.PageIndex)
 	assert.Equal(t, 0, token.Token.TotalResults)
 }
+
+func Test_GenerateForRange(t *testing.T) {
+	token := GenerateForRange("test-resource-id", 1, 100, "1FFFFFFFFFFFFFFF", "FF")
+	assert.Equal(t, "1FFFFFFFFFFFFFFF", token.Range.Min)
+	assert.Equal(t, "FF", token.Range.Max)
+
+	parsedToken := FromString(token.ToString())
+	assert.Equal(t, "1FFFFFFFFFFFFFFF", parsedToken.Range.Min)
+	assert.Equal(t, "FF", parsedToken.Range.Max)
+	assert.Equal(t, 100, parsedToken.Token.TotalResults)
+}
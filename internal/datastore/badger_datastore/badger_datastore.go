@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"log"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/badger/v4"
@@ -16,6 +17,12 @@ type BadgerDataStore struct {
 	gcTicker  *time.Ticker
 	gcDone    chan struct{}
 	gcStopped chan struct{}
+
+	// Cache of partition key range LSNs by their key
+	lsns     map[string]int64
+	lsnMutex sync.Mutex
+	// Serializes document transactions, so that they don't conflict on the LSN counters
+	documentMutex sync.Mutex
 }
 
 type BadgerDataStoreOptions struct {
@@ -42,6 +49,7 @@ func NewBadgerDataStore(options BadgerDataStoreOptions) *BadgerDataStore {
 		gcTicker:  gcTicker,
 		gcDone:    make(chan struct{}),
 		gcStopped: make(chan struct{}),
+		lsns:      make(map[string]int64),
 	}
 
 	ds.initializeDataStore(options.InitialDataFilePath)
@@ -117,7 +125,7 @@ func (r *BadgerDataStore) initializeDataStore(initialDataFilePath string) {
 		for colName, colModel := range state.Collections[dbName] {
 			r.CreateCollection(dbName, colModel)
 			for _, docModel := range state.Documents[dbName][colName] {
-				r.CreateDocument(dbName, colName, docModel)
+				r.CreateDocument(dbName, colName, nil, docModel)
 			}
 
 			for _, triggerModel := range state.Triggers[dbName][colName] {
@@ -43,17 +43,29 @@ func (r *BadgerDataStore) GetCollection(databaseId string, collectionId string)
 	return collection, status
 }
 
-func (r *BadgerDataStore) DeleteCollection(databaseId string, collectionId string) datastore.DataStoreStatus {
+func (r *BadgerDataStore) DeleteCollection(databaseId string, collectionId string, etag string) datastore.DataStoreStatus {
 	collectionKey := generateCollectionKey(databaseId, collectionId)
 
 	txn := r.db.NewTransaction(true)
 	defer txn.Discard()
 
+	if etag != "" {
+		var collection datastore.Collection
+		if status := getKey(txn, collectionKey, &collection); status != datastore.StatusOk {
+			return status
+		}
+
+		if collection.ETag != etag {
+			return datastore.PreconditionFailed
+		}
+	}
+
 	prefixes := []string{
 		generateKey(resourceid.ResourceTypeDocument, databaseId, collectionId, "") + "/",
 		generateKey(resourceid.ResourceTypeTrigger, databaseId, collectionId, "") + "/",
 		generateKey(resourceid.ResourceTypeStoredProcedure, databaseId, collectionId, "") + "/",
 		generateKey(resourceid.ResourceTypeUserDefinedFunction, databaseId, collectionId, "") + "/",
+		generateKey(resourceid.ResourceTypePartitionKeyRange, databaseId, collectionId, "") + "/",
 	}
 	for _, prefix := range prefixes {
 		if err := deleteKeysByPrefix(txn, prefix); err != nil {
@@ -69,6 +81,8 @@ func (r *BadgerDataStore) DeleteCollection(databaseId string, collectionId strin
 		return datastore.Unknown
 	}
 
+	r.forgetLSNs(generateKey(resourceid.ResourceTypePartitionKeyRange, databaseId, collectionId, "") + "/")
+
 	return datastore.StatusOk
 }
 
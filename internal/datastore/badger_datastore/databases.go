@@ -31,18 +31,30 @@ func (r *BadgerDataStore) GetDatabase(id string) (datastore.Database, datastore.
 	return database, status
 }
 
-func (r *BadgerDataStore) DeleteDatabase(id string) datastore.DataStoreStatus {
+func (r *BadgerDataStore) DeleteDatabase(id string, etag string) datastore.DataStoreStatus {
 	databaseKey := generateDatabaseKey(id)
 
 	txn := r.db.NewTransaction(true)
 	defer txn.Discard()
 
+	if etag != "" {
+		var database datastore.Database
+		if status := getKey(txn, databaseKey, &database); status != datastore.StatusOk {
+			return status
+		}
+
+		if database.ETag != etag {
+			return datastore.PreconditionFailed
+		}
+	}
+
 	prefixes := []string{
 		generateKey(resourceid.ResourceTypeCollection, id, "", "") + "/",
 		generateKey(resourceid.ResourceTypeDocument, id, "", "") + "/",
 		generateKey(resourceid.ResourceTypeTrigger, id, "", "") + "/",
 		generateKey(resourceid.ResourceTypeStoredProcedure, id, "", "") + "/",
 		generateKey(resourceid.ResourceTypeUserDefinedFunction, id, "", "") + "/",
+		generateKey(resourceid.ResourceTypePartitionKeyRange, id, "", "") + "/",
 	}
 	for _, prefix := range prefixes {
 		if err := deleteKeysByPrefix(txn, prefix); err != nil {
@@ -58,6 +70,8 @@ func (r *BadgerDataStore) DeleteDatabase(id string) datastore.DataStoreStatus {
 		return datastore.Unknown
 	}
 
+	r.forgetLSNs(generateKey(resourceid.ResourceTypePartitionKeyRange, id, "", "") + "/")
+
 	return datastore.StatusOk
 }
 
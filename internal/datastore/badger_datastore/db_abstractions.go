@@ -15,6 +15,7 @@ const (
 	TriggerKeyPrefix             = "TRG:"
 	StoredProcedureKeyPrefix     = "SP:"
 	UserDefinedFunctionKeyPrefix = "UDF:"
+	PartitionKeyRangeKeyPrefix   = "PKR:"
 )
 
 func generateKey(
@@ -38,6 +39,8 @@ func generateKey(
 		result += StoredProcedureKeyPrefix
 	case resourceid.ResourceTypeUserDefinedFunction:
 		result += UserDefinedFunctionKeyPrefix
+	case resourceid.ResourceTypePartitionKeyRange:
+		result += PartitionKeyRangeKeyPrefix
 	}
 
 	if databaseId != "" {
@@ -63,8 +66,10 @@ func generateCollectionKey(databaseId string, collectionId string) string {
 	return generateKey(resourceid.ResourceTypeCollection, databaseId, collectionId, "")
 }
 
-func generateDocumentKey(databaseId string, collectionId string, documentId string) string {
-	return generateKey(resourceid.ResourceTypeDocument, databaseId, collectionId, documentId)
+// generateDocumentKey combines the partition key and id of a document,
+// documents are only unique within their logical partition
+func generateDocumentKey(databaseId string, collectionId string, partitionKey []interface{}, documentId string) string {
+	return generateKey(resourceid.ResourceTypeDocument, databaseId, collectionId, datastore.PartitionKeyString(partitionKey)+"/"+documentId)
 }
 
 func generateTriggerKey(databaseId string, collectionId string, triggerId string) string {
@@ -79,6 +84,11 @@ func generateUserDefinedFunctionKey(databaseId string, collectionId string, udfI
 	return generateKey(resourceid.ResourceTypeUserDefinedFunction, databaseId, collectionId, udfId)
 }
 
+// generatePartitionKeyRangeKey points to the LSN of a partition key range
+func generatePartitionKeyRangeKey(databaseId string, collectionId string, partitionKeyRangeId string) string {
+	return generateKey(resourceid.ResourceTypePartitionKeyRange, databaseId, collectionId, partitionKeyRangeId)
+}
+
 func insertKey(txn *badger.Txn, key string, value interface{}) datastore.DataStoreStatus {
 	_, err := txn.Get([]byte(key))
 	if err == nil {
@@ -111,6 +121,23 @@ func insertKey(txn *badger.Txn, key string, value interface{}) datastore.DataSto
 	return datastore.StatusOk
 }
 
+// setKey writes the value whether the key exists or not, committing is left to the caller
+func setKey(txn *badger.Txn, key string, value interface{}) datastore.DataStoreStatus {
+	buf, err := msgpack.Marshal(value)
+	if err != nil {
+		logger.ErrorLn("Error while encoding value:", err)
+		return datastore.Unknown
+	}
+
+	err = txn.Set([]byte(key), buf)
+	if err != nil {
+		logger.ErrorLn("Error while setting key:", err)
+		return datastore.Unknown
+	}
+
+	return datastore.StatusOk
+}
+
 func getKey(txn *badger.Txn, key string, value interface{}) datastore.DataStoreStatus {
 	item, err := txn.Get([]byte(key))
 	if err != nil {
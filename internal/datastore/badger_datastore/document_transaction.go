@@ -0,0 +1,292 @@
+package badgerdatastore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/google/uuid"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/internal/resourceid"
+)
+
+// documentTransaction stages all document changes in a single badger transaction
+type documentTransaction struct {
+	txn        *documentTxn
+	database   datastore.Database
+	collection datastore.Collection
+}
+
+func (r *BadgerDataStore) BeginDocumentTransaction(databaseId string, collectionId string) (datastore.DocumentTransaction, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil, status
+	}
+
+	return transaction, datastore.StatusOk
+}
+
+func (r *BadgerDataStore) beginDocumentTransaction(databaseId string, collectionId string) (*documentTransaction, datastore.DataStoreStatus) {
+	txn := r.newDocumentTxn()
+
+	var database datastore.Database
+	if status := getKey(txn.Txn, generateDatabaseKey(databaseId), &database); status != datastore.StatusOk {
+		txn.discard()
+		return nil, status
+	}
+
+	var collection datastore.Collection
+	if status := getKey(txn.Txn, generateCollectionKey(databaseId, collectionId), &collection); status != datastore.StatusOk {
+		txn.discard()
+		return nil, status
+	}
+
+	return &documentTransaction{
+		txn:        txn,
+		database:   database,
+		collection: collection,
+	}, datastore.StatusOk
+}
+
+func (t *documentTransaction) GetDocument(partitionKey []interface{}, documentId string) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := findDocumentKey(t.txn.Txn, t.database.ID, t.collection.ID, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	var document datastore.Document
+	status = getKey(t.txn.Txn, documentKey, &document)
+
+	return document, status
+}
+
+// GetDocumentIterator reads all documents up front, iterators left open would keep the transaction from ending
+func (t *documentTransaction) GetDocumentIterator() (datastore.DocumentIterator, datastore.DataStoreStatus) {
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = []byte(generateKey(resourceid.ResourceTypeDocument, t.database.ID, t.collection.ID, "") + "/")
+	it := t.txn.NewIterator(opts)
+	defer it.Close()
+
+	documents := make([]datastore.Document, 0)
+	for it.Rewind(); it.Valid(); it.Next() {
+		var document datastore.Document
+		if status := getKey(t.txn.Txn, string(it.Item().Key()), &document); status != datastore.StatusOk {
+			return nil, status
+		}
+
+		documents = append(documents, document)
+	}
+
+	return &documentListIterator{documents: documents}, datastore.StatusOk
+}
+
+func (t *documentTransaction) DeleteDocument(partitionKey []interface{}, documentId string, etag string) datastore.DataStoreStatus {
+	documentKey, status := findDocumentKey(t.txn.Txn, t.database.ID, t.collection.ID, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	var document datastore.Document
+	if status := getKey(t.txn.Txn, documentKey, &document); status != datastore.StatusOk {
+		return status
+	}
+
+	if etag != "" && document["_etag"] != etag {
+		return datastore.PreconditionFailed
+	}
+
+	if _, status := t.txn.nextLSN(t.database.ID, t.collection, datastore.GetDocumentPartitionKey(t.collection, document)); status != datastore.StatusOk {
+		return status
+	}
+
+	if err := t.txn.Delete([]byte(documentKey)); err != nil {
+		logger.ErrorLn("Error while deleting document:", err)
+		return datastore.Unknown
+	}
+
+	return datastore.StatusOk
+}
+
+func (t *documentTransaction) CreateDocument(partitionKey []interface{}, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := t.newDocumentKey(partitionKey, document)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	exists, err := keyExists(t.txn.Txn, documentKey)
+	if err != nil {
+		logger.ErrorLn("Error while checking if document exists:", err)
+		return datastore.Document{}, datastore.Unknown
+	}
+	if exists {
+		return datastore.Document{}, datastore.Conflict
+	}
+
+	return t.insertDocument(documentKey, document)
+}
+
+func (t *documentTransaction) ReplaceDocument(partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := findDocumentKey(t.txn.Txn, t.database.ID, t.collection.ID, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	return t.replaceDocument(documentKey, document, etag)
+}
+
+func (t *documentTransaction) UpsertDocument(partitionKey []interface{}, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := t.newDocumentKey(partitionKey, document)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	exists, err := keyExists(t.txn.Txn, documentKey)
+	if err != nil {
+		logger.ErrorLn("Error while checking if document exists:", err)
+		return datastore.Document{}, datastore.Unknown
+	}
+
+	if exists {
+		return t.replaceDocument(documentKey, document, etag)
+	}
+
+	if etag != "" {
+		return datastore.Document{}, datastore.PreconditionFailed
+	}
+
+	return t.insertDocument(documentKey, document)
+}
+
+func (t *documentTransaction) Commit() datastore.DataStoreStatus {
+	return t.txn.commit()
+}
+
+func (t *documentTransaction) Rollback() {
+	t.txn.discard()
+}
+
+// commitDocument commits the transaction when the document was written successfully
+func (t *documentTransaction) commitDocument(document datastore.Document, status datastore.DataStoreStatus) (datastore.Document, datastore.DataStoreStatus) {
+	if status != datastore.StatusOk {
+		return document, status
+	}
+
+	if status := t.Commit(); status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	return document, datastore.StatusOk
+}
+
+// newDocumentKey assigns an id to the document when it has none and returns the key the document is stored under
+func (t *documentTransaction) newDocumentKey(partitionKey []interface{}, document map[string]interface{}) (string, datastore.DataStoreStatus) {
+	documentId, ok := document["id"].(string)
+	if !ok || documentId == "" {
+		documentId = fmt.Sprint(uuid.New())
+		document["id"] = documentId
+	}
+
+	documentPartitionKey := datastore.GetDocumentPartitionKey(t.collection, document)
+	if partitionKey != nil && !datastore.PartitionKeysEqual(partitionKey, documentPartitionKey) {
+		return "", datastore.PartitionKeyMismatch
+	}
+
+	return generateDocumentKey(t.database.ID, t.collection.ID, documentPartitionKey, documentId), datastore.StatusOk
+}
+
+// insertDocument assigns the system properties of a new document and stores it,
+// the caller has to make sure that the key is free
+func (t *documentTransaction) insertDocument(documentKey string, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	document["_ts"] = time.Now().Unix()
+	document["_rid"] = resourceid.NewCombined(t.collection.ResourceID, resourceid.New(resourceid.ResourceTypeDocument))
+	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
+	document["_self"] = fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", t.database.ResourceID, t.collection.ResourceID, document["_rid"])
+
+	lsn, status := t.txn.nextLSN(t.database.ID, t.collection, datastore.GetDocumentPartitionKey(t.collection, document))
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+	document["_lsn"] = lsn
+
+	if status := setKey(t.txn.Txn, documentKey, document); status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	return document, datastore.StatusOk
+}
+
+// replaceDocument swaps the stored document with the new one while keeping its _rid and _self,
+// the document moves to another key when its id or partition key changes
+func (t *documentTransaction) replaceDocument(existingKey string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	var existingDocument datastore.Document
+	status := getKey(t.txn.Txn, existingKey, &existingDocument)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	if etag != "" && existingDocument["_etag"] != etag {
+		return datastore.Document{}, datastore.PreconditionFailed
+	}
+
+	documentId, ok := document["id"].(string)
+	if !ok || documentId == "" {
+		documentId = existingDocument["id"].(string)
+		document["id"] = documentId
+	}
+
+	documentPartitionKey := datastore.GetDocumentPartitionKey(t.collection, document)
+	documentKey := generateDocumentKey(t.database.ID, t.collection.ID, documentPartitionKey, documentId)
+	if documentKey != existingKey {
+		exists, err := keyExists(t.txn.Txn, documentKey)
+		if err != nil {
+			logger.ErrorLn("Error while checking if document exists:", err)
+			return datastore.Document{}, datastore.Unknown
+		}
+		if exists {
+			return datastore.Document{}, datastore.Conflict
+		}
+
+		if err := t.txn.Delete([]byte(existingKey)); err != nil {
+			logger.ErrorLn("Error while deleting document:", err)
+			return datastore.Document{}, datastore.Unknown
+		}
+	}
+
+	document["_ts"] = time.Now().Unix()
+	document["_rid"] = existingDocument["_rid"]
+	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
+	document["_self"] = existingDocument["_self"]
+
+	lsn, status := t.txn.nextLSN(t.database.ID, t.collection, documentPartitionKey)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+	document["_lsn"] = lsn
+
+	if status := setKey(t.txn.Txn, documentKey, document); status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	return document, datastore.StatusOk
+}
+
+// documentListIterator iterates documents that have already been read
+type documentListIterator struct {
+	documents []datastore.Document
+}
+
+func (i *documentListIterator) Next() (datastore.Document, datastore.DataStoreStatus) {
+	if len(i.documents) == 0 {
+		return datastore.Document{}, datastore.IterEOF
+	}
+
+	document := i.documents[0]
+	i.documents = i.documents[1:]
+
+	return document, datastore.StatusOk
+}
+
+func (i *documentListIterator) Close() {
+	i.documents = nil
+}
@@ -1,15 +1,18 @@
 package badgerdatastore
 
 import (
-	"fmt"
-	"time"
+	"encoding/json"
+	"strings"
 
-	"github.com/google/uuid"
+	"github.com/dgraph-io/badger/v4"
 	"github.com/pikami/cosmium/internal/datastore"
 	"github.com/pikami/cosmium/internal/logger"
 	"github.com/pikami/cosmium/internal/resourceid"
 )
 
+// deleteDocumentsBatchSize is the number of documents deleted in one transaction
+const deleteDocumentsBatchSize = 1000
+
 func (r *BadgerDataStore) GetAllDocuments(databaseId string, collectionId string) ([]datastore.Document, datastore.DataStoreStatus) {
 	txn := r.db.NewTransaction(false)
 	defer txn.Discard()
@@ -51,79 +54,189 @@ func (r *BadgerDataStore) GetDocumentIterator(databaseId string, collectionId st
 	return iter, datastore.StatusOk
 }
 
-func (r *BadgerDataStore) GetDocument(databaseId string, collectionId string, documentId string) (datastore.Document, datastore.DataStoreStatus) {
-	documentKey := generateDocumentKey(databaseId, collectionId, documentId)
-
+func (r *BadgerDataStore) GetDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) (datastore.Document, datastore.DataStoreStatus) {
 	txn := r.db.NewTransaction(false)
 	defer txn.Discard()
 
+	documentKey, status := findDocumentKey(txn, databaseId, collectionId, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
 	var document datastore.Document
-	status := getKey(txn, documentKey, &document)
+	status = getKey(txn, documentKey, &document)
 
 	return document, status
 }
 
-func (r *BadgerDataStore) DeleteDocument(databaseId string, collectionId string, documentId string) datastore.DataStoreStatus {
-	documentKey := generateDocumentKey(databaseId, collectionId, documentId)
+func (r *BadgerDataStore) DeleteDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) datastore.DataStoreStatus {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return status
+	}
+	defer transaction.Rollback()
 
-	txn := r.db.NewTransaction(true)
-	defer txn.Discard()
+	if status := transaction.DeleteDocument(partitionKey, documentId, ""); status != datastore.StatusOk {
+		return status
+	}
 
-	exists, err := keyExists(txn, documentKey)
-	if err != nil {
-		return datastore.Unknown
+	return transaction.Commit()
+}
+
+// DeleteDocumentsByPartitionKey deletes the documents in batches, so that large partitions don't exceed the
+// transaction size limit. A prefix of a hierarchical partition key deletes all partitions below it
+func (r *BadgerDataStore) DeleteDocumentsByPartitionKey(databaseId string, collectionId string, partitionKey []interface{}) datastore.DataStoreStatus {
+	for {
+		deletedCount, status := r.deleteDocumentsByPartitionKeyBatch(databaseId, collectionId, partitionKey)
+		if status != datastore.StatusOk {
+			return status
+		}
+
+		if deletedCount < deleteDocumentsBatchSize {
+			return datastore.StatusOk
+		}
+	}
+}
+
+func (r *BadgerDataStore) deleteDocumentsByPartitionKeyBatch(databaseId string, collectionId string, partitionKey []interface{}) (int, datastore.DataStoreStatus) {
+	txn := r.newDocumentTxn()
+	defer txn.discard()
+
+	var collection datastore.Collection
+	status := getKey(txn.Txn, generateCollectionKey(databaseId, collectionId), &collection)
+	if status != datastore.StatusOk {
+		return 0, status
 	}
-	if !exists {
-		return datastore.StatusNotFound
+
+	documentKeys := make([]string, 0)
+	// Partition key of a deleted document by the partition key range it is placed in
+	partitionKeyRanges := make(map[string][]interface{})
+
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = []byte(generateKey(resourceid.ResourceTypeDocument, databaseId, collectionId, "") + "/")
+	it := txn.NewIterator(opts)
+	for it.Rewind(); it.Valid() && len(documentKeys) < deleteDocumentsBatchSize; it.Next() {
+		documentKey := string(it.Item().KeyCopy(nil))
+
+		var document datastore.Document
+		if status := getKey(txn.Txn, documentKey, &document); status != datastore.StatusOk {
+			it.Close()
+			return 0, status
+		}
+
+		documentPartitionKey := datastore.GetDocumentPartitionKey(collection, document)
+		if !datastore.PartitionKeyHasPrefix(documentPartitionKey, partitionKey) {
+			continue
+		}
+
+		documentKeys = append(documentKeys, documentKey)
+		partitionKeyRanges[datastore.GetPartitionKeyRangeId(collection, documentPartitionKey)] = documentPartitionKey
 	}
+	it.Close()
 
-	err = txn.Delete([]byte(documentKey))
-	if err != nil {
-		logger.ErrorLn("Error while deleting document:", err)
-		return datastore.Unknown
+	for _, documentKey := range documentKeys {
+		if err := txn.Delete([]byte(documentKey)); err != nil {
+			logger.ErrorLn("Error while deleting document:", err)
+			return 0, datastore.Unknown
+		}
 	}
 
-	err = txn.Commit()
-	if err != nil {
-		logger.ErrorLn("Error while committing transaction:", err)
-		return datastore.Unknown
+	for _, documentPartitionKey := range partitionKeyRanges {
+		if _, status := txn.nextLSN(databaseId, collection, documentPartitionKey); status != datastore.StatusOk {
+			return 0, status
+		}
 	}
 
-	return datastore.StatusOk
+	return len(documentKeys), txn.commit()
 }
 
-func (r *BadgerDataStore) CreateDocument(databaseId string, collectionId string, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
-	txn := r.db.NewTransaction(true)
-	defer txn.Discard()
+func (r *BadgerDataStore) CreateDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+	defer transaction.Rollback()
+
+	return transaction.commitDocument(transaction.CreateDocument(partitionKey, document))
+}
 
-	var database datastore.Database
-	status := getKey(txn, generateDatabaseKey(databaseId), &database)
+func (r *BadgerDataStore) ReplaceDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
 	if status != datastore.StatusOk {
 		return datastore.Document{}, status
 	}
+	defer transaction.Rollback()
 
-	var collection datastore.Collection
-	status = getKey(txn, generateCollectionKey(databaseId, collectionId), &collection)
+	return transaction.commitDocument(transaction.ReplaceDocument(partitionKey, documentId, document, etag))
+}
+
+func (r *BadgerDataStore) UpsertDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
 	if status != datastore.StatusOk {
 		return datastore.Document{}, status
 	}
+	defer transaction.Rollback()
+
+	return transaction.commitDocument(transaction.UpsertDocument(partitionKey, document, etag))
+}
+
+// findDocumentKey looks up the key of a document, when the partition key is not
+// specified the document is looked up in all partitions of the collection
+func findDocumentKey(txn *badger.Txn, databaseId string, collectionId string, partitionKey []interface{}, documentId string) (string, datastore.DataStoreStatus) {
+	if partitionKey != nil {
+		documentKey := generateDocumentKey(databaseId, collectionId, partitionKey, documentId)
+		exists, err := keyExists(txn, documentKey)
+		if err != nil {
+			return "", datastore.Unknown
+		}
+		if !exists {
+			return "", datastore.StatusNotFound
+		}
+
+		return documentKey, datastore.StatusOk
+	}
 
-	var ok bool
-	var documentId string
-	if documentId, ok = document["id"].(string); !ok || documentId == "" {
-		documentId = fmt.Sprint(uuid.New())
-		document["id"] = documentId
+	prefix := generateKey(resourceid.ResourceTypeDocument, databaseId, collectionId, "") + "/"
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = []byte(prefix)
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	documentKey := ""
+	for it.Rewind(); it.Valid(); it.Next() {
+		key := string(it.Item().Key())
+		if keyDocumentId(strings.TrimPrefix(key, prefix)) != documentId {
+			continue
+		}
+
+		// Without the partition key the document is ambiguous when the id is used in several partitions
+		if documentKey != "" {
+			return "", datastore.BadRequest
+		}
+		documentKey = key
 	}
 
-	document["_ts"] = time.Now().Unix()
-	document["_rid"] = resourceid.NewCombined(collection.ResourceID, resourceid.New(resourceid.ResourceTypeDocument))
-	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
-	document["_self"] = fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", database.ResourceID, collection.ResourceID, document["_rid"])
+	if documentKey == "" {
+		return "", datastore.StatusNotFound
+	}
 
-	status = insertKey(txn, generateDocumentKey(databaseId, collectionId, documentId), document)
-	if status != datastore.StatusOk {
-		return datastore.Document{}, status
+	return documentKey, datastore.StatusOk
+}
+
+// keyDocumentId extracts the document id from the partition key and id part of a document key,
+// the partition key is decoded to find where it ends since its values may contain slashes
+func keyDocumentId(key string) string {
+	decoder := json.NewDecoder(strings.NewReader(key))
+	var partitionKey []interface{}
+	if err := decoder.Decode(&partitionKey); err != nil {
+		return ""
+	}
+
+	documentId, ok := strings.CutPrefix(key[decoder.InputOffset():], "/")
+	if !ok {
+		return ""
 	}
 
-	return document, datastore.StatusOk
+	return documentId
 }
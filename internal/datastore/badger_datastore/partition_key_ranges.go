@@ -1,53 +1,152 @@
 package badgerdatastore
 
 import (
-	"fmt"
+	"strconv"
+	"strings"
 
-	"github.com/google/uuid"
+	"github.com/dgraph-io/badger/v4"
 	"github.com/pikami/cosmium/internal/datastore"
-	"github.com/pikami/cosmium/internal/resourceid"
+	"github.com/pikami/cosmium/internal/logger"
 )
 
-// I have no idea what this is tbh
 func (r *BadgerDataStore) GetPartitionKeyRanges(databaseId string, collectionId string) ([]datastore.PartitionKeyRange, datastore.DataStoreStatus) {
-	databaseRid := databaseId
-	collectionRid := collectionId
-	var timestamp int64 = 0
-
 	txn := r.db.NewTransaction(false)
 	defer txn.Discard()
 
 	var database datastore.Database
 	status := getKey(txn, generateDatabaseKey(databaseId), &database)
 	if status != datastore.StatusOk {
-		databaseRid = database.ResourceID
+		return nil, status
 	}
 
 	var collection datastore.Collection
 	status = getKey(txn, generateCollectionKey(databaseId, collectionId), &collection)
 	if status != datastore.StatusOk {
-		collectionRid = collection.ResourceID
-		timestamp = collection.TimeStamp
-	}
-
-	pkrResourceId := resourceid.NewCombined(collectionRid, resourceid.New(resourceid.ResourceTypePartitionKeyRange))
-	pkrSelf := fmt.Sprintf("dbs/%s/colls/%s/pkranges/%s/", databaseRid, collectionRid, pkrResourceId)
-	etag := fmt.Sprintf("\"%s\"", uuid.New())
-
-	return []datastore.PartitionKeyRange{
-		{
-			ResourceID:         pkrResourceId,
-			ID:                 "0",
-			Etag:               etag,
-			MinInclusive:       "",
-			MaxExclusive:       "FF",
-			RidPrefix:          0,
-			Self:               pkrSelf,
-			ThroughputFraction: 1,
-			Status:             "online",
-			Parents:            []interface{}{},
-			TimeStamp:          timestamp,
-			Lsn:                17,
-		},
-	}, datastore.StatusOk
+		return nil, status
+	}
+
+	r.lsnMutex.Lock()
+	defer r.lsnMutex.Unlock()
+
+	lsns := make(map[string]int64)
+	for i := 0; i < datastore.GetPartitionCount(collection); i++ {
+		partitionKeyRangeId := strconv.Itoa(i)
+		lsn, status := r.loadLSN(generatePartitionKeyRangeKey(databaseId, collectionId, partitionKeyRangeId))
+		if status != datastore.StatusOk {
+			return nil, status
+		}
+		lsns[partitionKeyRangeId] = lsn
+	}
+
+	return datastore.NewPartitionKeyRanges(database.ResourceID, collection, lsns), datastore.StatusOk
+}
+
+// documentTxn is a write transaction that advances LSNs, the LSNs are cached only after
+// the transaction is committed. It holds documentMutex until it ends, so document writes are serialized
+// and don't conflict on reading the counters, lsnMutex is only taken to access the cache
+type documentTxn struct {
+	*badger.Txn
+	store *BadgerDataStore
+	// Pending LSNs by their key, nil once the transaction has ended
+	lsns map[string]int64
+}
+
+func (r *BadgerDataStore) newDocumentTxn() *documentTxn {
+	r.documentMutex.Lock()
+
+	return &documentTxn{
+		Txn:   r.db.NewTransaction(true),
+		store: r,
+		lsns:  make(map[string]int64),
+	}
+}
+
+// nextLSN advances the log sequence number of the partition key range the logical partition is placed in
+// and writes it with the transaction
+func (t *documentTxn) nextLSN(databaseId string, collection datastore.Collection, partitionKey []interface{}) (int64, datastore.DataStoreStatus) {
+	key := generatePartitionKeyRangeKey(databaseId, collection.ID, datastore.GetPartitionKeyRangeId(collection, partitionKey))
+
+	lsn, ok := t.lsns[key]
+	if !ok {
+		var status datastore.DataStoreStatus
+		t.store.lsnMutex.Lock()
+		lsn, status = t.store.loadLSN(key)
+		t.store.lsnMutex.Unlock()
+		if status != datastore.StatusOk {
+			return 0, status
+		}
+	}
+
+	lsn++
+	if status := setKey(t.Txn, key, lsn); status != datastore.StatusOk {
+		return 0, status
+	}
+	t.lsns[key] = lsn
+
+	return lsn, datastore.StatusOk
+}
+
+// commit commits the transaction and caches the LSNs it has taken
+func (t *documentTxn) commit() datastore.DataStoreStatus {
+	defer t.release()
+
+	if err := t.Txn.Commit(); err != nil {
+		logger.ErrorLn("Error while committing transaction:", err)
+		return datastore.Unknown
+	}
+
+	t.store.lsnMutex.Lock()
+	for key, lsn := range t.lsns {
+		t.store.lsns[key] = lsn
+	}
+	t.store.lsnMutex.Unlock()
+
+	return datastore.StatusOk
+}
+
+// discard drops the changes of the transaction, it is a no-op after commit
+func (t *documentTxn) discard() {
+	t.Txn.Discard()
+	t.release()
+}
+
+func (t *documentTxn) release() {
+	if t.lsns != nil {
+		t.lsns = nil
+		t.store.documentMutex.Unlock()
+	}
+}
+
+// loadLSN returns the LSN stored under the key, the caller has to hold lsnMutex
+func (r *BadgerDataStore) loadLSN(key string) (int64, datastore.DataStoreStatus) {
+	if lsn, ok := r.lsns[key]; ok {
+		return lsn, datastore.StatusOk
+	}
+
+	txn := r.db.NewTransaction(false)
+	defer txn.Discard()
+
+	var lsn int64
+	status := getKey(txn, key, &lsn)
+	if status == datastore.StatusNotFound {
+		status = datastore.StatusOk
+	}
+
+	if status == datastore.StatusOk {
+		r.lsns[key] = lsn
+	}
+
+	return lsn, status
+}
+
+// forgetLSNs drops the cached LSNs of deleted collections
+func (r *BadgerDataStore) forgetLSNs(prefix string) {
+	r.lsnMutex.Lock()
+	defer r.lsnMutex.Unlock()
+
+	for key := range r.lsns {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.lsns, key)
+		}
+	}
 }
@@ -45,21 +45,22 @@ func (r *BadgerDataStore) GetStoredProcedure(databaseId string, collectionId str
 	return storedProcedure, status
 }
 
-func (r *BadgerDataStore) DeleteStoredProcedure(databaseId string, collectionId string, storedProcedureId string) datastore.DataStoreStatus {
+func (r *BadgerDataStore) DeleteStoredProcedure(databaseId string, collectionId string, storedProcedureId string, etag string) datastore.DataStoreStatus {
 	storedProcedureKey := generateStoredProcedureKey(databaseId, collectionId, storedProcedureId)
 
 	txn := r.db.NewTransaction(true)
 	defer txn.Discard()
 
-	exists, err := keyExists(txn, storedProcedureKey)
-	if err != nil {
-		return datastore.Unknown
+	var existing datastore.StoredProcedure
+	if status := getKey(txn, storedProcedureKey, &existing); status != datastore.StatusOk {
+		return status
 	}
-	if !exists {
-		return datastore.StatusNotFound
+
+	if etag != "" && existing.ETag != etag {
+		return datastore.PreconditionFailed
 	}
 
-	err = txn.Delete([]byte(storedProcedureKey))
+	err := txn.Delete([]byte(storedProcedureKey))
 	if err != nil {
 		logger.ErrorLn("Error while deleting stored procedure:", err)
 		return datastore.Unknown
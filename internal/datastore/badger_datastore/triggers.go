@@ -45,21 +45,22 @@ func (r *BadgerDataStore) GetTrigger(databaseId string, collectionId string, tri
 	return trigger, status
 }
 
-func (r *BadgerDataStore) DeleteTrigger(databaseId string, collectionId string, triggerId string) datastore.DataStoreStatus {
+func (r *BadgerDataStore) DeleteTrigger(databaseId string, collectionId string, triggerId string, etag string) datastore.DataStoreStatus {
 	triggerKey := generateTriggerKey(databaseId, collectionId, triggerId)
 
 	txn := r.db.NewTransaction(true)
 	defer txn.Discard()
 
-	exists, err := keyExists(txn, triggerKey)
-	if err != nil {
-		return datastore.Unknown
+	var existing datastore.Trigger
+	if status := getKey(txn, triggerKey, &existing); status != datastore.StatusOk {
+		return status
 	}
-	if !exists {
-		return datastore.StatusNotFound
+
+	if etag != "" && existing.ETag != etag {
+		return datastore.PreconditionFailed
 	}
 
-	err = txn.Delete([]byte(triggerKey))
+	err := txn.Delete([]byte(triggerKey))
 	if err != nil {
 		logger.ErrorLn("Error while deleting trigger:", err)
 		return datastore.Unknown
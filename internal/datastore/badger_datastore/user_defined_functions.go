@@ -45,21 +45,22 @@ func (r *BadgerDataStore) GetUserDefinedFunction(databaseId string, collectionId
 	return udf, status
 }
 
-func (r *BadgerDataStore) DeleteUserDefinedFunction(databaseId string, collectionId string, udfId string) datastore.DataStoreStatus {
+func (r *BadgerDataStore) DeleteUserDefinedFunction(databaseId string, collectionId string, udfId string, etag string) datastore.DataStoreStatus {
 	udfKey := generateUserDefinedFunctionKey(databaseId, collectionId, udfId)
 
 	txn := r.db.NewTransaction(true)
 	defer txn.Discard()
 
-	exists, err := keyExists(txn, udfKey)
-	if err != nil {
-		return datastore.Unknown
+	var existing datastore.UserDefinedFunction
+	if status := getKey(txn, udfKey, &existing); status != datastore.StatusOk {
+		return status
 	}
-	if !exists {
-		return datastore.StatusNotFound
+
+	if etag != "" && existing.ETag != etag {
+		return datastore.PreconditionFailed
 	}
 
-	err = txn.Delete([]byte(udfKey))
+	err := txn.Delete([]byte(udfKey))
 	if err != nil {
 		logger.ErrorLn("Error while deleting user defined function:", err)
 		return datastore.Unknown
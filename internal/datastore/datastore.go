@@ -3,33 +3,45 @@ package datastore
 type DataStore interface {
 	GetAllDatabases() ([]Database, DataStoreStatus)
 	GetDatabase(databaseId string) (Database, DataStoreStatus)
-	DeleteDatabase(databaseId string) DataStoreStatus
+	// A non-empty etag has to match the current one for the delete to happen
+	DeleteDatabase(databaseId string, etag string) DataStoreStatus
 	CreateDatabase(newDatabase Database) (Database, DataStoreStatus)
 
 	GetAllCollections(databaseId string) ([]Collection, DataStoreStatus)
 	GetCollection(databaseId string, collectionId string) (Collection, DataStoreStatus)
-	DeleteCollection(databaseId string, collectionId string) DataStoreStatus
+	DeleteCollection(databaseId string, collectionId string, etag string) DataStoreStatus
 	CreateCollection(databaseId string, newCollection Collection) (Collection, DataStoreStatus)
 
 	GetAllDocuments(databaseId string, collectionId string) ([]Document, DataStoreStatus)
 	GetDocumentIterator(databaseId string, collectionId string) (DocumentIterator, DataStoreStatus)
-	GetDocument(databaseId string, collectionId string, documentId string) (Document, DataStoreStatus)
-	DeleteDocument(databaseId string, collectionId string, documentId string) DataStoreStatus
-	CreateDocument(databaseId string, collectionId string, document map[string]interface{}) (Document, DataStoreStatus)
+	// Documents are identified by their partition key and id, a nil partition key looks the document up in all partitions
+	GetDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) (Document, DataStoreStatus)
+	DeleteDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) DataStoreStatus
+	// The partition key is extracted from the document, a non-nil partition key has to match it
+	CreateDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}) (Document, DataStoreStatus)
+	// Replaces the document found by partition key and id keeping its _rid, a non-empty etag has to match the current one
+	ReplaceDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (Document, DataStoreStatus)
+	// Replaces the document with the same id and partition key or creates it when it does not exist
+	UpsertDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}, etag string) (Document, DataStoreStatus)
+	// Removes all documents of the logical partition at once
+	DeleteDocumentsByPartitionKey(databaseId string, collectionId string, partitionKey []interface{}) DataStoreStatus
+	// Starts a transaction over the documents of the collection, other document writes wait until it ends,
+	// so the caller must not use the data store itself while the transaction is open
+	BeginDocumentTransaction(databaseId string, collectionId string) (DocumentTransaction, DataStoreStatus)
 
 	GetAllTriggers(databaseId string, collectionId string) ([]Trigger, DataStoreStatus)
 	GetTrigger(databaseId string, collectionId string, triggerId string) (Trigger, DataStoreStatus)
-	DeleteTrigger(databaseId string, collectionId string, triggerId string) DataStoreStatus
+	DeleteTrigger(databaseId string, collectionId string, triggerId string, etag string) DataStoreStatus
 	CreateTrigger(databaseId string, collectionId string, trigger Trigger) (Trigger, DataStoreStatus)
 
 	GetAllStoredProcedures(databaseId string, collectionId string) ([]StoredProcedure, DataStoreStatus)
 	GetStoredProcedure(databaseId string, collectionId string, storedProcedureId string) (StoredProcedure, DataStoreStatus)
-	DeleteStoredProcedure(databaseId string, collectionId string, storedProcedureId string) DataStoreStatus
+	DeleteStoredProcedure(databaseId string, collectionId string, storedProcedureId string, etag string) DataStoreStatus
 	CreateStoredProcedure(databaseId string, collectionId string, storedProcedure StoredProcedure) (StoredProcedure, DataStoreStatus)
 
 	GetAllUserDefinedFunctions(databaseId string, collectionId string) ([]UserDefinedFunction, DataStoreStatus)
 	GetUserDefinedFunction(databaseId string, collectionId string, udfId string) (UserDefinedFunction, DataStoreStatus)
-	DeleteUserDefinedFunction(databaseId string, collectionId string, udfId string) DataStoreStatus
+	DeleteUserDefinedFunction(databaseId string, collectionId string, udfId string, etag string) DataStoreStatus
 	CreateUserDefinedFunction(databaseId string, collectionId string, udf UserDefinedFunction) (UserDefinedFunction, DataStoreStatus)
 
 	GetPartitionKeyRanges(databaseId string, collectionId string) ([]PartitionKeyRange, DataStoreStatus)
@@ -42,3 +54,18 @@ type DocumentIterator interface {
 	Next() (Document, DataStoreStatus)
 	Close()
 }
+
+// DocumentTransaction stages document changes of a single collection, either all of them are applied
+// on commit or none of them, reads within the transaction see the staged changes
+type DocumentTransaction interface {
+	GetDocument(partitionKey []interface{}, documentId string) (Document, DataStoreStatus)
+	GetDocumentIterator() (DocumentIterator, DataStoreStatus)
+	DeleteDocument(partitionKey []interface{}, documentId string, etag string) DataStoreStatus
+	CreateDocument(partitionKey []interface{}, document map[string]interface{}) (Document, DataStoreStatus)
+	ReplaceDocument(partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (Document, DataStoreStatus)
+	UpsertDocument(partitionKey []interface{}, document map[string]interface{}, etag string) (Document, DataStoreStatus)
+
+	Commit() DataStoreStatus
+	// Drops the staged changes, does nothing once the transaction is committed
+	Rollback()
+}
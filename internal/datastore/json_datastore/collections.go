@@ -37,7 +37,7 @@ func (r *JsonDataStore) GetCollection(databaseId string, collectionId string) (d
 	return r.storeState.Collections[databaseId][collectionId], datastore.StatusOk
 }
 
-func (r *JsonDataStore) DeleteCollection(databaseId string, collectionId string) datastore.DataStoreStatus {
+func (r *JsonDataStore) DeleteCollection(databaseId string, collectionId string, etag string) datastore.DataStoreStatus {
 	r.storeState.Lock()
 	defer r.storeState.Unlock()
 
@@ -45,15 +45,21 @@ func (r *JsonDataStore) DeleteCollection(databaseId string, collectionId string)
 		return datastore.StatusNotFound
 	}
 
-	if _, ok := r.storeState.Collections[databaseId][collectionId]; !ok {
+	collection, ok := r.storeState.Collections[databaseId][collectionId]
+	if !ok {
 		return datastore.StatusNotFound
 	}
 
+	if etag != "" && collection.ETag != etag {
+		return datastore.PreconditionFailed
+	}
+
 	delete(r.storeState.Collections[databaseId], collectionId)
 	delete(r.storeState.Documents[databaseId], collectionId)
 	delete(r.storeState.Triggers[databaseId], collectionId)
 	delete(r.storeState.StoredProcedures[databaseId], collectionId)
 	delete(r.storeState.UserDefinedFunctions[databaseId], collectionId)
+	delete(r.storeState.PartitionKeyRangeLSNs[databaseId], collectionId)
 
 	return datastore.StatusOk
 }
@@ -84,6 +90,7 @@ func (r *JsonDataStore) CreateCollection(databaseId string, newCollection datast
 	r.storeState.Triggers[databaseId][newCollection.ID] = make(map[string]datastore.Trigger)
 	r.storeState.StoredProcedures[databaseId][newCollection.ID] = make(map[string]datastore.StoredProcedure)
 	r.storeState.UserDefinedFunctions[databaseId][newCollection.ID] = make(map[string]datastore.UserDefinedFunction)
+	r.storeState.PartitionKeyRangeLSNs[databaseId][newCollection.ID] = make(map[string]int64)
 
 	return newCollection, datastore.StatusOk
 }
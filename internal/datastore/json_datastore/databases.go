@@ -28,20 +28,26 @@ func (r *JsonDataStore) GetDatabase(id string) (datastore.Database, datastore.Da
 	return datastore.Database{}, datastore.StatusNotFound
 }
 
-func (r *JsonDataStore) DeleteDatabase(id string) datastore.DataStoreStatus {
+func (r *JsonDataStore) DeleteDatabase(id string, etag string) datastore.DataStoreStatus {
 	r.storeState.Lock()
 	defer r.storeState.Unlock()
 
-	if _, ok := r.storeState.Databases[id]; !ok {
+	database, ok := r.storeState.Databases[id]
+	if !ok {
 		return datastore.StatusNotFound
 	}
 
+	if etag != "" && database.ETag != etag {
+		return datastore.PreconditionFailed
+	}
+
 	delete(r.storeState.Databases, id)
 	delete(r.storeState.Collections, id)
 	delete(r.storeState.Documents, id)
 	delete(r.storeState.Triggers, id)
 	delete(r.storeState.StoredProcedures, id)
 	delete(r.storeState.UserDefinedFunctions, id)
+	delete(r.storeState.PartitionKeyRangeLSNs, id)
 
 	return datastore.StatusOk
 }
@@ -65,6 +71,7 @@ func (r *JsonDataStore) CreateDatabase(newDatabase datastore.Database) (datastor
 	r.storeState.Triggers[newDatabase.ID] = make(map[string]map[string]datastore.Trigger)
 	r.storeState.StoredProcedures[newDatabase.ID] = make(map[string]map[string]datastore.StoredProcedure)
 	r.storeState.UserDefinedFunctions[newDatabase.ID] = make(map[string]map[string]datastore.UserDefinedFunction)
+	r.storeState.PartitionKeyRangeLSNs[newDatabase.ID] = make(map[string]map[string]int64)
 
 	return newDatabase, datastore.StatusOk
 }
@@ -0,0 +1,301 @@
+package jsondatastore
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pikami/cosmium/internal/datastore"
+	"github.com/pikami/cosmium/internal/resourceid"
+)
+
+// documentTransaction stages its changes and applies them under the write lock on commit, reads of the
+// data store don't wait for it. Document transactions are serialized, so the staged LSNs stay valid until commit
+type documentTransaction struct {
+	store      *JsonDataStore
+	database   datastore.Database
+	collection datastore.Collection
+	documents  map[string]datastore.Document
+	lsns       map[string]int64
+
+	// Staged documents by their key, nil when the document is deleted
+	stagedDocuments map[string]datastore.Document
+	// Staged LSNs by partition key range id
+	stagedLSNs map[string]int64
+	done       bool
+}
+
+func (r *JsonDataStore) BeginDocumentTransaction(databaseId string, collectionId string) (datastore.DocumentTransaction, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil, status
+	}
+
+	return transaction, datastore.StatusOk
+}
+
+func (r *JsonDataStore) beginDocumentTransaction(databaseId string, collectionId string) (*documentTransaction, datastore.DataStoreStatus) {
+	r.documentMutex.Lock()
+
+	r.storeState.RLock()
+	defer r.storeState.RUnlock()
+
+	database, ok := r.storeState.Databases[databaseId]
+	if !ok {
+		r.documentMutex.Unlock()
+		return nil, datastore.StatusNotFound
+	}
+
+	collection, ok := r.storeState.Collections[databaseId][collectionId]
+	if !ok {
+		r.documentMutex.Unlock()
+		return nil, datastore.StatusNotFound
+	}
+
+	return &documentTransaction{
+		store:           r,
+		database:        database,
+		collection:      collection,
+		documents:       r.storeState.Documents[databaseId][collectionId],
+		lsns:            r.storeState.PartitionKeyRangeLSNs[databaseId][collectionId],
+		stagedDocuments: make(map[string]datastore.Document),
+		stagedLSNs:      make(map[string]int64),
+	}, datastore.StatusOk
+}
+
+func (t *documentTransaction) GetDocument(partitionKey []interface{}, documentId string) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := t.findDocumentKey(partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	document, _ := t.getDocument(documentKey)
+	return document, datastore.StatusOk
+}
+
+func (t *documentTransaction) GetDocumentIterator() (datastore.DocumentIterator, datastore.DataStoreStatus) {
+	documents := make([]datastore.Document, 0, len(t.documents))
+	for documentKey, document := range t.documents {
+		if _, ok := t.stagedDocuments[documentKey]; !ok {
+			documents = append(documents, document)
+		}
+	}
+
+	for _, document := range t.stagedDocuments {
+		if document != nil {
+			documents = append(documents, document)
+		}
+	}
+
+	return &ArrayDocumentIterator{
+		documents: documents,
+		index:     -1,
+	}, datastore.StatusOk
+}
+
+func (t *documentTransaction) DeleteDocument(partitionKey []interface{}, documentId string, etag string) datastore.DataStoreStatus {
+	documentKey, status := t.findDocumentKey(partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	document, _ := t.getDocument(documentKey)
+	if etag != "" && document["_etag"] != etag {
+		return datastore.PreconditionFailed
+	}
+
+	t.nextLSN(datastore.GetDocumentPartitionKey(t.collection, document))
+	t.stagedDocuments[documentKey] = nil
+
+	return datastore.StatusOk
+}
+
+func (t *documentTransaction) CreateDocument(partitionKey []interface{}, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := t.newDocumentKey(partitionKey, document)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	if _, ok := t.getDocument(documentKey); ok {
+		return datastore.Document{}, datastore.Conflict
+	}
+
+	return t.insertDocument(documentKey, document), datastore.StatusOk
+}
+
+func (t *documentTransaction) ReplaceDocument(partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := t.findDocumentKey(partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	return t.replaceDocument(documentKey, document, etag)
+}
+
+func (t *documentTransaction) UpsertDocument(partitionKey []interface{}, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	documentKey, status := t.newDocumentKey(partitionKey, document)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	if _, ok := t.getDocument(documentKey); ok {
+		return t.replaceDocument(documentKey, document, etag)
+	}
+
+	if etag != "" {
+		return datastore.Document{}, datastore.PreconditionFailed
+	}
+
+	return t.insertDocument(documentKey, document), datastore.StatusOk
+}
+
+func (t *documentTransaction) Commit() datastore.DataStoreStatus {
+	if t.done {
+		return datastore.StatusOk
+	}
+
+	t.store.storeState.Lock()
+	for documentKey, document := range t.stagedDocuments {
+		if document == nil {
+			delete(t.documents, documentKey)
+		} else {
+			t.documents[documentKey] = document
+		}
+	}
+
+	for partitionKeyRangeId, lsn := range t.stagedLSNs {
+		t.lsns[partitionKeyRangeId] = lsn
+	}
+	t.store.storeState.Unlock()
+
+	t.end()
+
+	return datastore.StatusOk
+}
+
+func (t *documentTransaction) Rollback() {
+	if !t.done {
+		t.end()
+	}
+}
+
+func (t *documentTransaction) end() {
+	t.done = true
+	t.stagedDocuments = nil
+	t.stagedLSNs = nil
+	t.store.documentMutex.Unlock()
+}
+
+// getDocument returns the staged version of the document or the stored one when it is not staged
+func (t *documentTransaction) getDocument(documentKey string) (datastore.Document, bool) {
+	if document, ok := t.stagedDocuments[documentKey]; ok {
+		return document, document != nil
+	}
+
+	document, ok := t.documents[documentKey]
+	return document, ok
+}
+
+// findDocumentKey looks up the key of a document, when the partition key is not
+// specified the document is looked up in all partitions of the collection
+func (t *documentTransaction) findDocumentKey(partitionKey []interface{}, documentId string) (string, datastore.DataStoreStatus) {
+	if partitionKey != nil {
+		documentKey := generateDocumentKey(partitionKey, documentId)
+		if _, ok := t.getDocument(documentKey); !ok {
+			return "", datastore.StatusNotFound
+		}
+		return documentKey, datastore.StatusOk
+	}
+
+	documentKeys := make([]string, 0, 1)
+	for documentKey, document := range t.stagedDocuments {
+		if document != nil && document["id"] == documentId {
+			documentKeys = append(documentKeys, documentKey)
+		}
+	}
+
+	for documentKey, document := range t.documents {
+		if _, ok := t.stagedDocuments[documentKey]; !ok && document["id"] == documentId {
+			documentKeys = append(documentKeys, documentKey)
+		}
+	}
+
+	return singleDocumentKey(documentKeys)
+}
+
+// newDocumentKey assigns an id to the document when it has none and returns the key the document is stored under
+func (t *documentTransaction) newDocumentKey(partitionKey []interface{}, document map[string]interface{}) (string, datastore.DataStoreStatus) {
+	documentId, ok := document["id"].(string)
+	if !ok || documentId == "" {
+		documentId = fmt.Sprint(uuid.New())
+		document["id"] = documentId
+	}
+
+	documentPartitionKey := datastore.GetDocumentPartitionKey(t.collection, document)
+	if partitionKey != nil && !datastore.PartitionKeysEqual(partitionKey, documentPartitionKey) {
+		return "", datastore.PartitionKeyMismatch
+	}
+
+	return generateDocumentKey(documentPartitionKey, documentId), datastore.StatusOk
+}
+
+// insertDocument assigns the system properties of a new document and stores it
+func (t *documentTransaction) insertDocument(documentKey string, document map[string]interface{}) datastore.Document {
+	document["_ts"] = time.Now().Unix()
+	document["_rid"] = resourceid.NewCombined(t.collection.ResourceID, resourceid.New(resourceid.ResourceTypeDocument))
+	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
+	document["_self"] = fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", t.database.ResourceID, t.collection.ResourceID, document["_rid"])
+	document["_lsn"] = t.nextLSN(datastore.GetDocumentPartitionKey(t.collection, document))
+
+	t.stagedDocuments[documentKey] = document
+
+	return document
+}
+
+// replaceDocument swaps the stored document with the new one while keeping its _rid and _self,
+// the document moves to another key when its id or partition key changes
+func (t *documentTransaction) replaceDocument(existingKey string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	existingDocument, _ := t.getDocument(existingKey)
+	if etag != "" && existingDocument["_etag"] != etag {
+		return datastore.Document{}, datastore.PreconditionFailed
+	}
+
+	documentId, ok := document["id"].(string)
+	if !ok || documentId == "" {
+		documentId = existingDocument["id"].(string)
+		document["id"] = documentId
+	}
+
+	documentPartitionKey := datastore.GetDocumentPartitionKey(t.collection, document)
+	documentKey := generateDocumentKey(documentPartitionKey, documentId)
+	if documentKey != existingKey {
+		if _, ok := t.getDocument(documentKey); ok {
+			return datastore.Document{}, datastore.Conflict
+		}
+		t.stagedDocuments[existingKey] = nil
+	}
+
+	document["_ts"] = time.Now().Unix()
+	document["_rid"] = existingDocument["_rid"]
+	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
+	document["_self"] = existingDocument["_self"]
+	document["_lsn"] = t.nextLSN(documentPartitionKey)
+
+	t.stagedDocuments[documentKey] = document
+
+	return document, datastore.StatusOk
+}
+
+// nextLSN advances the staged LSN of the partition key range the logical partition is placed in
+func (t *documentTransaction) nextLSN(partitionKey []interface{}) int64 {
+	partitionKeyRangeId := datastore.GetPartitionKeyRangeId(t.collection, partitionKey)
+	lsn, ok := t.stagedLSNs[partitionKeyRangeId]
+	if !ok {
+		lsn = t.lsns[partitionKeyRangeId]
+	}
+
+	lsn++
+	t.stagedLSNs[partitionKeyRangeId] = lsn
+
+	return lsn
+}
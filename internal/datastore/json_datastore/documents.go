@@ -1,12 +1,7 @@
 package jsondatastore
 
 import (
-	"fmt"
-	"time"
-
-	"github.com/google/uuid"
 	"github.com/pikami/cosmium/internal/datastore"
-	"github.com/pikami/cosmium/internal/resourceid"
 	"golang.org/x/exp/maps"
 )
 
@@ -25,7 +20,7 @@ func (r *JsonDataStore) GetAllDocuments(databaseId string, collectionId string)
 	return maps.Values(r.storeState.Documents[databaseId][collectionId]), datastore.StatusOk
 }
 
-func (r *JsonDataStore) GetDocument(databaseId string, collectionId string, documentId string) (datastore.Document, datastore.DataStoreStatus) {
+func (r *JsonDataStore) GetDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) (datastore.Document, datastore.DataStoreStatus) {
 	r.storeState.RLock()
 	defer r.storeState.RUnlock()
 
@@ -37,14 +32,28 @@ func (r *JsonDataStore) GetDocument(databaseId string, collectionId string, docu
 		return datastore.Document{}, datastore.StatusNotFound
 	}
 
-	if _, ok := r.storeState.Documents[databaseId][collectionId][documentId]; !ok {
-		return datastore.Document{}, datastore.StatusNotFound
+	documentKey, status := r.findDocumentKey(databaseId, collectionId, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	return r.storeState.Documents[databaseId][collectionId][documentKey], datastore.StatusOk
+}
+
+func (r *JsonDataStore) DeleteDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) datastore.DataStoreStatus {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return status
 	}
+	defer transaction.Commit()
 
-	return r.storeState.Documents[databaseId][collectionId][documentId], datastore.StatusOk
+	return transaction.DeleteDocument(partitionKey, documentId, "")
 }
 
-func (r *JsonDataStore) DeleteDocument(databaseId string, collectionId string, documentId string) datastore.DataStoreStatus {
+func (r *JsonDataStore) DeleteDocumentsByPartitionKey(databaseId string, collectionId string, partitionKey []interface{}) datastore.DataStoreStatus {
+	r.documentMutex.Lock()
+	defer r.documentMutex.Unlock()
+
 	r.storeState.Lock()
 	defer r.storeState.Unlock()
 
@@ -52,52 +61,57 @@ func (r *JsonDataStore) DeleteDocument(databaseId string, collectionId string, d
 		return datastore.StatusNotFound
 	}
 
-	if _, ok := r.storeState.Collections[databaseId][collectionId]; !ok {
+	collection, ok := r.storeState.Collections[databaseId][collectionId]
+	if !ok {
 		return datastore.StatusNotFound
 	}
 
-	if _, ok := r.storeState.Documents[databaseId][collectionId][documentId]; !ok {
-		return datastore.StatusNotFound
+	// Partition key of a deleted document by the partition key range it is placed in,
+	// a prefix of a hierarchical partition key deletes all partitions below it
+	partitionKeyRanges := make(map[string][]interface{})
+	for documentKey, document := range r.storeState.Documents[databaseId][collectionId] {
+		documentPartitionKey := datastore.GetDocumentPartitionKey(collection, document)
+		if datastore.PartitionKeyHasPrefix(documentPartitionKey, partitionKey) {
+			delete(r.storeState.Documents[databaseId][collectionId], documentKey)
+			partitionKeyRanges[datastore.GetPartitionKeyRangeId(collection, documentPartitionKey)] = documentPartitionKey
+		}
 	}
 
-	delete(r.storeState.Documents[databaseId][collectionId], documentId)
+	for _, documentPartitionKey := range partitionKeyRanges {
+		r.nextLSN(databaseId, collection, documentPartitionKey)
+	}
 
 	return datastore.StatusOk
 }
 
-func (r *JsonDataStore) CreateDocument(databaseId string, collectionId string, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
-	r.storeState.Lock()
-	defer r.storeState.Unlock()
-
-	var ok bool
-	var documentId string
-	var database datastore.Database
-	var collection datastore.Collection
-	if documentId, ok = document["id"].(string); !ok || documentId == "" {
-		documentId = fmt.Sprint(uuid.New())
-		document["id"] = documentId
-	}
-
-	if database, ok = r.storeState.Databases[databaseId]; !ok {
-		return datastore.Document{}, datastore.StatusNotFound
+func (r *JsonDataStore) CreateDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
 	}
+	defer transaction.Commit()
 
-	if collection, ok = r.storeState.Collections[databaseId][collectionId]; !ok {
-		return datastore.Document{}, datastore.StatusNotFound
-	}
+	return transaction.CreateDocument(partitionKey, document)
+}
 
-	if _, ok := r.storeState.Documents[databaseId][collectionId][documentId]; ok {
-		return datastore.Document{}, datastore.Conflict
+func (r *JsonDataStore) ReplaceDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
 	}
+	defer transaction.Commit()
 
-	document["_ts"] = time.Now().Unix()
-	document["_rid"] = resourceid.NewCombined(collection.ResourceID, resourceid.New(resourceid.ResourceTypeDocument))
-	document["_etag"] = fmt.Sprintf("\"%s\"", uuid.New())
-	document["_self"] = fmt.Sprintf("dbs/%s/colls/%s/docs/%s/", database.ResourceID, collection.ResourceID, document["_rid"])
+	return transaction.ReplaceDocument(partitionKey, documentId, document, etag)
+}
 
-	r.storeState.Documents[databaseId][collectionId][documentId] = document
+func (r *JsonDataStore) UpsertDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	transaction, status := r.beginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+	defer transaction.Commit()
 
-	return document, datastore.StatusOk
+	return transaction.UpsertDocument(partitionKey, document, etag)
 }
 
 func (r *JsonDataStore) GetDocumentIterator(databaseId string, collectionId string) (datastore.DocumentIterator, datastore.DataStoreStatus) {
@@ -111,3 +125,53 @@ func (r *JsonDataStore) GetDocumentIterator(databaseId string, collectionId stri
 		index:     -1,
 	}, datastore.StatusOk
 }
+
+// findDocumentKey looks up the key of a document, when the partition key is not
+// specified the document is looked up in all partitions of the collection
+func (r *JsonDataStore) findDocumentKey(databaseId string, collectionId string, partitionKey []interface{}, documentId string) (string, datastore.DataStoreStatus) {
+	if partitionKey != nil {
+		documentKey := generateDocumentKey(partitionKey, documentId)
+		if _, ok := r.storeState.Documents[databaseId][collectionId][documentKey]; !ok {
+			return "", datastore.StatusNotFound
+		}
+		return documentKey, datastore.StatusOk
+	}
+
+	documentKeys := make([]string, 0, 1)
+	for documentKey, document := range r.storeState.Documents[databaseId][collectionId] {
+		if document["id"] == documentId {
+			documentKeys = append(documentKeys, documentKey)
+		}
+	}
+
+	return singleDocumentKey(documentKeys)
+}
+
+// singleDocumentKey picks the document found without a partition key, the document
+// is ambiguous when the id is used in several partitions
+func singleDocumentKey(documentKeys []string) (string, datastore.DataStoreStatus) {
+	switch len(documentKeys) {
+	case 0:
+		return "", datastore.StatusNotFound
+	case 1:
+		return documentKeys[0], datastore.StatusOk
+	default:
+		return "", datastore.BadRequest
+	}
+}
+
+// generateDocumentKey combines the partition key and id of a document,
+// documents are only unique within their logical partition
+func generateDocumentKey(partitionKey []interface{}, documentId string) string {
+	return datastore.PartitionKeyString(partitionKey) + "/" + documentId
+}
+
+// nextLSN advances the log sequence number of the partition key range the logical partition is placed in,
+// the caller has to hold the document mutex and the write lock
+func (r *JsonDataStore) nextLSN(databaseId string, collection datastore.Collection, partitionKey []interface{}) int64 {
+	lsns := r.storeState.PartitionKeyRangeLSNs[databaseId][collection.ID]
+	partitionKeyRangeId := datastore.GetPartitionKeyRangeId(collection, partitionKey)
+	lsns[partitionKeyRangeId]++
+
+	return lsns[partitionKeyRangeId]
+}
@@ -1,9 +1,16 @@
 package jsondatastore
 
-import "github.com/pikami/cosmium/internal/datastore"
+import (
+	"sync"
+
+	"github.com/pikami/cosmium/internal/datastore"
+)
 
 type JsonDataStore struct {
 	storeState State
+	// Serializes document transactions, they stage their changes and take the
+	// state lock only to apply them, so that reads don't wait for them
+	documentMutex sync.Mutex
 
 	initialDataFilePath string
 	persistDataFilePath string
@@ -17,12 +24,13 @@ type JsonDataStoreOptions struct {
 func NewJsonDataStore(options JsonDataStoreOptions) *JsonDataStore {
 	dataStore := &JsonDataStore{
 		storeState: State{
-			Databases:            make(map[string]datastore.Database),
-			Collections:          make(map[string]map[string]datastore.Collection),
-			Documents:            make(map[string]map[string]map[string]datastore.Document),
-			Triggers:             make(map[string]map[string]map[string]datastore.Trigger),
-			StoredProcedures:     make(map[string]map[string]map[string]datastore.StoredProcedure),
-			UserDefinedFunctions: make(map[string]map[string]map[string]datastore.UserDefinedFunction),
+			Databases:             make(map[string]datastore.Database),
+			Collections:           make(map[string]map[string]datastore.Collection),
+			Documents:             make(map[string]map[string]map[string]datastore.Document),
+			Triggers:              make(map[string]map[string]map[string]datastore.Trigger),
+			StoredProcedures:      make(map[string]map[string]map[string]datastore.StoredProcedure),
+			UserDefinedFunctions:  make(map[string]map[string]map[string]datastore.UserDefinedFunction),
+			PartitionKeyRangeLSNs: make(map[string]map[string]map[string]int64),
 		},
 		initialDataFilePath: options.InitialDataFilePath,
 		persistDataFilePath: options.PersistDataFilePath,
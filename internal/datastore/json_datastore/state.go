@@ -20,7 +20,7 @@ type State struct {
 	// Map databaseId -> collectionId -> Collection
 	Collections map[string]map[string]datastore.Collection `json:"collections"`
 
-	// Map databaseId -> collectionId -> documentId -> Documents
+	// Map databaseId -> collectionId -> partitionKey/documentId -> Documents
 	Documents map[string]map[string]map[string]datastore.Document `json:"documents"`
 
 	// Map databaseId -> collectionId -> triggerId -> Trigger
@@ -31,6 +31,9 @@ type State struct {
 
 	// Map databaseId -> collectionId -> udfId -> UserDefinedFunction
 	UserDefinedFunctions map[string]map[string]map[string]datastore.UserDefinedFunction `json:"udfs"`
+
+	// Map databaseId -> collectionId -> partitionKeyRangeId -> LSN
+	PartitionKeyRangeLSNs map[string]map[string]map[string]int64 `json:"lsns"`
 }
 
 func (r *JsonDataStore) InitializeDataStore() {
@@ -80,8 +83,10 @@ func (r *JsonDataStore) LoadStateJSON(jsonData string) error {
 	r.storeState.Collections = state.Collections
 	r.storeState.Databases = state.Databases
 	r.storeState.Documents = state.Documents
+	r.storeState.PartitionKeyRangeLSNs = state.PartitionKeyRangeLSNs
 
 	r.ensureStoreStateNoNullReferences()
+	r.rekeyDocuments()
 
 	logger.InfoLn("Loaded state:")
 	logger.Infof("Databases: %d\n", getLength(r.storeState.Databases))
@@ -188,6 +193,10 @@ func (r *JsonDataStore) ensureStoreStateNoNullReferences() {
 		r.storeState.UserDefinedFunctions = make(map[string]map[string]map[string]datastore.UserDefinedFunction)
 	}
 
+	if r.storeState.PartitionKeyRangeLSNs == nil {
+		r.storeState.PartitionKeyRangeLSNs = make(map[string]map[string]map[string]int64)
+	}
+
 	for database := range r.storeState.Databases {
 		if r.storeState.Collections[database] == nil {
 			r.storeState.Collections[database] = make(map[string]datastore.Collection)
@@ -209,6 +218,10 @@ func (r *JsonDataStore) ensureStoreStateNoNullReferences() {
 			r.storeState.UserDefinedFunctions[database] = make(map[string]map[string]datastore.UserDefinedFunction)
 		}
 
+		if r.storeState.PartitionKeyRangeLSNs[database] == nil {
+			r.storeState.PartitionKeyRangeLSNs[database] = make(map[string]map[string]int64)
+		}
+
 		for collection := range r.storeState.Collections[database] {
 			if r.storeState.Documents[database][collection] == nil {
 				r.storeState.Documents[database][collection] = make(map[string]datastore.Document)
@@ -231,6 +244,26 @@ func (r *JsonDataStore) ensureStoreStateNoNullReferences() {
 			if r.storeState.UserDefinedFunctions[database][collection] == nil {
 				r.storeState.UserDefinedFunctions[database][collection] = make(map[string]datastore.UserDefinedFunction)
 			}
+
+			if r.storeState.PartitionKeyRangeLSNs[database][collection] == nil {
+				r.storeState.PartitionKeyRangeLSNs[database][collection] = make(map[string]int64)
+			}
+		}
+	}
+}
+
+// rekeyDocuments keys the loaded documents by their partition key and id,
+// state files written by older versions key documents by id only
+func (r *JsonDataStore) rekeyDocuments() {
+	for databaseId, collections := range r.storeState.Collections {
+		for collectionId, collection := range collections {
+			documents := make(map[string]datastore.Document, len(r.storeState.Documents[databaseId][collectionId]))
+			for _, document := range r.storeState.Documents[databaseId][collectionId] {
+				documentId, _ := document["id"].(string)
+				partitionKey := datastore.GetDocumentPartitionKey(collection, document)
+				documents[generateDocumentKey(partitionKey, documentId)] = document
+			}
+			r.storeState.Documents[databaseId][collectionId] = documents
 		}
 	}
 }
@@ -36,7 +36,7 @@ func (r *JsonDataStore) GetStoredProcedure(databaseId string, collectionId strin
 	return datastore.StoredProcedure{}, datastore.StatusNotFound
 }
 
-func (r *JsonDataStore) DeleteStoredProcedure(databaseId string, collectionId string, spId string) datastore.DataStoreStatus {
+func (r *JsonDataStore) DeleteStoredProcedure(databaseId string, collectionId string, spId string, etag string) datastore.DataStoreStatus {
 	r.storeState.Lock()
 	defer r.storeState.Unlock()
 
@@ -48,10 +48,15 @@ func (r *JsonDataStore) DeleteStoredProcedure(databaseId string, collectionId st
 		return datastore.StatusNotFound
 	}
 
-	if _, ok := r.storeState.StoredProcedures[databaseId][collectionId][spId]; !ok {
+	existing, ok := r.storeState.StoredProcedures[databaseId][collectionId][spId]
+	if !ok {
 		return datastore.StatusNotFound
 	}
 
+	if etag != "" && existing.ETag != etag {
+		return datastore.PreconditionFailed
+	}
+
 	delete(r.storeState.StoredProcedures[databaseId][collectionId], spId)
 
 	return datastore.StatusOk
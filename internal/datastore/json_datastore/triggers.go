@@ -36,7 +36,7 @@ func (r *JsonDataStore) GetTrigger(databaseId string, collectionId string, trigg
 	return datastore.Trigger{}, datastore.StatusNotFound
 }
 
-func (r *JsonDataStore) DeleteTrigger(databaseId string, collectionId string, triggerId string) datastore.DataStoreStatus {
+func (r *JsonDataStore) DeleteTrigger(databaseId string, collectionId string, triggerId string, etag string) datastore.DataStoreStatus {
 	r.storeState.Lock()
 	defer r.storeState.Unlock()
 
@@ -48,10 +48,15 @@ func (r *JsonDataStore) DeleteTrigger(databaseId string, collectionId string, tr
 		return datastore.StatusNotFound
 	}
 
-	if _, ok := r.storeState.Triggers[databaseId][collectionId][triggerId]; !ok {
+	existing, ok := r.storeState.Triggers[databaseId][collectionId][triggerId]
+	if !ok {
 		return datastore.StatusNotFound
 	}
 
+	if etag != "" && existing.ETag != etag {
+		return datastore.PreconditionFailed
+	}
+
 	delete(r.storeState.Triggers[databaseId][collectionId], triggerId)
 
 	return datastore.StatusOk
@@ -36,7 +36,7 @@ func (r *JsonDataStore) GetUserDefinedFunction(databaseId string, collectionId s
 	return datastore.UserDefinedFunction{}, datastore.StatusNotFound
 }
 
-func (r *JsonDataStore) DeleteUserDefinedFunction(databaseId string, collectionId string, udfId string) datastore.DataStoreStatus {
+func (r *JsonDataStore) DeleteUserDefinedFunction(databaseId string, collectionId string, udfId string, etag string) datastore.DataStoreStatus {
 	r.storeState.Lock()
 	defer r.storeState.Unlock()
 
@@ -48,10 +48,15 @@ func (r *JsonDataStore) DeleteUserDefinedFunction(databaseId string, collectionI
 		return datastore.StatusNotFound
 	}
 
-	if _, ok := r.storeState.UserDefinedFunctions[databaseId][collectionId][udfId]; !ok {
+	existing, ok := r.storeState.UserDefinedFunctions[databaseId][collectionId][udfId]
+	if !ok {
 		return datastore.StatusNotFound
 	}
 
+	if etag != "" && existing.ETag != etag {
+		return datastore.PreconditionFailed
+	}
+
 	delete(r.storeState.UserDefinedFunctions[databaseId][collectionId], udfId)
 
 	return datastore.StatusOk
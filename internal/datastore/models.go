@@ -11,12 +11,14 @@ type Database struct {
 type DataStoreStatus int
 
 const (
-	StatusOk       DataStoreStatus = 1
-	StatusNotFound DataStoreStatus = 2
-	Conflict       DataStoreStatus = 3
-	BadRequest     DataStoreStatus = 4
-	IterEOF        DataStoreStatus = 5
-	Unknown        DataStoreStatus = 6
+	StatusOk             DataStoreStatus = 1
+	StatusNotFound       DataStoreStatus = 2
+	Conflict             DataStoreStatus = 3
+	BadRequest           DataStoreStatus = 4
+	IterEOF              DataStoreStatus = 5
+	Unknown              DataStoreStatus = 6
+	PartitionKeyMismatch DataStoreStatus = 7
+	PreconditionFailed   DataStoreStatus = 8
 )
 
 type TriggerOperation string
@@ -36,18 +38,20 @@ const (
 )
 
 type Collection struct {
-	ID             string                   `json:"id"`
-	IndexingPolicy CollectionIndexingPolicy `json:"indexingPolicy"`
-	PartitionKey   CollectionPartitionKey   `json:"partitionKey"`
-	ResourceID     string                   `json:"_rid"`
-	TimeStamp      int64                    `json:"_ts"`
-	Self           string                   `json:"_self"`
-	ETag           string                   `json:"_etag"`
-	Docs           string                   `json:"_docs"`
-	Sprocs         string                   `json:"_sprocs"`
-	Triggers       string                   `json:"_triggers"`
-	Udfs           string                   `json:"_udfs"`
-	Conflicts      string                   `json:"_conflicts"`
+	ID                 string                       `json:"id"`
+	IndexingPolicy     CollectionIndexingPolicy     `json:"indexingPolicy"`
+	PartitionKey       CollectionPartitionKey       `json:"partitionKey"`
+	ComputedProperties []CollectionComputedProperty `json:"computedProperties,omitempty"`
+	PartitionCount     int                          `json:"partitionCount,omitempty"`
+	ResourceID         string                       `json:"_rid"`
+	TimeStamp          int64                        `json:"_ts"`
+	Self               string                       `json:"_self"`
+	ETag               string                       `json:"_etag"`
+	Docs               string                       `json:"_docs"`
+	Sprocs             string                       `json:"_sprocs"`
+	Triggers           string                       `json:"_triggers"`
+	Udfs               string                       `json:"_udfs"`
+	Conflicts          string                       `json:"_conflicts"`
 }
 
 type CollectionIndexingPolicy struct {
@@ -72,6 +76,16 @@ type CollectionPartitionKey struct {
 	Version int      `json:"Version"`
 }
 
+const (
+	PartitionKeyKindHash      = "Hash"
+	PartitionKeyKindMultiHash = "MultiHash"
+)
+
+type CollectionComputedProperty struct {
+	Name  string `json:"name"`
+	Query string `json:"query"`
+}
+
 type UserDefinedFunction struct {
 	Body       string `json:"body"`
 	ID         string `json:"id"`
@@ -115,5 +129,5 @@ type PartitionKeyRange struct {
 	Status             string `json:"status"`
 	Parents            []any  `json:"parents"`
 	TimeStamp          int64  `json:"_ts"`
-	Lsn                int    `json:"lsn"`
+	Lsn                int64  `json:"lsn"`
 }
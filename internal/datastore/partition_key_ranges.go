@@ -0,0 +1,167 @@
+package datastore
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/pikami/cosmium/internal/murmurhash3"
+	"github.com/pikami/cosmium/internal/resourceid"
+)
+
+const (
+	MinEffectivePartitionKey = ""
+	MaxEffectivePartitionKey = "FF"
+)
+
+// Markers used when serializing partition key values for hashing
+const (
+	partitionKeyComponentUndefined byte = 0x00
+	partitionKeyComponentNull      byte = 0x01
+	partitionKeyComponentFalse     byte = 0x02
+	partitionKeyComponentTrue      byte = 0x03
+	partitionKeyComponentNumber    byte = 0x05
+	partitionKeyComponentString    byte = 0x08
+)
+
+// GetEffectivePartitionKey hashes the partition key values the same way Cosmos DB does for
+// V2 partition keys, the hash decides which partition key range the document belongs to.
+// MultiHash partition keys are hashed per level and the hashes are concatenated,
+// so documents sharing a prefix stay next to each other
+func GetEffectivePartitionKey(definition CollectionPartitionKey, partitionKey []interface{}) string {
+	if len(partitionKey) == 0 {
+		return MinEffectivePartitionKey
+	}
+
+	if definition.Kind != PartitionKeyKindMultiHash {
+		return hashPartitionKeyComponents(partitionKey)
+	}
+
+	var effectivePartitionKey strings.Builder
+	for _, value := range partitionKey {
+		effectivePartitionKey.WriteString(hashPartitionKeyComponents([]interface{}{value}))
+	}
+
+	return effectivePartitionKey.String()
+}
+
+func hashPartitionKeyComponents(components []interface{}) string {
+	var buffer bytes.Buffer
+	for _, value := range components {
+		writePartitionKeyComponent(&buffer, value)
+	}
+
+	h1, h2 := murmurhash3.Sum128(buffer.Bytes(), 0)
+
+	hash := make([]byte, 16)
+	binary.BigEndian.PutUint64(hash[:8], h2)
+	binary.BigEndian.PutUint64(hash[8:], h1)
+	hash[0] &= 0x3F
+
+	return fmt.Sprintf("%X", hash)
+}
+
+func writePartitionKeyComponent(buffer *bytes.Buffer, value interface{}) {
+	switch typedValue := value.(type) {
+	case nil:
+		buffer.WriteByte(partitionKeyComponentNull)
+	case bool:
+		if typedValue {
+			buffer.WriteByte(partitionKeyComponentTrue)
+		} else {
+			buffer.WriteByte(partitionKeyComponentFalse)
+		}
+	case string:
+		buffer.WriteByte(partitionKeyComponentString)
+		buffer.WriteString(typedValue)
+		buffer.WriteByte(0xFF)
+	case float64:
+		buffer.WriteByte(partitionKeyComponentNumber)
+		binary.Write(buffer, binary.LittleEndian, math.Float64bits(typedValue))
+	case int:
+		writePartitionKeyComponent(buffer, float64(typedValue))
+	case int64:
+		writePartitionKeyComponent(buffer, float64(typedValue))
+	default:
+		buffer.WriteByte(partitionKeyComponentUndefined)
+	}
+}
+
+// GetPartitionCount returns the number of physical partitions of the collection
+func GetPartitionCount(collection Collection) int {
+	return max(collection.PartitionCount, 1)
+}
+
+// GetPartitionKeyRangeId returns the id of the partition key range the logical partition is placed in
+func GetPartitionKeyRangeId(collection Collection, partitionKey []interface{}) string {
+	effectivePartitionKey := GetEffectivePartitionKey(collection.PartitionKey, partitionKey)
+
+	partitionCount := GetPartitionCount(collection)
+	for i := 1; i < partitionCount; i++ {
+		if effectivePartitionKey < partitionKeyRangeBoundary(i, partitionCount) {
+			return strconv.Itoa(i - 1)
+		}
+	}
+
+	return strconv.Itoa(partitionCount - 1)
+}
+
+// NewPartitionKeyRanges splits the hash space evenly between the physical partitions of the collection,
+// lsns holds the current log sequence number of each range by its id
+func NewPartitionKeyRanges(databaseRid string, collection Collection, lsns map[string]int64) []PartitionKeyRange {
+	collectionRid := collection.ResourceID
+	if collectionRid == "" {
+		collectionRid = collection.ID
+	}
+
+	partitionCount := GetPartitionCount(collection)
+	partitionKeyRanges := make([]PartitionKeyRange, partitionCount)
+	for i := range partitionKeyRanges {
+		pkrResourceId := resourceid.NewCombined(collectionRid, resourceid.New(resourceid.ResourceTypePartitionKeyRange))
+		pkrId := strconv.Itoa(i)
+
+		partitionKeyRanges[i] = PartitionKeyRange{
+			ResourceID:         pkrResourceId,
+			ID:                 pkrId,
+			Etag:               fmt.Sprintf("\"%s\"", uuid.New()),
+			MinInclusive:       partitionKeyRangeBoundary(i, partitionCount),
+			MaxExclusive:       partitionKeyRangeBoundary(i+1, partitionCount),
+			RidPrefix:          i,
+			Self:               fmt.Sprintf("dbs/%s/colls/%s/pkranges/%s/", databaseRid, collectionRid, pkrResourceId),
+			ThroughputFraction: 1,
+			Status:             "online",
+			Parents:            []interface{}{},
+			TimeStamp:          collection.TimeStamp,
+			Lsn:                lsns[pkrId],
+		}
+	}
+
+	return partitionKeyRanges
+}
+
+// partitionKeyRangeBoundary returns the effective partition key where the range with the given index starts,
+// hashes always have their two highest bits cleared, so only that part of the space is split
+func partitionKeyRangeBoundary(index int, partitionCount int) string {
+	if index <= 0 {
+		return MinEffectivePartitionKey
+	}
+
+	if index >= partitionCount {
+		return MaxEffectivePartitionKey
+	}
+
+	hi, lo := bits.Mul64(uint64(index), 1<<62)
+	boundary, _ := bits.Div64(hi, lo, uint64(partitionCount))
+
+	return fmt.Sprintf("%016X", boundary)
+}
+
+// Contains checks if the effective partition key belongs to the range
+func (r PartitionKeyRange) Contains(effectivePartitionKey string) bool {
+	return effectivePartitionKey >= r.MinInclusive && effectivePartitionKey < r.MaxExclusive
+}
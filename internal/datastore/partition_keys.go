@@ -0,0 +1,62 @@
+package datastore
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// GetDocumentPartitionKey extracts the partition key values of the document using the partition key paths
+// of the collection, missing values are represented as an empty object, the same way Cosmos DB represents undefined
+func GetDocumentPartitionKey(collection Collection, document map[string]interface{}) []interface{} {
+	partitionKey := make([]interface{}, 0, len(collection.PartitionKey.Paths))
+
+	for _, path := range collection.PartitionKey.Paths {
+		var value interface{} = document
+		for _, part := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				value = map[string]interface{}{}
+				break
+			}
+
+			if value, ok = object[strings.Trim(part, "\"")]; !ok {
+				value = map[string]interface{}{}
+				break
+			}
+		}
+
+		partitionKey = append(partitionKey, value)
+	}
+
+	return partitionKey
+}
+
+// PartitionKeyString returns the canonical representation of partition key values (e.g. `["value"]`),
+// it is used to group the documents of the same logical partition
+func PartitionKeyString(partitionKey []interface{}) string {
+	if partitionKey == nil {
+		partitionKey = []interface{}{}
+	}
+
+	data, err := json.Marshal(partitionKey)
+	if err != nil {
+		return "[]"
+	}
+
+	return string(data)
+}
+
+// PartitionKeysEqual compares partition key values, numbers are equal regardless of their type
+func PartitionKeysEqual(a []interface{}, b []interface{}) bool {
+	return PartitionKeyString(a) == PartitionKeyString(b)
+}
+
+// PartitionKeyHasPrefix checks if the partition key starts with the given prefix,
+// hierarchical partition keys can be scoped to the first levels only
+func PartitionKeyHasPrefix(partitionKey []interface{}, prefix []interface{}) bool {
+	if len(prefix) > len(partitionKey) {
+		return false
+	}
+
+	return PartitionKeysEqual(partitionKey[:len(prefix)], prefix)
+}
@@ -0,0 +1,192 @@
+package replicadatastore
+
+import (
+	"maps"
+
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+// documentTransaction wraps a transaction of the wrapped data store and remembers its writes,
+// they are recorded for the replica once the transaction is committed. The replica receives all
+// writes of the transaction at once, so they share the LSN their partition key range has after the commit
+type documentTransaction struct {
+	datastore.DocumentTransaction
+
+	replica      *ReplicaDataStore
+	databaseId   string
+	collectionId string
+	collection   datastore.Collection
+	writes       []transactionWrite
+	done         bool
+}
+
+type transactionWrite struct {
+	partitionKey []interface{}
+	documentId   string
+	// previous is the version stored before the write, nil when the document did not exist
+	previous datastore.Document
+	// document is nil when the write is a delete
+	document datastore.Document
+}
+
+// BeginDocumentTransaction holds the write mutex until the transaction ends, so that no other write is recorded in between
+func (r *ReplicaDataStore) BeginDocumentTransaction(databaseId string, collectionId string) (datastore.DocumentTransaction, datastore.DataStoreStatus) {
+	r.writeMutex.Lock()
+
+	collection, status := r.DataStore.GetCollection(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		r.writeMutex.Unlock()
+		return nil, status
+	}
+
+	transaction, status := r.DataStore.BeginDocumentTransaction(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		r.writeMutex.Unlock()
+		return nil, status
+	}
+
+	return &documentTransaction{
+		DocumentTransaction: transaction,
+		replica:             r,
+		databaseId:          databaseId,
+		collectionId:        collectionId,
+		collection:          collection,
+	}, datastore.StatusOk
+}
+
+func (t *documentTransaction) DeleteDocument(partitionKey []interface{}, documentId string, etag string) datastore.DataStoreStatus {
+	existingDocument, status := t.DocumentTransaction.GetDocument(partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	status = t.DocumentTransaction.DeleteDocument(partitionKey, documentId, etag)
+	if status == datastore.StatusOk {
+		t.writes = append(t.writes, transactionWrite{
+			partitionKey: datastore.GetDocumentPartitionKey(t.collection, existingDocument),
+			documentId:   documentId,
+			previous:     maps.Clone(existingDocument),
+		})
+	}
+
+	return status
+}
+
+func (t *documentTransaction) CreateDocument(partitionKey []interface{}, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	createdDocument, status := t.DocumentTransaction.CreateDocument(partitionKey, document)
+	if status == datastore.StatusOk {
+		t.recordDocumentWrite(nil, createdDocument)
+	}
+
+	return createdDocument, status
+}
+
+func (t *documentTransaction) ReplaceDocument(partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	existingDocument, status := t.DocumentTransaction.GetDocument(partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+	existingDocument = maps.Clone(existingDocument)
+
+	replacedDocument, status := t.DocumentTransaction.ReplaceDocument(partitionKey, documentId, document, etag)
+	if status == datastore.StatusOk {
+		t.recordDocumentWrite(existingDocument, replacedDocument)
+	}
+
+	return replacedDocument, status
+}
+
+func (t *documentTransaction) UpsertDocument(partitionKey []interface{}, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	var existingDocument datastore.Document
+	if documentId, ok := document["id"].(string); ok && documentId != "" {
+		documentPartitionKey := datastore.GetDocumentPartitionKey(t.collection, document)
+		if currentDocument, status := t.DocumentTransaction.GetDocument(documentPartitionKey, documentId); status == datastore.StatusOk {
+			existingDocument = maps.Clone(currentDocument)
+		}
+	}
+
+	upsertedDocument, status := t.DocumentTransaction.UpsertDocument(partitionKey, document, etag)
+	if status == datastore.StatusOk {
+		t.recordDocumentWrite(existingDocument, upsertedDocument)
+	}
+
+	return upsertedDocument, status
+}
+
+func (t *documentTransaction) Commit() datastore.DataStoreStatus {
+	if t.done {
+		return datastore.StatusOk
+	}
+	defer t.end()
+
+	// Reads of the replica must not see the committed writes before they are recorded
+	t.replica.mutex.Lock()
+	defer t.replica.mutex.Unlock()
+
+	status := t.DocumentTransaction.Commit()
+	if status == datastore.StatusOk {
+		t.recordWrites()
+	}
+
+	return status
+}
+
+func (t *documentTransaction) Rollback() {
+	if t.done {
+		return
+	}
+	defer t.end()
+
+	t.DocumentTransaction.Rollback()
+}
+
+func (t *documentTransaction) end() {
+	t.done = true
+	t.writes = nil
+	t.replica.writeMutex.Unlock()
+}
+
+// recordDocumentWrite remembers the new version of a written document, when the document moved to another
+// partition key or id the old version is remembered as deleted
+func (t *documentTransaction) recordDocumentWrite(existingDocument datastore.Document, document datastore.Document) {
+	documentId, _ := document["id"].(string)
+	documentPartitionKey := datastore.GetDocumentPartitionKey(t.collection, document)
+
+	if existingDocument != nil {
+		existingId, _ := existingDocument["id"].(string)
+		existingPartitionKey := datastore.GetDocumentPartitionKey(t.collection, existingDocument)
+		if existingId != documentId || !datastore.PartitionKeysEqual(existingPartitionKey, documentPartitionKey) {
+			t.writes = append(t.writes, transactionWrite{
+				partitionKey: existingPartitionKey,
+				documentId:   existingId,
+				previous:     existingDocument,
+			})
+			existingDocument = nil
+		}
+	}
+
+	t.writes = append(t.writes, transactionWrite{
+		partitionKey: documentPartitionKey,
+		documentId:   documentId,
+		previous:     existingDocument,
+		document:     maps.Clone(document),
+	})
+}
+
+// recordWrites records the committed writes for the replica, the caller has to hold the mutex
+func (t *documentTransaction) recordWrites() {
+	lsns := make(map[string]int64)
+	for _, write := range t.writes {
+		partitionKeyRangeId := datastore.GetPartitionKeyRangeId(t.collection, write.partitionKey)
+		if _, ok := lsns[partitionKeyRangeId]; !ok {
+			lsns[partitionKeyRangeId] = t.replica.primaryLSN(t.databaseId, t.collectionId, partitionKeyRangeId)
+			t.replica.recordWrite(t.databaseId, t.collectionId, partitionKeyRangeId, lsns[partitionKeyRangeId])
+		}
+
+		t.replica.recordVersion(t.databaseId, t.collectionId, write.partitionKey, write.documentId, write.previous, documentVersion{
+			partitionKeyRangeId: partitionKeyRangeId,
+			lsn:                 lsns[partitionKeyRangeId],
+			document:            write.document,
+		})
+	}
+}
@@ -0,0 +1,433 @@
+package replicadatastore
+
+import (
+	"maps"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+type ConsistencyLevel string
+
+const (
+	ConsistencyLevelStrong           ConsistencyLevel = "Strong"
+	ConsistencyLevelBoundedStaleness ConsistencyLevel = "BoundedStaleness"
+	ConsistencyLevelSession          ConsistencyLevel = "Session"
+	ConsistencyLevelConsistentPrefix ConsistencyLevel = "ConsistentPrefix"
+	ConsistencyLevelEventual         ConsistencyLevel = "Eventual"
+)
+
+type ReplicaDataStoreOptions struct {
+	// ReplicationLag is the time it takes for a write to reach the replica
+	ReplicationLag time.Duration
+	// MaxStalenessPrefix is the number of writes per partition key range a BoundedStaleness read can lag behind
+	MaxStalenessPrefix int64
+	// MaxStalenessInterval is the time a BoundedStaleness read can lag behind
+	MaxStalenessInterval time.Duration
+}
+
+// ReplicaDataStore simulates a read replica that lags behind the data store it wraps. Writes go straight
+// to the wrapped data store and are remembered until the replica has received them, views of the replica
+// leave out the writes a read with the given consistency level is not guaranteed to see
+type ReplicaDataStore struct {
+	datastore.DataStore
+
+	options ReplicaDataStoreOptions
+	mutex   sync.Mutex
+	// writeMutex serializes document writes, so that they are recorded in the order they happen.
+	// Document transactions hold it until they end but take the mutex only to commit, so reads don't wait for them
+	writeMutex sync.Mutex
+
+	// Map databaseId/collectionId -> partitionKeyRangeId -> writes the replica has not received yet
+	ranges map[string]map[string]*replicationLog
+
+	// Map databaseId/collectionId -> partitionKey/documentId -> versions the replica has not received yet
+	documents map[string]map[string]*documentHistory
+}
+
+type replicationLog struct {
+	// baseLSN is the LSN of the range that every read sees
+	baseLSN int64
+	writes  []replicatedWrite
+}
+
+type replicatedWrite struct {
+	lsn       int64
+	timestamp time.Time
+}
+
+type documentHistory struct {
+	partitionKey []interface{}
+	documentId   string
+	// base is the version that every read sees, nil when the document did not exist
+	base     datastore.Document
+	versions []documentVersion
+}
+
+type documentVersion struct {
+	partitionKeyRangeId string
+	lsn                 int64
+	// document is nil when the version is a delete
+	document datastore.Document
+}
+
+func NewReplicaDataStore(dataStore datastore.DataStore, options ReplicaDataStoreOptions) *ReplicaDataStore {
+	return &ReplicaDataStore{
+		DataStore: dataStore,
+		options:   options,
+		ranges:    make(map[string]map[string]*replicationLog),
+		documents: make(map[string]map[string]*documentHistory),
+	}
+}
+
+func (r *ReplicaDataStore) DeleteDatabase(databaseId string, etag string) datastore.DataStoreStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	status := r.DataStore.DeleteDatabase(databaseId, etag)
+	if status == datastore.StatusOk {
+		r.forget(databaseId + "/")
+	}
+
+	return status
+}
+
+func (r *ReplicaDataStore) DeleteCollection(databaseId string, collectionId string, etag string) datastore.DataStoreStatus {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	status := r.DataStore.DeleteCollection(databaseId, collectionId, etag)
+	if status == datastore.StatusOk {
+		r.forget(generateCollectionKey(databaseId, collectionId))
+	}
+
+	return status
+}
+
+func (r *ReplicaDataStore) DeleteDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) datastore.DataStoreStatus {
+	r.writeMutex.Lock()
+	defer r.writeMutex.Unlock()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	existingDocument, status := r.DataStore.GetDocument(databaseId, collectionId, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	status = r.DataStore.DeleteDocument(databaseId, collectionId, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	collection, _ := r.DataStore.GetCollection(databaseId, collectionId)
+	documentPartitionKey := datastore.GetDocumentPartitionKey(collection, existingDocument)
+	partitionKeyRangeId := datastore.GetPartitionKeyRangeId(collection, documentPartitionKey)
+	lsn := r.primaryLSN(databaseId, collectionId, partitionKeyRangeId)
+
+	r.recordWrite(databaseId, collectionId, partitionKeyRangeId, lsn)
+	r.recordVersion(databaseId, collectionId, documentPartitionKey, documentId, existingDocument, documentVersion{
+		partitionKeyRangeId: partitionKeyRangeId,
+		lsn:                 lsn,
+	})
+
+	return status
+}
+
+func (r *ReplicaDataStore) DeleteDocumentsByPartitionKey(databaseId string, collectionId string, partitionKey []interface{}) datastore.DataStoreStatus {
+	r.writeMutex.Lock()
+	defer r.writeMutex.Unlock()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	collection, status := r.DataStore.GetCollection(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	documents, status := r.DataStore.GetAllDocuments(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	status = r.DataStore.DeleteDocumentsByPartitionKey(databaseId, collectionId, partitionKey)
+	if status != datastore.StatusOk {
+		return status
+	}
+
+	lsns := make(map[string]int64)
+	for _, document := range documents {
+		documentPartitionKey := datastore.GetDocumentPartitionKey(collection, document)
+		if !datastore.PartitionKeyHasPrefix(documentPartitionKey, partitionKey) {
+			continue
+		}
+
+		partitionKeyRangeId := datastore.GetPartitionKeyRangeId(collection, documentPartitionKey)
+		lsn, ok := lsns[partitionKeyRangeId]
+		if !ok {
+			lsn = r.primaryLSN(databaseId, collectionId, partitionKeyRangeId)
+			lsns[partitionKeyRangeId] = lsn
+			r.recordWrite(databaseId, collectionId, partitionKeyRangeId, lsn)
+		}
+
+		documentId, _ := document["id"].(string)
+		r.recordVersion(databaseId, collectionId, documentPartitionKey, documentId, document, documentVersion{
+			partitionKeyRangeId: partitionKeyRangeId,
+			lsn:                 lsn,
+		})
+	}
+
+	return status
+}
+
+func (r *ReplicaDataStore) CreateDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}) (datastore.Document, datastore.DataStoreStatus) {
+	r.writeMutex.Lock()
+	defer r.writeMutex.Unlock()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	createdDocument, status := r.DataStore.CreateDocument(databaseId, collectionId, partitionKey, document)
+	if status != datastore.StatusOk {
+		return createdDocument, status
+	}
+
+	r.recordDocumentWrite(databaseId, collectionId, nil, createdDocument)
+
+	return createdDocument, status
+}
+
+func (r *ReplicaDataStore) ReplaceDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	r.writeMutex.Lock()
+	defer r.writeMutex.Unlock()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	existingDocument, status := r.DataStore.GetDocument(databaseId, collectionId, partitionKey, documentId)
+	if status != datastore.StatusOk {
+		return datastore.Document{}, status
+	}
+
+	replacedDocument, status := r.DataStore.ReplaceDocument(databaseId, collectionId, partitionKey, documentId, document, etag)
+	if status != datastore.StatusOk {
+		return replacedDocument, status
+	}
+
+	r.recordDocumentWrite(databaseId, collectionId, existingDocument, replacedDocument)
+
+	return replacedDocument, status
+}
+
+func (r *ReplicaDataStore) UpsertDocument(databaseId string, collectionId string, partitionKey []interface{}, document map[string]interface{}, etag string) (datastore.Document, datastore.DataStoreStatus) {
+	r.writeMutex.Lock()
+	defer r.writeMutex.Unlock()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var existingDocument datastore.Document
+	if documentId, ok := document["id"].(string); ok && documentId != "" {
+		collection, _ := r.DataStore.GetCollection(databaseId, collectionId)
+		documentPartitionKey := datastore.GetDocumentPartitionKey(collection, document)
+		if currentDocument, status := r.DataStore.GetDocument(databaseId, collectionId, documentPartitionKey, documentId); status == datastore.StatusOk {
+			existingDocument = currentDocument
+		}
+	}
+
+	upsertedDocument, status := r.DataStore.UpsertDocument(databaseId, collectionId, partitionKey, document, etag)
+	if status != datastore.StatusOk {
+		return upsertedDocument, status
+	}
+
+	r.recordDocumentWrite(databaseId, collectionId, existingDocument, upsertedDocument)
+
+	return upsertedDocument, status
+}
+
+// recordDocumentWrite remembers the new version of a written document, when the document moved to another
+// partition key or id the old version is remembered as deleted, the caller has to hold the mutex
+func (r *ReplicaDataStore) recordDocumentWrite(databaseId string, collectionId string, existingDocument datastore.Document, document datastore.Document) {
+	collection, _ := r.DataStore.GetCollection(databaseId, collectionId)
+	documentPartitionKey := datastore.GetDocumentPartitionKey(collection, document)
+	partitionKeyRangeId := datastore.GetPartitionKeyRangeId(collection, documentPartitionKey)
+	lsn := toLSN(document["_lsn"])
+
+	r.recordWrite(databaseId, collectionId, partitionKeyRangeId, lsn)
+
+	documentId, _ := document["id"].(string)
+	if existingDocument != nil {
+		existingId, _ := existingDocument["id"].(string)
+		existingPartitionKey := datastore.GetDocumentPartitionKey(collection, existingDocument)
+		if existingId != documentId || !datastore.PartitionKeysEqual(existingPartitionKey, documentPartitionKey) {
+			r.recordVersion(databaseId, collectionId, existingPartitionKey, existingId, existingDocument, documentVersion{
+				partitionKeyRangeId: partitionKeyRangeId,
+				lsn:                 lsn,
+			})
+			existingDocument = nil
+		}
+	}
+
+	r.recordVersion(databaseId, collectionId, documentPartitionKey, documentId, existingDocument, documentVersion{
+		partitionKeyRangeId: partitionKeyRangeId,
+		lsn:                 lsn,
+		document:            maps.Clone(document),
+	})
+}
+
+// recordWrite adds a write to the replication log of the partition key range,
+// the writes every read has seen are dropped on the way, the caller has to hold the mutex
+func (r *ReplicaDataStore) recordWrite(databaseId string, collectionId string, partitionKeyRangeId string, lsn int64) {
+	collectionKey := generateCollectionKey(databaseId, collectionId)
+	if r.ranges[collectionKey] == nil {
+		r.ranges[collectionKey] = make(map[string]*replicationLog)
+	}
+
+	log, ok := r.ranges[collectionKey][partitionKeyRangeId]
+	if !ok {
+		// Writes made before the range was tracked are considered replicated
+		log = &replicationLog{baseLSN: lsn - 1}
+		r.ranges[collectionKey][partitionKeyRangeId] = log
+	}
+
+	now := time.Now()
+	log.writes = append(log.writes, replicatedWrite{lsn: lsn, timestamp: now})
+
+	r.compact(collectionKey, now)
+}
+
+// recordVersion adds a version to the history of a document, previous is the version
+// that was stored before the write, the caller has to hold the mutex
+func (r *ReplicaDataStore) recordVersion(databaseId string, collectionId string, partitionKey []interface{}, documentId string, previous datastore.Document, version documentVersion) {
+	collectionKey := generateCollectionKey(databaseId, collectionId)
+	documentKey := generateDocumentKey(partitionKey, documentId)
+	if r.documents[collectionKey] == nil {
+		r.documents[collectionKey] = make(map[string]*documentHistory)
+	}
+
+	history, ok := r.documents[collectionKey][documentKey]
+	if !ok {
+		history = &documentHistory{
+			partitionKey: partitionKey,
+			documentId:   documentId,
+			base:         maps.Clone(previous),
+		}
+		r.documents[collectionKey][documentKey] = history
+	}
+
+	history.versions = append(history.versions, version)
+}
+
+// compact drops the writes that the replica has received, documents without pending versions
+// are read from the wrapped data store again, the caller has to hold the mutex
+func (r *ReplicaDataStore) compact(collectionKey string, now time.Time) {
+	replicatedLSNs := make(map[string]int64, len(r.ranges[collectionKey]))
+	for partitionKeyRangeId, log := range r.ranges[collectionKey] {
+		replicatedLSN := log.lsnAt(now.Add(-r.options.ReplicationLag))
+		log.writes = log.writes[len(log.writes)-log.pendingCount(replicatedLSN):]
+		log.baseLSN = replicatedLSN
+		replicatedLSNs[partitionKeyRangeId] = replicatedLSN
+	}
+
+	for documentKey, history := range r.documents[collectionKey] {
+		replicated := 0
+		for _, version := range history.versions {
+			if version.lsn > replicatedLSNs[version.partitionKeyRangeId] {
+				break
+			}
+			history.base = version.document
+			replicated++
+		}
+
+		history.versions = history.versions[replicated:]
+		if len(history.versions) == 0 {
+			delete(r.documents[collectionKey], documentKey)
+		}
+	}
+}
+
+// forget drops everything remembered about the collections with the key prefix, the caller has to hold the mutex
+func (r *ReplicaDataStore) forget(collectionKeyPrefix string) {
+	for collectionKey := range r.ranges {
+		if strings.HasPrefix(collectionKey, collectionKeyPrefix) {
+			delete(r.ranges, collectionKey)
+		}
+	}
+
+	for collectionKey := range r.documents {
+		if strings.HasPrefix(collectionKey, collectionKeyPrefix) {
+			delete(r.documents, collectionKey)
+		}
+	}
+}
+
+// primaryLSN returns the current LSN of the partition key range in the wrapped data store
+func (r *ReplicaDataStore) primaryLSN(databaseId string, collectionId string, partitionKeyRangeId string) int64 {
+	partitionKeyRanges, _ := r.DataStore.GetPartitionKeyRanges(databaseId, collectionId)
+	for _, partitionKeyRange := range partitionKeyRanges {
+		if partitionKeyRange.ID == partitionKeyRangeId {
+			return partitionKeyRange.Lsn
+		}
+	}
+
+	return 0
+}
+
+// lsnAt returns the LSN the range had at the given time
+func (l *replicationLog) lsnAt(timestamp time.Time) int64 {
+	lsn := l.baseLSN
+	for _, write := range l.writes {
+		if write.timestamp.After(timestamp) {
+			break
+		}
+		lsn = write.lsn
+	}
+
+	return lsn
+}
+
+// latestLSN returns the LSN of the last write to the range
+func (l *replicationLog) latestLSN() int64 {
+	if len(l.writes) == 0 {
+		return l.baseLSN
+	}
+
+	return l.writes[len(l.writes)-1].lsn
+}
+
+func (l *replicationLog) pendingCount(replicatedLSN int64) int {
+	pending := 0
+	for i := len(l.writes) - 1; i >= 0 && l.writes[i].lsn > replicatedLSN; i-- {
+		pending++
+	}
+
+	return pending
+}
+
+func generateCollectionKey(databaseId string, collectionId string) string {
+	return databaseId + "/" + collectionId
+}
+
+func generateDocumentKey(partitionKey []interface{}, documentId string) string {
+	return datastore.PartitionKeyString(partitionKey) + "/" + documentId
+}
+
+// toLSN reads the _lsn of a document, data stores may decode it as any numeric type
+func toLSN(value interface{}) int64 {
+	lsn := reflect.ValueOf(value)
+	switch {
+	case lsn.CanInt():
+		return lsn.Int()
+	case lsn.CanUint():
+		return int64(lsn.Uint())
+	case lsn.CanFloat():
+		return int64(lsn.Float())
+	}
+
+	return 0
+}
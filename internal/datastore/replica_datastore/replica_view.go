@@ -0,0 +1,223 @@
+package replicadatastore
+
+import (
+	"time"
+
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+// replicaView reads documents the way a replica serving the consistency level sees them,
+// everything except document reads and partition key ranges comes from the wrapped data store
+type replicaView struct {
+	datastore.DataStore
+
+	replica     *ReplicaDataStore
+	level       ConsistencyLevel
+	sessionLSNs map[string]int64
+}
+
+// View returns the data store as seen by a read with the given consistency level, Session reads
+// see at least the LSNs of the session token, Strong reads are served by the wrapped data store
+func (r *ReplicaDataStore) View(level ConsistencyLevel, sessionLSNs map[string]int64) datastore.DataStore {
+	if level == ConsistencyLevelStrong || r.options.ReplicationLag <= 0 {
+		return r.DataStore
+	}
+
+	return &replicaView{
+		DataStore:   r.DataStore,
+		replica:     r,
+		level:       level,
+		sessionLSNs: sessionLSNs,
+	}
+}
+
+func (v *replicaView) GetDocument(databaseId string, collectionId string, partitionKey []interface{}, documentId string) (datastore.Document, datastore.DataStoreStatus) {
+	v.replica.mutex.Lock()
+	defer v.replica.mutex.Unlock()
+
+	collectionKey := generateCollectionKey(databaseId, collectionId)
+	document, status := v.DataStore.GetDocument(databaseId, collectionId, partitionKey, documentId)
+	if status == datastore.StatusOk {
+		collection, _ := v.DataStore.GetCollection(databaseId, collectionId)
+		documentKey := generateDocumentKey(datastore.GetDocumentPartitionKey(collection, document), documentId)
+		if _, ok := v.replica.documents[collectionKey][documentKey]; !ok {
+			return document, status
+		}
+	} else if status != datastore.StatusNotFound {
+		return document, status
+	}
+
+	replicaLSNs := v.replicaLSNs(collectionKey)
+	for _, history := range v.replica.documents[collectionKey] {
+		if history.documentId != documentId || (partitionKey != nil && !datastore.PartitionKeysEqual(partitionKey, history.partitionKey)) {
+			continue
+		}
+
+		if document := history.visibleDocument(replicaLSNs); document != nil {
+			return document, datastore.StatusOk
+		}
+	}
+
+	return datastore.Document{}, datastore.StatusNotFound
+}
+
+func (v *replicaView) GetAllDocuments(databaseId string, collectionId string) ([]datastore.Document, datastore.DataStoreStatus) {
+	iterator, status := v.GetDocumentIterator(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil, status
+	}
+	defer iterator.Close()
+
+	documents := make([]datastore.Document, 0)
+	for {
+		document, status := iterator.Next()
+		if status == datastore.IterEOF || status == datastore.StatusNotFound {
+			break
+		}
+		if status != datastore.StatusOk {
+			return nil, status
+		}
+
+		documents = append(documents, document)
+	}
+
+	return documents, datastore.StatusOk
+}
+
+func (v *replicaView) GetDocumentIterator(databaseId string, collectionId string) (datastore.DocumentIterator, datastore.DataStoreStatus) {
+	v.replica.mutex.Lock()
+	defer v.replica.mutex.Unlock()
+
+	collection, status := v.DataStore.GetCollection(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return nil, status
+	}
+
+	iterator, status := v.DataStore.GetDocumentIterator(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return iterator, status
+	}
+
+	collectionKey := generateCollectionKey(databaseId, collectionId)
+	replicaLSNs := v.replicaLSNs(collectionKey)
+	replicaIterator := &replicaDocumentIterator{
+		iterator:   iterator,
+		collection: collection,
+		skipped:    make(map[string]struct{}, len(v.replica.documents[collectionKey])),
+	}
+	for documentKey, history := range v.replica.documents[collectionKey] {
+		replicaIterator.skipped[documentKey] = struct{}{}
+		if document := history.visibleDocument(replicaLSNs); document != nil {
+			replicaIterator.documents = append(replicaIterator.documents, document)
+		}
+	}
+
+	return replicaIterator, datastore.StatusOk
+}
+
+func (v *replicaView) GetPartitionKeyRanges(databaseId string, collectionId string) ([]datastore.PartitionKeyRange, datastore.DataStoreStatus) {
+	v.replica.mutex.Lock()
+	defer v.replica.mutex.Unlock()
+
+	partitionKeyRanges, status := v.DataStore.GetPartitionKeyRanges(databaseId, collectionId)
+	if status != datastore.StatusOk {
+		return partitionKeyRanges, status
+	}
+
+	replicaLSNs := v.replicaLSNs(generateCollectionKey(databaseId, collectionId))
+	for i, partitionKeyRange := range partitionKeyRanges {
+		if lsn, ok := replicaLSNs[partitionKeyRange.ID]; ok && lsn < partitionKeyRange.Lsn {
+			partitionKeyRanges[i].Lsn = lsn
+		}
+	}
+
+	return partitionKeyRanges, status
+}
+
+// replicaLSNs returns the LSN the replica has reached for every tracked partition key range,
+// ranges without pending writes are missing, the caller has to hold the mutex
+func (v *replicaView) replicaLSNs(collectionKey string) map[string]int64 {
+	now := time.Now()
+	options := v.replica.options
+
+	lsns := make(map[string]int64, len(v.replica.ranges[collectionKey]))
+	for partitionKeyRangeId, log := range v.replica.ranges[collectionKey] {
+		latestLSN := log.latestLSN()
+		lsn := log.lsnAt(now.Add(-options.ReplicationLag))
+
+		switch v.level {
+		case ConsistencyLevelBoundedStaleness:
+			lsn = max(lsn, log.lsnAt(now.Add(-options.MaxStalenessInterval)), latestLSN-options.MaxStalenessPrefix)
+		case ConsistencyLevelSession:
+			lsn = max(lsn, v.sessionLSNs[partitionKeyRangeId])
+		case ConsistencyLevelEventual, ConsistencyLevelConsistentPrefix:
+		default:
+			lsn = latestLSN
+		}
+
+		if lsn < latestLSN {
+			lsns[partitionKeyRangeId] = lsn
+		}
+	}
+
+	return lsns
+}
+
+// visibleDocument returns the last version the replica has received, nil when the document is deleted
+func (h *documentHistory) visibleDocument(replicaLSNs map[string]int64) datastore.Document {
+	document := h.base
+	for _, version := range h.versions {
+		if lsn, ok := replicaLSNs[version.partitionKeyRangeId]; ok && version.lsn > lsn {
+			continue
+		}
+		document = version.document
+	}
+
+	return document
+}
+
+// replicaDocumentIterator iterates the wrapped data store leaving out the documents
+// with pending versions, the versions visible to the replica are returned at the end
+type replicaDocumentIterator struct {
+	iterator   datastore.DocumentIterator
+	collection datastore.Collection
+	skipped    map[string]struct{}
+	documents  []datastore.Document
+}
+
+func (i *replicaDocumentIterator) Next() (datastore.Document, datastore.DataStoreStatus) {
+	for i.iterator != nil {
+		document, status := i.iterator.Next()
+		if status == datastore.IterEOF || status == datastore.StatusNotFound {
+			i.iterator.Close()
+			i.iterator = nil
+			break
+		}
+		if status != datastore.StatusOk {
+			return document, status
+		}
+
+		documentId, _ := document["id"].(string)
+		documentKey := generateDocumentKey(datastore.GetDocumentPartitionKey(i.collection, document), documentId)
+		if _, ok := i.skipped[documentKey]; !ok {
+			return document, status
+		}
+	}
+
+	if len(i.documents) == 0 {
+		return datastore.Document{}, datastore.IterEOF
+	}
+
+	document := i.documents[0]
+	i.documents = i.documents[1:]
+
+	return document, datastore.StatusOk
+}
+
+func (i *replicaDocumentIterator) Close() {
+	if i.iterator != nil {
+		i.iterator.Close()
+		i.iterator = nil
+	}
+	i.documents = nil
+}
@@ -0,0 +1,147 @@
+package jsruntime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+const (
+	// MaxCallStackSize limits the recursion depth of server-side scripts
+	MaxCallStackSize = 512
+	// DefaultTimeout limits how long a single script invocation is allowed to run
+	DefaultTimeout = 5 * time.Second
+)
+
+var ErrTimeout = errors.New("script execution timed out")
+
+// New creates a JavaScript runtime with the limits that apply to server-side scripts
+func New() *goja.Runtime {
+	vm := goja.New()
+	vm.SetMaxCallStackSize(MaxCallStackSize)
+	return vm
+}
+
+// Compile evaluates a script body that defines a single function
+// (e.g. "function (a, b) { return a + b; }") and returns it as a callable
+func Compile(vm *goja.Runtime, name string, body string) (goja.Callable, error) {
+	value, err := vm.RunScript(name, "("+strings.TrimSpace(body)+"\n)")
+	if err != nil {
+		return nil, err
+	}
+
+	function, ok := goja.AssertFunction(value)
+	if !ok {
+		return nil, fmt.Errorf("script '%s' does not define a function", name)
+	}
+
+	return function, nil
+}
+
+// Call invokes the function and interrupts it if it runs for longer than the timeout
+func Call(vm *goja.Runtime, function goja.Callable, timeout time.Duration, arguments ...goja.Value) (goja.Value, error) {
+	interrupted := make(chan struct{})
+	timer := time.AfterFunc(timeout, func() {
+		vm.Interrupt(ErrTimeout)
+		close(interrupted)
+	})
+	defer func() {
+		// An interrupt that arrives after the call would stop the next call on the runtime,
+		// so the timer has to be stopped or finished before the interrupt is cleared
+		if !timer.Stop() {
+			<-interrupted
+		}
+		vm.ClearInterrupt()
+	}()
+
+	return function(goja.Undefined(), arguments...)
+}
+
+// ToValue converts a JSON compatible go value into a plain JavaScript value
+func ToValue(vm *goja.Runtime, value interface{}) (goja.Value, error) {
+	if value == nil {
+		return goja.Undefined(), nil
+	}
+
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	parse, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("parse"))
+	return parse(goja.Undefined(), vm.ToValue(string(jsonValue)))
+}
+
+// Export converts a JavaScript value into a JSON compatible go value,
+// undefined and values that can not be represented in JSON are exported as nil
+func Export(vm *goja.Runtime, value goja.Value) (interface{}, error) {
+	if value == nil || goja.IsUndefined(value) {
+		return nil, nil
+	}
+
+	stringify, _ := goja.AssertFunction(vm.Get("JSON").ToObject(vm).Get("stringify"))
+	jsonValue, err := stringify(goja.Undefined(), value)
+	if err != nil {
+		return nil, err
+	}
+
+	if goja.IsUndefined(jsonValue) {
+		return nil, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(jsonValue.String())))
+	decoder.UseNumber()
+
+	var result interface{}
+	if err := decoder.Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return convertJsonNumbers(result), nil
+}
+
+// ErrorMessage formats an error returned by the runtime the way Cosmos DB reports script failures
+func ErrorMessage(err error) string {
+	var exception *goja.Exception
+	if errors.As(err, &exception) {
+		return fmt.Sprintf("Encountered exception while executing Javascript. Exception = %s", exception.Value().String())
+	}
+
+	var interrupted *goja.InterruptedError
+	if errors.As(err, &interrupted) {
+		return "Encountered exception while executing Javascript. Exception = Script execution timed out."
+	}
+
+	var stackOverflow *goja.StackOverflowError
+	if errors.As(err, &stackOverflow) {
+		return "Encountered exception while executing Javascript. Exception = Maximum call stack size exceeded."
+	}
+
+	return fmt.Sprintf("Encountered exception while executing Javascript. Exception = %s", err.Error())
+}
+
+func convertJsonNumbers(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if intValue, err := typedValue.Int64(); err == nil {
+			return int(intValue)
+		}
+		floatValue, _ := typedValue.Float64()
+		return floatValue
+	case map[string]interface{}:
+		for key, item := range typedValue {
+			typedValue[key] = convertJsonNumbers(item)
+		}
+	case []interface{}:
+		for i, item := range typedValue {
+			typedValue[i] = convertJsonNumbers(item)
+		}
+	}
+
+	return value
+}
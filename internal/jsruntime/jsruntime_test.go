@@ -0,0 +1,37 @@
+package jsruntime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pikami/cosmium/internal/jsruntime"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Call(t *testing.T) {
+	t.Run("Should interrupt calls that run into the timeout", func(t *testing.T) {
+		vm := jsruntime.New()
+		function, err := jsruntime.Compile(vm, "loop", "function () { while (true) {} }")
+		assert.Nil(t, err)
+
+		_, err = jsruntime.Call(vm, function, 10*time.Millisecond)
+		assert.NotNil(t, err)
+		assert.Equal(t, "Encountered exception while executing Javascript. Exception = Script execution timed out.", jsruntime.ErrorMessage(err))
+	})
+
+	t.Run("Should not interrupt the call after a call that almost timed out", func(t *testing.T) {
+		vm := jsruntime.New()
+		slow, err := jsruntime.Compile(vm, "slow", "function (ms) { var end = Date.now() + ms; while (Date.now() < end) {} return 1; }")
+		assert.Nil(t, err)
+		fast, err := jsruntime.Compile(vm, "fast", "function () { var sum = 0; for (var i = 0; i < 100000; i++) { sum += i; } return sum; }")
+		assert.Nil(t, err)
+
+		for i := 0; i < 20; i++ {
+			jsruntime.Call(vm, slow, 5*time.Millisecond, vm.ToValue(5))
+
+			value, err := jsruntime.Call(vm, fast, time.Second)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(4999950000), value.Export())
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package murmurhash3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sum128(t *testing.T) {
+	testCases := []struct {
+		input string
+		h1    uint64
+		h2    uint64
+	}{
+		{"", 0x0000000000000000, 0x0000000000000000},
+		{"hello", 0xcbd8a7b341bd9b02, 0x5b1e906a48ae1d19},
+		{"The quick brown fox jumps over the lazy dog", 0xe34bbc7bbc071b6c, 0x7a433ca9c49a9347},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			h1, h2 := Sum128([]byte(testCase.input), 0)
+			assert.Equal(t, testCase.h1, h1)
+			assert.Equal(t, testCase.h2, h2)
+		})
+	}
+}
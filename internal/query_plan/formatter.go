@@ -0,0 +1,381 @@
+package queryplan
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pikami/cosmium/parsers"
+)
+
+// orderByFilterPlaceholder is replaced by the SDKs with the continuation filter
+// of each partition when executing an ORDER BY query
+type orderByFilterPlaceholder struct{}
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+var functionNames = map[parsers.FunctionCallType]string{
+	parsers.FunctionCallIndexOf:           "INDEX_OF",
+	parsers.FunctionCallIsDefined:         "IS_DEFINED",
+	parsers.FunctionCallIsArray:           "IS_ARRAY",
+	parsers.FunctionCallIsBool:            "IS_BOOL",
+	parsers.FunctionCallIsFiniteNumber:    "IS_FINITE_NUMBER",
+	parsers.FunctionCallIsInteger:         "IS_INTEGER",
+	parsers.FunctionCallIsNull:            "IS_NULL",
+	parsers.FunctionCallIsNumber:          "IS_NUMBER",
+	parsers.FunctionCallIsObject:          "IS_OBJECT",
+	parsers.FunctionCallIsPrimitive:       "IS_PRIMITIVE",
+	parsers.FunctionCallIsString:          "IS_STRING",
+	parsers.FunctionCallArrayConcat:       "ARRAY_CONCAT",
+	parsers.FunctionCallArrayContains:     "ARRAY_CONTAINS",
+	parsers.FunctionCallArrayContainsAny:  "ARRAY_CONTAINS_ANY",
+	parsers.FunctionCallArrayContainsAll:  "ARRAY_CONTAINS_ALL",
+	parsers.FunctionCallArrayLength:       "ARRAY_LENGTH",
+	parsers.FunctionCallArraySlice:        "ARRAY_SLICE",
+	parsers.FunctionCallStArea:            "ST_AREA",
+	parsers.FunctionCallStDistance:        "ST_DISTANCE",
+	parsers.FunctionCallStWithin:          "ST_WITHIN",
+	parsers.FunctionCallStIntersects:      "ST_INTERSECTS",
+	parsers.FunctionCallStIsValid:         "ST_ISVALID",
+	parsers.FunctionCallStIsValidDetailed: "ST_ISVALIDDETAILED",
+}
+
+// FormatQuery converts a parsed query back to its SQL representation
+func FormatQuery(query parsers.SelectStmt) string {
+	var sb strings.Builder
+
+	sb.WriteString("SELECT ")
+	if query.Distinct {
+		sb.WriteString("DISTINCT ")
+	}
+
+	if query.Count > 0 && query.Offset == 0 {
+		sb.WriteString(fmt.Sprintf("TOP %d ", query.Count))
+	}
+
+	sb.WriteString(formatSelectItems(query))
+
+	if table := formatTable(query.Table); table != "" {
+		sb.WriteString("\nFROM " + table)
+	}
+
+	for _, joinItem := range query.JoinItems {
+		sb.WriteString("\nJOIN " + formatJoinItem(joinItem))
+	}
+
+	if query.Filters != nil {
+		sb.WriteString("\nWHERE " + formatExpression(query.Filters))
+	}
+
+	if len(query.GroupBy) > 0 {
+		groupBy := make([]string, len(query.GroupBy))
+		for i, selectItem := range query.GroupBy {
+			groupBy[i] = formatExpression(selectItem)
+		}
+		sb.WriteString("\nGROUP BY " + strings.Join(groupBy, ", "))
+	}
+
+	if len(query.OrderExpressions) > 0 {
+		orderBy := make([]string, len(query.OrderExpressions))
+		for i, orderExpression := range query.OrderExpressions {
+			orderBy[i] = formatExpression(orderExpression.SelectItem) + " " + formatOrderDirection(orderExpression.Direction)
+		}
+		sb.WriteString("\nORDER BY " + strings.Join(orderBy, ", "))
+	}
+
+	if query.Offset > 0 {
+		sb.WriteString(fmt.Sprintf("\nOFFSET %d LIMIT %d", query.Offset, query.Count))
+	}
+
+	return sb.String()
+}
+
+func formatSelectItems(query parsers.SelectStmt) string {
+	selectItems := query.SelectItems
+	if len(selectItems) == 1 && selectItems[0].IsTopLevel {
+		if isSelectAsterisk(query) {
+			return "*"
+		}
+		return "VALUE " + formatExpression(selectItems[0])
+	}
+
+	columns := make([]string, len(selectItems))
+	for i, selectItem := range selectItems {
+		columns[i] = formatExpression(selectItem)
+		if selectItem.Alias != "" {
+			columns[i] += " AS " + selectItem.Alias
+		}
+	}
+
+	return strings.Join(columns, ", ")
+}
+
+func formatTable(table parsers.Table) string {
+	if table.IsInSelect {
+		return table.Value + " IN " + formatExpression(table.SelectItem)
+	}
+
+	if table.SelectItem.Type == parsers.SelectItemTypeSubQuery {
+		return strings.TrimSpace(formatExpression(table.SelectItem) + " " + table.SelectItem.Alias)
+	}
+
+	if len(table.SelectItem.Path) == 0 {
+		return table.Value
+	}
+
+	result := formatExpression(table.SelectItem)
+	if table.SelectItem.Alias != "" {
+		result += " AS " + table.SelectItem.Alias
+	}
+
+	return result
+}
+
+func formatJoinItem(joinItem parsers.JoinItem) string {
+	if joinItem.SelectItem.Type == parsers.SelectItemTypeSubQuery {
+		return strings.TrimSpace(formatExpression(joinItem.SelectItem) + " " + joinItem.Table.Value)
+	}
+
+	return joinItem.Table.Value + " IN " + formatExpression(joinItem.SelectItem)
+}
+
+func formatOrderDirection(direction parsers.OrderDirection) string {
+	if direction == parsers.OrderDirectionDesc {
+		return "DESC"
+	}
+
+	return "ASC"
+}
+
+func formatExpression(expression interface{}) string {
+	switch typedValue := expression.(type) {
+	case parsers.SelectItem:
+		return formatSelectItem(typedValue)
+	case parsers.Constant:
+		return formatConstant(typedValue)
+	case parsers.FunctionCall:
+		return formatFunctionCall(typedValue, false)
+	case parsers.SelectStmt:
+		return formatSubQuery(typedValue)
+	case parsers.ComparisonExpression:
+		result := formatExpression(typedValue.Left) + " " + typedValue.Operation + " " + formatExpression(typedValue.Right)
+		if typedValue.Escape != "" {
+			result += " ESCAPE " + formatString(typedValue.Escape)
+		}
+		return "(" + result + ")"
+	case parsers.LogicalExpression:
+		operation := " OR "
+		if typedValue.Operation == parsers.LogicalExpressionTypeAnd {
+			operation = " AND "
+		}
+
+		expressions := make([]string, len(typedValue.Expressions))
+		for i, expression := range typedValue.Expressions {
+			expressions[i] = formatExpression(expression)
+		}
+		return "(" + strings.Join(expressions, operation) + ")"
+	case parsers.BetweenExpression:
+		operation := " BETWEEN "
+		if typedValue.Invert {
+			operation = " NOT BETWEEN "
+		}
+		return "(" + formatExpression(typedValue.Value) + operation +
+			formatExpression(typedValue.Low) + " AND " + formatExpression(typedValue.High) + ")"
+	case parsers.BinaryExpression:
+		return "(" + formatExpression(typedValue.Left) + " " + typedValue.Operation + " " + formatExpression(typedValue.Right) + ")"
+	case parsers.UnaryExpression:
+		return "(" + typedValue.Operation + formatExpression(typedValue.Value) + ")"
+	case orderByFilterPlaceholder:
+		return "({documentdb-formattableorderbyquery-filter})"
+	}
+
+	return "undefined"
+}
+
+func formatSelectItem(selectItem parsers.SelectItem) string {
+	var result string
+
+	switch selectItem.Type {
+	case parsers.SelectItemTypeObject:
+		fields := make([]string, len(selectItem.SelectItems))
+		for i, field := range selectItem.SelectItems {
+			fields[i] = formatString(field.Alias) + ": " + formatExpression(field)
+		}
+		result = "{" + strings.Join(fields, ", ") + "}"
+	case parsers.SelectItemTypeArray:
+		items := make([]string, len(selectItem.SelectItems))
+		for i, item := range selectItem.SelectItems {
+			items[i] = formatExpression(item)
+		}
+		result = "[" + strings.Join(items, ", ") + "]"
+	case parsers.SelectItemTypeFunctionCall:
+		if functionCall, ok := selectItem.Value.(parsers.FunctionCall); ok {
+			// NOT IN is part of the function call itself
+			return formatFunctionCall(functionCall, selectItem.Invert)
+		}
+		result = formatExpression(selectItem.Value)
+	case parsers.SelectItemTypeField:
+		result = formatPath(selectItem.Path)
+	default:
+		result = formatExpression(selectItem.Value)
+	}
+
+	if selectItem.Invert {
+		return "(NOT " + result + ")"
+	}
+
+	return result
+}
+
+func formatPath(path []string) string {
+	if len(path) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(path[0])
+	for _, segment := range path[1:] {
+		if _, err := strconv.Atoi(segment); err == nil || strings.HasPrefix(segment, "@") {
+			sb.WriteString("[" + segment + "]")
+		} else if identifierRegex.MatchString(segment) {
+			sb.WriteString("." + segment)
+		} else {
+			sb.WriteString("[" + formatString(segment) + "]")
+		}
+	}
+
+	return sb.String()
+}
+
+func formatConstant(constant parsers.Constant) string {
+	switch typedValue := constant.Value.(type) {
+	case nil:
+		return "null"
+	case string:
+		if constant.Type == parsers.ConstantTypeParameterConstant {
+			return typedValue
+		}
+		return formatString(typedValue)
+	case int:
+		return strconv.Itoa(typedValue)
+	case float64:
+		return strconv.FormatFloat(typedValue, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(typedValue)
+	}
+
+	return "undefined"
+}
+
+func formatString(value string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\b", `\b`,
+		"\f", `\f`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+	)
+
+	return `"` + replacer.Replace(value) + `"`
+}
+
+func formatSubQuery(query parsers.SelectStmt) string {
+	if query.Exists {
+		return "EXISTS(" + FormatQuery(query) + ")"
+	}
+
+	return "(" + FormatQuery(query) + ")"
+}
+
+func formatFunctionCall(functionCall parsers.FunctionCall, invert bool) string {
+	arguments := make([]string, 0, len(functionCall.Arguments))
+	for _, argument := range functionCall.Arguments {
+		if argument != nil {
+			arguments = append(arguments, formatExpression(argument))
+		}
+	}
+
+	switch functionCall.Type {
+	case parsers.FunctionCallIn:
+		operation := " IN "
+		if invert {
+			operation = " NOT IN "
+		}
+		return "(" + arguments[0] + operation + "(" + strings.Join(arguments[1:], ", ") + "))"
+	case parsers.FunctionCallUserDefined:
+		return wrapInvert("udf."+functionCall.UdfName+"("+strings.Join(arguments, ", ")+")", invert)
+	case parsers.FunctionCallIif:
+		// IIF only accepts plain select items, conditions built with the ternary operator are expressions
+		for _, argument := range functionCall.Arguments {
+			if selectItem, ok := argument.(parsers.SelectItem); ok && isExpressionSelectItem(selectItem) {
+				return wrapInvert("("+arguments[0]+" ? "+arguments[1]+" : "+arguments[2]+")", invert)
+			}
+		}
+	}
+
+	return wrapInvert(functionName(functionCall.Type)+"("+strings.Join(arguments, ", ")+")", invert)
+}
+
+func functionName(functionType parsers.FunctionCallType) string {
+	if name, ok := functionNames[functionType]; ok {
+		return name
+	}
+
+	name := strings.TrimPrefix(string(functionType), "Math")
+	name = strings.TrimPrefix(name, "Aggregate")
+
+	return strings.ToUpper(name)
+}
+
+func wrapInvert(expression string, invert bool) string {
+	if invert {
+		return "(NOT " + expression + ")"
+	}
+
+	return expression
+}
+
+func isExpressionSelectItem(selectItem parsers.SelectItem) bool {
+	switch selectItem.Type {
+	case parsers.SelectItemTypeExpression, parsers.SelectItemTypeBinaryExpression, parsers.SelectItemTypeUnaryExpression:
+		return true
+	}
+
+	return selectItem.Invert
+}
+
+// selectAsteriskPath is the path the parser selects for SELECT *, regardless of the alias of the collection
+const selectAsteriskPath = "c"
+
+// isSelectAsterisk checks if the query selects the documents of the collection, the path of SELECT *
+// is also a valid alias, so it only selects the documents when the alias is not bound to anything else
+func isSelectAsterisk(query parsers.SelectStmt) bool {
+	if len(query.SelectItems) != 1 {
+		return false
+	}
+
+	selectItem := query.SelectItems[0]
+	if selectItem.Type != parsers.SelectItemTypeField || len(selectItem.Path) != 1 ||
+		selectItem.Path[0] != selectAsteriskPath || selectItem.Alias != "" {
+		return false
+	}
+
+	if rootAlias(query) == selectAsteriskPath {
+		return !query.Table.IsInSelect && query.Table.SelectItem.Type != parsers.SelectItemTypeSubQuery
+	}
+
+	return !isJoinAlias(query, selectAsteriskPath)
+}
+
+func isJoinAlias(query parsers.SelectStmt, alias string) bool {
+	for _, joinItem := range query.JoinItems {
+		if joinItem.Table.Value == alias || joinItem.SelectItem.Alias == alias {
+			return true
+		}
+	}
+
+	return false
+}
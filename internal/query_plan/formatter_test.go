@@ -0,0 +1,59 @@
+package queryplan
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+	"github.com/pikami/cosmium/parsers/nosql"
+	"github.com/stretchr/testify/assert"
+)
+
+func parseQuery(t *testing.T, query string) parsers.SelectStmt {
+	parsedQuery, err := nosql.Parse("", []byte(query))
+	if err != nil {
+		t.Fatalf("Failed to parse query: %s\nerr: %v", query, err)
+	}
+
+	return parsedQuery.(parsers.SelectStmt)
+}
+
+func Test_FormatQuery(t *testing.T) {
+	queries := []string{
+		`SELECT * FROM c`,
+		`SELECT VALUE c.id FROM c`,
+		`SELECT DISTINCT TOP 5 c.id, c.pk AS partitionKey FROM c`,
+		`SELECT c.id FROM c OFFSET 10 LIMIT 5`,
+		`SELECT c.id, c["name"], c.tags[1], c[@param] FROM root c`,
+		`SELECT { "id": c.id, "$1": c.name, isAdult: c.age >= 18 } AS obj, [c.a, 1, 2.5, "text\n\"", true, null] AS arr FROM c`,
+		`SELECT [] AS empty, {} AS emptyObject FROM c`,
+		`SELECT VALUE c.a + c.b * -c.c FROM c WHERE ~c.flags & 4 = 4 OR c.a ?? c.b`,
+		`SELECT c.id FROM c WHERE c.id = "1" AND (c.pk != @pk OR NOT IS_DEFINED(c.pk)) AND c.age NOT BETWEEN 1 AND 10`,
+		`SELECT c.id FROM c WHERE c.name LIKE "a!%" ESCAPE "!" AND c.name NOT LIKE "b%"`,
+		`SELECT c.id FROM c WHERE c.id IN ("1", "2") AND c.pk NOT IN (1, 2) AND (UPPER(c.name) IN ("A"))`,
+		`SELECT VALUE c.active ? c.name : LOWER(c.name) FROM c`,
+		`SELECT VALUE IIF(c.active, c.name, "none") FROM c`,
+		`SELECT INDEX_OF(c.name, "a"), STRINGEQUALS(c.a, c.b, true), ARRAY_CONTAINS(c.tags, "a"), ST_ISVALIDDETAILED(c.loc), ABS(c.n), IntAdd(c.a, c.b), DateTimeAdd("dd", 1, c.date), udf.myFunc(c.a) FROM c`,
+		`SELECT c.pk, COUNT(1) AS cnt, AVG(c.age) FROM c GROUP BY c.pk`,
+		`SELECT t.name FROM c JOIN t IN c.tags WHERE EXISTS(SELECT VALUE x FROM x IN c.tags WHERE x.name = "a")`,
+		`SELECT c.id, (SELECT VALUE COUNT(1) FROM t IN c.tags) AS tagCount FROM c ORDER BY c.id DESC, c.pk ASC`,
+		`SELECT VALUE s FROM (SELECT VALUE c.id FROM c) s`,
+		`SELECT t FROM t IN c.tags`,
+		`SELECT VALUE c FROM root r JOIN c IN r.children`,
+		`SELECT VALUE c FROM c IN r.children`,
+	}
+
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			parsedQuery := parseQuery(t, query)
+			formattedQuery := FormatQuery(parsedQuery)
+
+			assert.Equal(t, parsedQuery, parseQuery(t, formattedQuery), formattedQuery)
+		})
+	}
+
+	t.Run("Should format query", func(t *testing.T) {
+		formattedQuery := FormatQuery(parseQuery(t, `select top 1 c.id from c where c.a=1 or c.b>2 order by c.id`))
+
+		assert.Equal(t, "SELECT TOP 1 c.id\nFROM c\nWHERE ((c.a = 1) OR (c.b > 2))\nORDER BY c.id ASC", formattedQuery)
+	})
+}
@@ -0,0 +1,372 @@
+package queryplan
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+
+	"github.com/pikami/cosmium/parsers"
+)
+
+type QueryPlan struct {
+	PartitionedQueryExecutionInfoVersion int          `json:"partitionedQueryExecutionInfoVersion"`
+	QueryInfo                            QueryInfo    `json:"queryInfo"`
+	QueryRanges                          []QueryRange `json:"queryRanges"`
+}
+
+type QueryInfo struct {
+	DistinctType                string             `json:"distinctType"`
+	Top                         *int               `json:"top"`
+	Offset                      *int               `json:"offset"`
+	Limit                       *int               `json:"limit"`
+	OrderBy                     []string           `json:"orderBy"`
+	OrderByExpressions          []string           `json:"orderByExpressions"`
+	GroupByExpressions          []string           `json:"groupByExpressions"`
+	GroupByAliases              []string           `json:"groupByAliases"`
+	Aggregates                  []string           `json:"aggregates"`
+	GroupByAliasToAggregateType map[string]*string `json:"groupByAliasToAggregateType"`
+	RewrittenQuery              string             `json:"rewrittenQuery"`
+	HasSelectValue              bool               `json:"hasSelectValue"`
+	DCountInfo                  interface{}        `json:"dCountInfo"`
+}
+
+type QueryRange struct {
+	Min            string `json:"min"`
+	Max            string `json:"max"`
+	IsMinInclusive bool   `json:"isMinInclusive"`
+	IsMaxInclusive bool   `json:"isMaxInclusive"`
+}
+
+const (
+	DistinctTypeNone      = "None"
+	DistinctTypeOrdered   = "Ordered"
+	DistinctTypeUnordered = "Unordered"
+)
+
+var aggregateTypes = map[parsers.FunctionCallType]string{
+	parsers.FunctionCallAggregateAvg:   "Average",
+	parsers.FunctionCallAggregateCount: "Count",
+	parsers.FunctionCallAggregateMax:   "Max",
+	parsers.FunctionCallAggregateMin:   "Min",
+	parsers.FunctionCallAggregateSum:   "Sum",
+}
+
+// FullRange covers all partition key ranges of a collection
+var FullRange = QueryRange{Min: "", Max: "FF", IsMinInclusive: true, IsMaxInclusive: false}
+
+// Build creates the query plan the SDKs use to run a query across partitions
+func Build(query parsers.SelectStmt) (QueryPlan, error) {
+	queryInfo := QueryInfo{
+		DistinctType:                DistinctTypeNone,
+		OrderBy:                     []string{},
+		OrderByExpressions:          []string{},
+		GroupByExpressions:          []string{},
+		GroupByAliases:              []string{},
+		Aggregates:                  []string{},
+		GroupByAliasToAggregateType: map[string]*string{},
+		HasSelectValue:              len(query.SelectItems) == 1 && query.SelectItems[0].IsTopLevel && !isSelectAsterisk(query),
+	}
+
+	if query.Distinct {
+		queryInfo.DistinctType = DistinctTypeUnordered
+		if len(query.OrderExpressions) > 0 {
+			queryInfo.DistinctType = DistinctTypeOrdered
+		}
+	}
+
+	if query.Offset > 0 {
+		offset, limit := query.Offset, query.Count
+		queryInfo.Offset = &offset
+		queryInfo.Limit = &limit
+	} else if query.Count > 0 {
+		top := query.Count
+		queryInfo.Top = &top
+	}
+
+	for _, orderExpression := range query.OrderExpressions {
+		direction := "Ascending"
+		if orderExpression.Direction == parsers.OrderDirectionDesc {
+			direction = "Descending"
+		}
+		queryInfo.OrderBy = append(queryInfo.OrderBy, direction)
+		queryInfo.OrderByExpressions = append(queryInfo.OrderByExpressions, formatExpression(orderExpression.SelectItem))
+	}
+
+	for _, selectItem := range query.GroupBy {
+		queryInfo.GroupByExpressions = append(queryInfo.GroupByExpressions, formatExpression(selectItem))
+	}
+
+	hasAggregates := false
+	for _, selectItem := range query.SelectItems {
+		if _, isAggregate := aggregateFunction(selectItem); isAggregate {
+			hasAggregates = true
+		} else if containsAggregate(selectItem) {
+			return QueryPlan{}, errors.New("Compositions of aggregates and other expressions are not allowed.")
+		}
+	}
+
+	switch {
+	case len(query.GroupBy) > 0 || (hasAggregates && !queryInfo.HasSelectValue):
+		queryInfo.RewrittenQuery = FormatQuery(rewriteGroupByQuery(query, &queryInfo))
+	case hasAggregates:
+		queryInfo.RewrittenQuery = FormatQuery(rewriteAggregateQuery(query, &queryInfo))
+	case len(query.OrderExpressions) > 0:
+		queryInfo.RewrittenQuery = FormatQuery(rewriteOrderByQuery(query))
+	case query.Offset > 0:
+		queryInfo.RewrittenQuery = FormatQuery(rewriteOffsetLimit(query))
+	}
+
+	return QueryPlan{
+		PartitionedQueryExecutionInfoVersion: 2,
+		QueryInfo:                            queryInfo,
+		QueryRanges:                          []QueryRange{FullRange},
+	}, nil
+}
+
+// rewriteOrderByQuery wraps the ORDER BY values and the original projection, so that
+// the SDKs can merge the sorted results of each partition
+func rewriteOrderByQuery(query parsers.SelectStmt) parsers.SelectStmt {
+	orderByItems := make([]parsers.SelectItem, len(query.OrderExpressions))
+	for i, orderExpression := range query.OrderExpressions {
+		orderByItems[i] = itemObject(orderExpression.SelectItem)
+	}
+
+	rewritten := query
+	rewritten.Distinct = false
+	rewritten.SelectItems = []parsers.SelectItem{
+		{Path: []string{rootAlias(query), "_rid"}},
+		{Type: parsers.SelectItemTypeArray, SelectItems: orderByItems, Alias: "orderByItems"},
+		withAlias(payload(query), "payload"),
+	}
+
+	if query.Filters != nil {
+		rewritten.Filters = parsers.LogicalExpression{
+			Operation:   parsers.LogicalExpressionTypeAnd,
+			Expressions: []interface{}{query.Filters, orderByFilterPlaceholder{}},
+		}
+	} else {
+		rewritten.Filters = orderByFilterPlaceholder{}
+	}
+
+	if query.Offset > 0 {
+		rewritten.Offset = 0
+		rewritten.Count = query.Offset + query.Count
+	}
+
+	return rewritten
+}
+
+// rewriteAggregateQuery wraps the aggregate of a SELECT VALUE query, so that
+// the SDKs can combine the partial aggregates of each partition
+func rewriteAggregateQuery(query parsers.SelectStmt, queryInfo *QueryInfo) parsers.SelectStmt {
+	functionCall, _ := aggregateFunction(query.SelectItems[0])
+	queryInfo.Aggregates = append(queryInfo.Aggregates, aggregateTypes[functionCall.Type])
+
+	rewritten := query
+	rewritten.Count = 0
+	rewritten.Offset = 0
+	rewritten.OrderExpressions = nil
+	rewritten.SelectItems = []parsers.SelectItem{
+		{
+			Type:        parsers.SelectItemTypeArray,
+			SelectItems: []parsers.SelectItem{itemObject(partialAggregate(functionCall))},
+			IsTopLevel:  true,
+		},
+	}
+
+	return rewritten
+}
+
+// rewriteGroupByQuery projects the grouping values and the partial aggregates of each group, so that
+// the SDKs can merge the groups of each partition
+func rewriteGroupByQuery(query parsers.SelectStmt, queryInfo *QueryInfo) parsers.SelectStmt {
+	groupByItems := make([]parsers.SelectItem, len(query.GroupBy))
+	for i, selectItem := range query.GroupBy {
+		groupByItems[i] = itemObject(selectItem)
+	}
+
+	var payloadItem parsers.SelectItem
+	if queryInfo.HasSelectValue {
+		payloadItem = query.SelectItems[0]
+		if functionCall, isAggregate := aggregateFunction(payloadItem); isAggregate {
+			queryInfo.Aggregates = append(queryInfo.Aggregates, aggregateTypes[functionCall.Type])
+			payloadItem = itemObject(partialAggregate(functionCall))
+		}
+		payloadItem.IsTopLevel = false
+	} else {
+		fields := make([]parsers.SelectItem, len(query.SelectItems))
+		for i, selectItem := range query.SelectItems {
+			alias := selectItemAlias(selectItem, i)
+			field := selectItem
+
+			var aggregateType *string
+			if functionCall, isAggregate := aggregateFunction(selectItem); isAggregate {
+				typeName := aggregateTypes[functionCall.Type]
+				aggregateType = &typeName
+				field = itemObject(partialAggregate(functionCall))
+			}
+
+			queryInfo.GroupByAliases = append(queryInfo.GroupByAliases, alias)
+			queryInfo.GroupByAliasToAggregateType[alias] = aggregateType
+			fields[i] = withAlias(field, alias)
+		}
+		payloadItem = parsers.SelectItem{Type: parsers.SelectItemTypeObject, SelectItems: fields}
+	}
+
+	rewritten := query
+	rewritten.Distinct = false
+	rewritten.Count = 0
+	rewritten.Offset = 0
+	rewritten.OrderExpressions = nil
+	rewritten.SelectItems = []parsers.SelectItem{
+		{Type: parsers.SelectItemTypeArray, SelectItems: groupByItems, Alias: "groupByItems"},
+		withAlias(payloadItem, "payload"),
+	}
+
+	return rewritten
+}
+
+// rewriteOffsetLimit makes each partition return enough items for the SDKs to apply the offset
+func rewriteOffsetLimit(query parsers.SelectStmt) parsers.SelectStmt {
+	rewritten := query
+	rewritten.Offset = 0
+	rewritten.Count = query.Offset + query.Count
+
+	return rewritten
+}
+
+// payload returns the original projection of the query as a single select item
+func payload(query parsers.SelectStmt) parsers.SelectItem {
+	if len(query.SelectItems) == 1 && query.SelectItems[0].IsTopLevel {
+		if isSelectAsterisk(query) {
+			return parsers.SelectItem{Path: []string{rootAlias(query)}}
+		}
+
+		selectItem := query.SelectItems[0]
+		selectItem.IsTopLevel = false
+		return selectItem
+	}
+
+	fields := make([]parsers.SelectItem, len(query.SelectItems))
+	for i, selectItem := range query.SelectItems {
+		fields[i] = withAlias(selectItem, selectItemAlias(selectItem, i))
+	}
+
+	return parsers.SelectItem{Type: parsers.SelectItemTypeObject, SelectItems: fields}
+}
+
+// partialAggregate returns the aggregate each partition has to compute, averages are
+// merged from the sum and count of every partition
+func partialAggregate(functionCall parsers.FunctionCall) parsers.SelectItem {
+	if functionCall.Type != parsers.FunctionCallAggregateAvg {
+		return parsers.SelectItem{Type: parsers.SelectItemTypeFunctionCall, Value: functionCall}
+	}
+
+	return parsers.SelectItem{
+		Type: parsers.SelectItemTypeObject,
+		SelectItems: []parsers.SelectItem{
+			{
+				Alias: "sum",
+				Type:  parsers.SelectItemTypeFunctionCall,
+				Value: parsers.FunctionCall{Type: parsers.FunctionCallAggregateSum, Arguments: functionCall.Arguments},
+			},
+			{
+				Alias: "count",
+				Type:  parsers.SelectItemTypeFunctionCall,
+				Value: parsers.FunctionCall{Type: parsers.FunctionCallAggregateCount, Arguments: functionCall.Arguments},
+			},
+		},
+	}
+}
+
+func itemObject(selectItem parsers.SelectItem) parsers.SelectItem {
+	return parsers.SelectItem{
+		Type:        parsers.SelectItemTypeObject,
+		SelectItems: []parsers.SelectItem{withAlias(selectItem, "item")},
+	}
+}
+
+func withAlias(selectItem parsers.SelectItem, alias string) parsers.SelectItem {
+	selectItem.Alias = alias
+	selectItem.IsTopLevel = false
+	return selectItem
+}
+
+// selectItemAlias returns the property name the select item gets in the query results
+func selectItemAlias(selectItem parsers.SelectItem, index int) string {
+	if selectItem.Alias != "" {
+		return selectItem.Alias
+	}
+
+	if selectItem.Type == parsers.SelectItemTypeField && len(selectItem.Path) > 1 {
+		return selectItem.Path[len(selectItem.Path)-1]
+	}
+
+	return "$" + strconv.Itoa(index+1)
+}
+
+func rootAlias(query parsers.SelectStmt) string {
+	if query.Table.IsInSelect || query.Table.SelectItem.Type == parsers.SelectItemTypeSubQuery {
+		return query.Table.Value
+	}
+
+	if query.Table.SelectItem.Alias != "" {
+		return query.Table.SelectItem.Alias
+	}
+
+	if len(query.Table.SelectItem.Path) > 0 {
+		return query.Table.SelectItem.Path[0]
+	}
+
+	return query.Table.Value
+}
+
+func aggregateFunction(selectItem parsers.SelectItem) (parsers.FunctionCall, bool) {
+	if selectItem.Type != parsers.SelectItemTypeFunctionCall {
+		return parsers.FunctionCall{}, false
+	}
+
+	functionCall, ok := selectItem.Value.(parsers.FunctionCall)
+	if !ok || !slices.Contains(parsers.AggregateFunctions, functionCall.Type) {
+		return parsers.FunctionCall{}, false
+	}
+
+	return functionCall, true
+}
+
+// containsAggregate checks for aggregates nested in other expressions, subqueries are not inspected
+// as their aggregates are computed separately
+func containsAggregate(expression interface{}) bool {
+	switch typedValue := expression.(type) {
+	case parsers.SelectItem:
+		if _, isAggregate := aggregateFunction(typedValue); isAggregate {
+			return true
+		}
+
+		if typedValue.Type == parsers.SelectItemTypeSubQuery {
+			return false
+		}
+
+		for _, selectItem := range typedValue.SelectItems {
+			if containsAggregate(selectItem) {
+				return true
+			}
+		}
+
+		return containsAggregate(typedValue.Value)
+	case parsers.FunctionCall:
+		return slices.ContainsFunc(typedValue.Arguments, containsAggregate)
+	case parsers.ComparisonExpression:
+		return containsAggregate(typedValue.Left) || containsAggregate(typedValue.Right)
+	case parsers.LogicalExpression:
+		return slices.ContainsFunc(typedValue.Expressions, containsAggregate)
+	case parsers.BetweenExpression:
+		return containsAggregate(typedValue.Value) || containsAggregate(typedValue.Low) || containsAggregate(typedValue.High)
+	case parsers.BinaryExpression:
+		return containsAggregate(typedValue.Left) || containsAggregate(typedValue.Right)
+	case parsers.UnaryExpression:
+		return containsAggregate(typedValue.Value)
+	}
+
+	return false
+}
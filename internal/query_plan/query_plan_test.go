@@ -0,0 +1,129 @@
+package queryplan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Build(t *testing.T) {
+	t.Run("Should build plan for simple query", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT * FROM c WHERE c.id = "1"`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, 2, plan.PartitionedQueryExecutionInfoVersion)
+		assert.Equal(t, []QueryRange{FullRange}, plan.QueryRanges)
+		assert.Equal(t, DistinctTypeNone, plan.QueryInfo.DistinctType)
+		assert.Equal(t, "", plan.QueryInfo.RewrittenQuery)
+		assert.False(t, plan.QueryInfo.HasSelectValue)
+		assert.Nil(t, plan.QueryInfo.Top)
+	})
+
+	t.Run("Should build plan for SELECT VALUE of a joined alias named c", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT VALUE c FROM root r JOIN c IN r.children ORDER BY r.id`))
+		assert.Nil(t, err)
+
+		assert.True(t, plan.QueryInfo.HasSelectValue)
+		assert.Equal(t,
+			"SELECT r._rid, [{\"item\": r.id}] AS orderByItems, c AS payload\n"+
+				"FROM root AS r\n"+
+				"JOIN c IN r.children\n"+
+				"WHERE ({documentdb-formattableorderbyquery-filter})\n"+
+				"ORDER BY r.id ASC",
+			plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for TOP and DISTINCT", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT DISTINCT TOP 5 VALUE c.pk FROM c`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, DistinctTypeUnordered, plan.QueryInfo.DistinctType)
+		assert.Equal(t, 5, *plan.QueryInfo.Top)
+		assert.True(t, plan.QueryInfo.HasSelectValue)
+		assert.Equal(t, "", plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for ORDER BY", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT DISTINCT c.id, c.name AS n FROM c WHERE c.pk = "a" ORDER BY c.name DESC, c.id`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, DistinctTypeOrdered, plan.QueryInfo.DistinctType)
+		assert.Equal(t, []string{"Descending", "Ascending"}, plan.QueryInfo.OrderBy)
+		assert.Equal(t, []string{"c.name", "c.id"}, plan.QueryInfo.OrderByExpressions)
+		assert.Equal(t,
+			"SELECT c._rid, [{\"item\": c.name}, {\"item\": c.id}] AS orderByItems, {\"id\": c.id, \"n\": c.name} AS payload\n"+
+				"FROM c\n"+
+				"WHERE ((c.pk = \"a\") AND ({documentdb-formattableorderbyquery-filter}))\n"+
+				"ORDER BY c.name DESC, c.id ASC",
+			plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for ORDER BY with SELECT * and OFFSET LIMIT", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT * FROM root r ORDER BY r.id OFFSET 5 LIMIT 10`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, 5, *plan.QueryInfo.Offset)
+		assert.Equal(t, 10, *plan.QueryInfo.Limit)
+		assert.Nil(t, plan.QueryInfo.Top)
+		assert.Equal(t,
+			"SELECT TOP 15 r._rid, [{\"item\": r.id}] AS orderByItems, r AS payload\n"+
+				"FROM root AS r\n"+
+				"WHERE ({documentdb-formattableorderbyquery-filter})\n"+
+				"ORDER BY r.id ASC",
+			plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for OFFSET LIMIT", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT VALUE c.id FROM c OFFSET 5 LIMIT 10`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, "SELECT TOP 15 VALUE c.id\nFROM c", plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for SELECT VALUE aggregate", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT VALUE COUNT(1) FROM c WHERE c.pk = "a"`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"Count"}, plan.QueryInfo.Aggregates)
+		assert.True(t, plan.QueryInfo.HasSelectValue)
+		assert.Equal(t, "SELECT VALUE [{\"item\": COUNT(1)}]\nFROM c\nWHERE (c.pk = \"a\")", plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for SELECT VALUE AVG", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT VALUE AVG(c.age) FROM c`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"Average"}, plan.QueryInfo.Aggregates)
+		assert.Equal(t, "SELECT VALUE [{\"item\": {\"sum\": SUM(c.age), \"count\": COUNT(c.age)}}]\nFROM c", plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for GROUP BY", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT c.pk, COUNT(1) AS total, MAX(c.age) FROM c GROUP BY c.pk`))
+		assert.Nil(t, err)
+
+		count, max := "Count", "Max"
+		assert.Equal(t, []string{"c.pk"}, plan.QueryInfo.GroupByExpressions)
+		assert.Equal(t, []string{"pk", "total", "$3"}, plan.QueryInfo.GroupByAliases)
+		assert.Equal(t, map[string]*string{"pk": nil, "total": &count, "$3": &max}, plan.QueryInfo.GroupByAliasToAggregateType)
+		assert.Equal(t,
+			"SELECT [{\"item\": c.pk}] AS groupByItems, {\"pk\": c.pk, \"total\": {\"item\": COUNT(1)}, \"$3\": {\"item\": MAX(c.age)}} AS payload\n"+
+				"FROM c\n"+
+				"GROUP BY c.pk",
+			plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should build plan for aggregates without GROUP BY", func(t *testing.T) {
+		plan, err := Build(parseQuery(t, `SELECT COUNT(1) AS total FROM c`))
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"total"}, plan.QueryInfo.GroupByAliases)
+		assert.Equal(t,
+			"SELECT [] AS groupByItems, {\"total\": {\"item\": COUNT(1)}} AS payload\nFROM c",
+			plan.QueryInfo.RewrittenQuery)
+	})
+
+	t.Run("Should reject aggregates composed with other expressions", func(t *testing.T) {
+		_, err := Build(parseQuery(t, `SELECT VALUE COUNT(1) + 1 FROM c`))
+		assert.NotNil(t, err)
+	})
+}
@@ -75,6 +75,22 @@ func NewCombined(ids ...string) string {
 	return strings.ReplaceAll(encoded, "/", "-")
 }
 
+// DocumentNumericId returns the numeric document id that is encoded
+// in the last 8 bytes of a document resource id
+func DocumentNumericId(rid string) (uint64, bool) {
+	idBytes, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(rid, "-", "/"))
+	if err != nil || len(idBytes) != 16 {
+		return 0, false
+	}
+
+	var documentId uint64
+	for i, b := range idBytes[8:] {
+		documentId |= uint64(b) << (i * 8)
+	}
+
+	return documentId, true
+}
+
 func uintToBytes(id uint32) []byte {
 	buf := make([]byte, 4)
 	for i := 0; i < 4; i++ {
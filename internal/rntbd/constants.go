@@ -186,6 +186,15 @@ const (
 	RntbdRequestHeaderSDKSupportedCapabilities RntbdRequestHeader = 0x00A2 // RntbdTokenType.ULong, required = ?
 )
 
+// rntbdConsistencyLevels maps the values of the consistency level request header to their HTTP names
+var rntbdConsistencyLevels = map[byte]string{
+	0x00: "Strong",
+	0x01: "BoundedStaleness",
+	0x02: "Session",
+	0x03: "Eventual",
+	0x04: "ConsistentPrefix",
+}
+
 type RntbdResponseHeaderType uint16
 
 const (
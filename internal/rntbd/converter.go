@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/pikami/cosmium/api/headers"
+	"github.com/pikami/cosmium/internal/logger"
 )
 
 func (f *RntbdFrame) ToHttpRequest() *http.Request {
@@ -27,9 +28,29 @@ func (f *RntbdFrame) ToHttpRequest() *http.Request {
 		req.Header.Set(headers.IsUpsert, "true")
 	}
 
-	if ifMatch, ok := f.RequestHeaders[RntbdRequestHeaderMatch]; ok {
-		if ifMatchString, ok := ifMatch.(string); ok {
-			req.Header.Set(headers.IfMatch, ifMatchString)
+	// The match header is sent as If-None-Match on reads and as If-Match on writes
+	if match, ok := f.RequestHeaders[RntbdRequestHeaderMatch]; ok {
+		if matchString, ok := match.(string); ok {
+			switch f.OperationType {
+			case RntbdOperationTypeRead, RntbdOperationTypeReadFeed:
+				req.Header.Set(headers.IfNoneMatch, matchString)
+			default:
+				req.Header.Set(headers.IfMatch, matchString)
+			}
+		}
+	}
+
+	if sessionToken, ok := f.RequestHeaders[RntbdRequestHeaderSessionToken]; ok {
+		if sessionTokenString, ok := sessionToken.(string); ok {
+			req.Header.Set(headers.SessionToken, sessionTokenString)
+		}
+	}
+
+	if consistencyLevel, ok := f.RequestHeaders[RntbdRequestHeaderConsistencyLevel]; ok {
+		if consistencyLevelBytes, ok := consistencyLevel.([]byte); ok && len(consistencyLevelBytes) == 1 {
+			if consistencyLevelString, ok := rntbdConsistencyLevels[consistencyLevelBytes[0]]; ok {
+				req.Header.Set(headers.ConsistencyLevel, consistencyLevelString)
+			}
 		}
 	}
 
@@ -39,6 +60,18 @@ func (f *RntbdFrame) ToHttpRequest() *http.Request {
 		}
 	}
 
+	if partitionKey, ok := f.RequestHeaders[RntbdRequestHeaderPartitionKey]; ok {
+		if partitionKeyString, ok := partitionKey.(string); ok {
+			req.Header.Set(headers.PartitionKey, partitionKeyString)
+		}
+	}
+
+	if partitionKeyRangeId, ok := f.RequestHeaders[RntbdRequestHeaderPartitionKeyRangeId]; ok {
+		if partitionKeyRangeIdString, ok := partitionKeyRangeId.(string); ok {
+			req.Header.Set(headers.PartitionKeyRangeId, partitionKeyRangeIdString)
+		}
+	}
+
 	if maxItemCount, ok := f.RequestHeaders[RntbdRequestHeaderPageSize]; ok {
 		if maxItemCountString, ok := maxItemCount.(uint64); ok {
 			req.Header.Set(headers.MaxItemCount, fmt.Sprintf("%d", maxItemCountString))
@@ -69,6 +102,41 @@ func ToRntbdResponseFrame(responseWriter *httptest.ResponseRecorder) *RntbdRespo
 		builder.AddHeader(uint16(RntbdResponseHeaderItemCount), RntbdTokenTypeULong, uint32(itemCount))
 	}
 
+	// Direct mode clients expect LSNs on every response, resources outside of collections don't have one
+	var lsn int64
+	var err error
+	if lsnValue := responseWriter.Header().Get(headers.LSN); lsnValue != "" {
+		if lsn, err = strconv.ParseInt(lsnValue, 10, 64); err != nil {
+			logger.ErrorLn("Failed to parse LSN header:", err)
+		}
+	}
+
+	if err == nil {
+		lsnHeaders := []RntbdResponseHeaderType{
+			RntbdResponseHeaderLSN,
+			RntbdResponseHeaderItemLSN,
+			RntbdResponseHeaderLocalLSN,
+			RntbdResponseHeaderItemLocalLSN,
+			RntbdResponseHeaderQuorumAckedLSN,
+			RntbdResponseHeaderQuorumAckedLocalLSN,
+			RntbdResponseHeaderGlobalCommittedLSN,
+		}
+		for _, lsnHeader := range lsnHeaders {
+			builder.AddHeader(uint16(lsnHeader), RntbdTokenTypeLongLong, lsn)
+		}
+	}
+
+	if responseWriter.Header().Get(headers.SessionToken) != "" {
+		builder.AddHeader(uint16(RntbdResponseHeaderSessionToken), RntbdTokenTypeString, responseWriter.Header().Get(headers.SessionToken))
+	}
+
+	if responseWriter.Header().Get(headers.SubStatus) != "" {
+		subStatus, err := strconv.ParseUint(responseWriter.Header().Get(headers.SubStatus), 10, 32)
+		if err == nil {
+			builder.AddHeader(uint16(RntbdResponseHeaderSubStatus), RntbdTokenTypeULong, uint32(subStatus))
+		}
+	}
+
 	if responseWriter.Body.Len() > 0 {
 		builder.AddHeader(uint16(RntbdResponseHeaderPayloadPresent), RntbdTokenTypeByte, []byte{1})
 		builder.AddPayload(responseWriter.Body.Bytes())
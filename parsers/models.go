@@ -1,17 +1,19 @@
 package parsers
 
 type SelectStmt struct {
-	SelectItems      []SelectItem
-	Table            Table
-	JoinItems        []JoinItem
-	Filters          interface{}
-	Exists           bool
-	Distinct         bool
-	Count            int
-	Offset           int
-	Parameters       map[string]interface{}
-	OrderExpressions []OrderExpression
-	GroupBy          []SelectItem
+	SelectItems        []SelectItem
+	Table              Table
+	JoinItems          []JoinItem
+	Filters            interface{}
+	Exists             bool
+	Distinct           bool
+	Count              int
+	Offset             int
+	Parameters         map[string]interface{}
+	Udfs               map[string]string
+	ComputedProperties map[string]SelectStmt
+	OrderExpressions   []OrderExpression
+	GroupBy            []SelectItem
 }
 
 type Table struct {
@@ -36,6 +38,7 @@ const (
 	SelectItemTypeSubQuery
 	SelectItemTypeExpression
 	SelectItemTypeBinaryExpression
+	SelectItemTypeUnaryExpression
 )
 
 type SelectItem struct {
@@ -64,6 +67,14 @@ type ComparisonExpression struct {
 	Left      interface{}
 	Right     interface{}
 	Operation string
+	Escape    string
+}
+
+type BetweenExpression struct {
+	Value  interface{}
+	Low    interface{}
+	High   interface{}
+	Invert bool
 }
 
 type BinaryExpression struct {
@@ -72,6 +83,11 @@ type BinaryExpression struct {
 	Operation string
 }
 
+type UnaryExpression struct {
+	Value     interface{}
+	Operation string
+}
+
 type ConstantType int
 
 const (
@@ -123,6 +139,12 @@ const (
 	FunctionCallSubstring    FunctionCallType = "Substring"
 	FunctionCallTrim         FunctionCallType = "Trim"
 
+	FunctionCallStringToArray   FunctionCallType = "StringToArray"
+	FunctionCallStringToBoolean FunctionCallType = "StringToBoolean"
+	FunctionCallStringToNull    FunctionCallType = "StringToNull"
+	FunctionCallStringToNumber  FunctionCallType = "StringToNumber"
+	FunctionCallStringToObject  FunctionCallType = "StringToObject"
+
 	FunctionCallIsDefined      FunctionCallType = "IsDefined"
 	FunctionCallIsArray        FunctionCallType = "IsArray"
 	FunctionCallIsBool         FunctionCallType = "IsBool"
@@ -140,11 +162,40 @@ const (
 	FunctionCallArrayContainsAll FunctionCallType = "ArrayContainsAll"
 	FunctionCallArrayLength      FunctionCallType = "ArrayLength"
 	FunctionCallArraySlice       FunctionCallType = "ArraySlice"
+	FunctionCallChoose           FunctionCallType = "Choose"
+	FunctionCallObjectToArray    FunctionCallType = "ObjectToArray"
 	FunctionCallSetIntersect     FunctionCallType = "SetIntersect"
 	FunctionCallSetUnion         FunctionCallType = "SetUnion"
 
 	FunctionCallIif FunctionCallType = "Iif"
 
+	FunctionCallDocumentId FunctionCallType = "DocumentId"
+
+	FunctionCallUserDefined FunctionCallType = "UserDefined"
+
+	FunctionCallDateTimeAdd               FunctionCallType = "DateTimeAdd"
+	FunctionCallDateTimeBin               FunctionCallType = "DateTimeBin"
+	FunctionCallDateTimeDiff              FunctionCallType = "DateTimeDiff"
+	FunctionCallDateTimeFromParts         FunctionCallType = "DateTimeFromParts"
+	FunctionCallDateTimePart              FunctionCallType = "DateTimePart"
+	FunctionCallDateTimeToTicks           FunctionCallType = "DateTimeToTicks"
+	FunctionCallDateTimeToTimestamp       FunctionCallType = "DateTimeToTimestamp"
+	FunctionCallGetCurrentDateTime        FunctionCallType = "GetCurrentDateTime"
+	FunctionCallGetCurrentDateTimeStatic  FunctionCallType = "GetCurrentDateTimeStatic"
+	FunctionCallGetCurrentTicks           FunctionCallType = "GetCurrentTicks"
+	FunctionCallGetCurrentTicksStatic     FunctionCallType = "GetCurrentTicksStatic"
+	FunctionCallGetCurrentTimestamp       FunctionCallType = "GetCurrentTimestamp"
+	FunctionCallGetCurrentTimestampStatic FunctionCallType = "GetCurrentTimestampStatic"
+	FunctionCallTicksToDateTime           FunctionCallType = "TicksToDateTime"
+	FunctionCallTimestampToDateTime       FunctionCallType = "TimestampToDateTime"
+
+	FunctionCallStArea            FunctionCallType = "StArea"
+	FunctionCallStDistance        FunctionCallType = "StDistance"
+	FunctionCallStWithin          FunctionCallType = "StWithin"
+	FunctionCallStIntersects      FunctionCallType = "StIntersects"
+	FunctionCallStIsValid         FunctionCallType = "StIsValid"
+	FunctionCallStIsValidDetailed FunctionCallType = "StIsValidDetailed"
+
 	FunctionCallMathAbs              FunctionCallType = "MathAbs"
 	FunctionCallMathAcos             FunctionCallType = "MathAcos"
 	FunctionCallMathAsin             FunctionCallType = "MathAsin"
@@ -202,4 +253,5 @@ var AggregateFunctions = []FunctionCallType{
 type FunctionCall struct {
 	Arguments []interface{}
 	Type      FunctionCallType
+	UdfName   string
 }
@@ -363,4 +363,176 @@ func Test_Parse_Arithmetics(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should parse coalesce and ternary operators", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.nickname ?? c.name AS displayName, c.qty > 0 ? "in" : "out" AS stock FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias: "displayName",
+						Type:  parsers.SelectItemTypeBinaryExpression,
+						Value: parsers.BinaryExpression{
+							Operation: "??",
+							Left:      testutils.SelectItem_Path("c", "nickname"),
+							Right:     testutils.SelectItem_Path("c", "name"),
+						},
+					},
+					{
+						Alias: "stock",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallIif,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Type: parsers.SelectItemTypeExpression,
+									Value: parsers.ComparisonExpression{
+										Operation: ">",
+										Left:      testutils.SelectItem_Path("c", "qty"),
+										Right:     testutils.SelectItem_Constant_Int(0),
+									},
+								},
+								testutils.SelectItem_Constant_String("in"),
+								testutils.SelectItem_Constant_String("out"),
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse ternary operator with lower precedence than coalesce", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT VALUE c.a ?? c.b ? 1 : c.c ? 2 : 3 FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						IsTopLevel: true,
+						Type:       parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallIif,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Type: parsers.SelectItemTypeBinaryExpression,
+									Value: parsers.BinaryExpression{
+										Operation: "??",
+										Left:      testutils.SelectItem_Path("c", "a"),
+										Right:     testutils.SelectItem_Path("c", "b"),
+									},
+								},
+								testutils.SelectItem_Constant_Int(1),
+								parsers.SelectItem{
+									Type: parsers.SelectItemTypeFunctionCall,
+									Value: parsers.FunctionCall{
+										Type: parsers.FunctionCallIif,
+										Arguments: []interface{}{
+											testutils.SelectItem_Path("c", "c"),
+											testutils.SelectItem_Constant_Int(2),
+											testutils.SelectItem_Constant_Int(3),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse bitwise operators with correct precedence", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.a | c.b & 1 << 2 AS flags, ~c.a AS complement, -c.b % 3 AS remainder FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias: "flags",
+						Type:  parsers.SelectItemTypeBinaryExpression,
+						Value: parsers.BinaryExpression{
+							Operation: "|",
+							Left:      testutils.SelectItem_Path("c", "a"),
+							Right: parsers.SelectItem{
+								Type: parsers.SelectItemTypeBinaryExpression,
+								Value: parsers.BinaryExpression{
+									Operation: "&",
+									Left:      testutils.SelectItem_Path("c", "b"),
+									Right: parsers.SelectItem{
+										Type: parsers.SelectItemTypeBinaryExpression,
+										Value: parsers.BinaryExpression{
+											Operation: "<<",
+											Left:      testutils.SelectItem_Constant_Int(1),
+											Right:     testutils.SelectItem_Constant_Int(2),
+										},
+									},
+								},
+							},
+						},
+					},
+					{
+						Alias: "complement",
+						Type:  parsers.SelectItemTypeUnaryExpression,
+						Value: parsers.UnaryExpression{
+							Operation: "~",
+							Value:     testutils.SelectItem_Path("c", "a"),
+						},
+					},
+					{
+						Alias: "remainder",
+						Type:  parsers.SelectItemTypeBinaryExpression,
+						Value: parsers.BinaryExpression{
+							Operation: "%",
+							Left: parsers.SelectItem{
+								Type: parsers.SelectItemTypeUnaryExpression,
+								Value: parsers.UnaryExpression{
+									Operation: "-",
+									Value:     testutils.SelectItem_Path("c", "b"),
+								},
+							},
+							Right: testutils.SelectItem_Constant_Int(3),
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse string concatenation and negative literals", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.first || " " || c.last AS name FROM c WHERE c.balance > -1.5`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias: "name",
+						Type:  parsers.SelectItemTypeBinaryExpression,
+						Value: parsers.BinaryExpression{
+							Operation: "||",
+							Left: parsers.SelectItem{
+								Type: parsers.SelectItemTypeBinaryExpression,
+								Value: parsers.BinaryExpression{
+									Operation: "||",
+									Left:      testutils.SelectItem_Path("c", "first"),
+									Right:     testutils.SelectItem_Constant_String(" "),
+								},
+							},
+							Right: testutils.SelectItem_Path("c", "last"),
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.ComparisonExpression{
+					Operation: ">",
+					Left:      testutils.SelectItem_Path("c", "balance"),
+					Right:     testutils.SelectItem_Constant_Float(-1.5),
+				},
+			},
+		)
+	})
 }
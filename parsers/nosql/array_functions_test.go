@@ -255,4 +255,44 @@ func Test_Parse_ArrayFunctions(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should parse function CHOOSE()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT CHOOSE(c.index, "a", c.b) FROM c`,
+			parsers.FunctionCallChoose,
+			[]interface{}{
+				testutils.SelectItem_Path("c", "index"),
+				testutils.SelectItem_Constant_String("a"),
+				testutils.SelectItem_Path("c", "b"),
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function ObjectToArray()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ObjectToArray(c.obj) FROM c`,
+			parsers.FunctionCallObjectToArray,
+			[]interface{}{
+				testutils.SelectItem_Path("c", "obj"),
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function ObjectToArray() with key and value names", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ObjectToArray(c.obj, "name", "value") FROM c`,
+			parsers.FunctionCallObjectToArray,
+			[]interface{}{
+				testutils.SelectItem_Path("c", "obj"),
+				testutils.SelectItem_Constant_String("name"),
+				testutils.SelectItem_Constant_String("value"),
+			},
+			"c",
+		)
+	})
 }
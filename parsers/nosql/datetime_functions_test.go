@@ -0,0 +1,236 @@
+package nosql_test
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+	testutils "github.com/pikami/cosmium/test_utils"
+)
+
+func Test_Parse_DateTimeFunctions(t *testing.T) {
+
+	t.Run("Should parse function DateTimeAdd(part, number, dateTime)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimeAdd("mm", 1, c.date) FROM c`,
+			parsers.FunctionCallDateTimeAdd,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("mm"),
+				testutils.SelectItem_Constant_Int(1),
+				parsers.SelectItem{
+					Path: []string{"c", "date"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function DateTimeBin(dateTime, part)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimeBin(c.date, "hh") FROM c`,
+			parsers.FunctionCallDateTimeBin,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "date"},
+					Type: parsers.SelectItemTypeField,
+				},
+				testutils.SelectItem_Constant_String("hh"),
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function DateTimeBin(dateTime, part, binSize, origin)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimeBin(c.date, "hh", 2, "2021-01-01T00:00:00Z") FROM c`,
+			parsers.FunctionCallDateTimeBin,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "date"},
+					Type: parsers.SelectItemTypeField,
+				},
+				testutils.SelectItem_Constant_String("hh"),
+				testutils.SelectItem_Constant_Int(2),
+				testutils.SelectItem_Constant_String("2021-01-01T00:00:00Z"),
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function DateTimeDiff(part, startDate, endDate)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimeDiff("dd", c.start, c.end) FROM c`,
+			parsers.FunctionCallDateTimeDiff,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("dd"),
+				parsers.SelectItem{
+					Path: []string{"c", "start"},
+					Type: parsers.SelectItemTypeField,
+				},
+				parsers.SelectItem{
+					Path: []string{"c", "end"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function DateTimeFromParts(year, month, day, hour, minute, second, ticks)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimeFromParts(2024, 2, 29, 10, 20, 30, 1234567) FROM c`,
+			parsers.FunctionCallDateTimeFromParts,
+			[]interface{}{
+				testutils.SelectItem_Constant_Int(2024),
+				testutils.SelectItem_Constant_Int(2),
+				testutils.SelectItem_Constant_Int(29),
+				testutils.SelectItem_Constant_Int(10),
+				testutils.SelectItem_Constant_Int(20),
+				testutils.SelectItem_Constant_Int(30),
+				testutils.SelectItem_Constant_Int(1234567),
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function DateTimePart(part, dateTime)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimePart("yyyy", c.date) FROM c`,
+			parsers.FunctionCallDateTimePart,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("yyyy"),
+				parsers.SelectItem{
+					Path: []string{"c", "date"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function DateTimeToTicks(dateTime)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimeToTicks(c.date) FROM c`,
+			parsers.FunctionCallDateTimeToTicks,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "date"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function DateTimeToTimestamp(dateTime)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT DateTimeToTimestamp(c.date) FROM c`,
+			parsers.FunctionCallDateTimeToTimestamp,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "date"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function GetCurrentDateTime()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT GetCurrentDateTime() FROM c`,
+			parsers.FunctionCallGetCurrentDateTime,
+			[]interface{}{},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function GetCurrentDateTimeStatic()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT GetCurrentDateTimeStatic() FROM c`,
+			parsers.FunctionCallGetCurrentDateTimeStatic,
+			[]interface{}{},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function GetCurrentTicks()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT GetCurrentTicks() FROM c`,
+			parsers.FunctionCallGetCurrentTicks,
+			[]interface{}{},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function GetCurrentTicksStatic()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT GetCurrentTicksStatic() FROM c`,
+			parsers.FunctionCallGetCurrentTicksStatic,
+			[]interface{}{},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function GetCurrentTimestamp()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT GetCurrentTimestamp() FROM c`,
+			parsers.FunctionCallGetCurrentTimestamp,
+			[]interface{}{},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function GetCurrentTimestampStatic()", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT GetCurrentTimestampStatic() FROM c`,
+			parsers.FunctionCallGetCurrentTimestampStatic,
+			[]interface{}{},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function TicksToDateTime(ticks)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT TicksToDateTime(c.ticks) FROM c`,
+			parsers.FunctionCallTicksToDateTime,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "ticks"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function TimestampToDateTime(timestamp)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT TimestampToDateTime(c.ts) FROM c`,
+			parsers.FunctionCallTimestampToDateTime,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "ts"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+}
@@ -0,0 +1,71 @@
+package nosql
+
+import (
+	"errors"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SyntaxError describes why and where a query could not be parsed.
+// Start and End are character offsets into the query text.
+type SyntaxError struct {
+	Start   int
+	End     int
+	Message string
+}
+
+func (e SyntaxError) Error() string {
+	return e.Message
+}
+
+// GetSyntaxError converts an error returned by Parse into a SyntaxError
+// pointing at the part of the query where parsing failed
+func GetSyntaxError(query string, err error) SyntaxError {
+	var parseErr *parserError
+	var list errList
+	if errors.As(err, &list) && len(list) > 0 {
+		errors.As(list[0], &parseErr)
+	} else {
+		errors.As(err, &parseErr)
+	}
+
+	if parseErr == nil {
+		return SyntaxError{Start: 0, End: utf8.RuneCountInString(query), Message: err.Error()}
+	}
+
+	offset := min(max(parseErr.pos.offset, 0), len(query))
+	start := utf8.RuneCountInString(query[:offset])
+
+	// Only "no match" errors carry the list of expected tokens,
+	// other errors are returned by the grammar actions themselves
+	if len(parseErr.expected) == 0 {
+		return SyntaxError{Start: start, End: start, Message: parseErr.Inner.Error()}
+	}
+
+	token := tokenAt(query[offset:])
+	if token == "" {
+		return SyntaxError{Start: start, End: start, Message: "Syntax error, unexpected end-of-file."}
+	}
+
+	return SyntaxError{
+		Start:   start,
+		End:     start + utf8.RuneCountInString(token),
+		Message: fmt.Sprintf("Syntax error, incorrect syntax near '%s'.", token),
+	}
+}
+
+// tokenAt returns the word or the single symbol at the beginning of the text
+func tokenAt(text string) string {
+	for i, r := range text {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			if i == 0 {
+				_, size := utf8.DecodeRuneInString(text)
+				return text[:size]
+			}
+			return text[:i]
+		}
+	}
+
+	return text
+}
@@ -0,0 +1,53 @@
+package nosql_test
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers/nosql"
+)
+
+func Test_GetSyntaxError(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected nosql.SyntaxError
+	}{
+		{
+			query:    `SELECT * FORM c`,
+			expected: nosql.SyntaxError{Start: 9, End: 13, Message: "Syntax error, incorrect syntax near 'FORM'."},
+		},
+		{
+			query:    `SELECT * FROM c WHERE c.id = 1 garbage`,
+			expected: nosql.SyntaxError{Start: 31, End: 38, Message: "Syntax error, incorrect syntax near 'garbage'."},
+		},
+		{
+			query:    `SELECT * FROM c WHERE c.id = `,
+			expected: nosql.SyntaxError{Start: 29, End: 29, Message: "Syntax error, unexpected end-of-file."},
+		},
+		{
+			query:    `SELECT * FROM c WHERE c.name = "ąčę" AND )`,
+			expected: nosql.SyntaxError{Start: 41, End: 42, Message: "Syntax error, incorrect syntax near ')'."},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.query, func(t *testing.T) {
+			_, err := nosql.Parse("", []byte(testCase.query))
+			if err == nil {
+				t.Fatal("expected query to fail parsing")
+			}
+
+			syntaxError := nosql.GetSyntaxError(testCase.query, err)
+			if syntaxError != testCase.expected {
+				t.Errorf("expected %+v, got %+v", testCase.expected, syntaxError)
+			}
+		})
+	}
+}
+
+func Test_Parse_KeywordPrefixedAlias(t *testing.T) {
+	t.Run("Should parse alias starting with a keyword", func(t *testing.T) {
+		if _, err := nosql.Parse("", []byte(`SELECT c.id AS inRange FROM c`)); err != nil {
+			t.Errorf("expected query to parse, got %v", err)
+		}
+	})
+}
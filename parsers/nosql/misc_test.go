@@ -245,4 +245,56 @@ func Test_Parse(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should parse DocumentId function", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT DocumentId(c) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallDocumentId,
+							Arguments: []interface{}{
+								testutils.SelectItem_Path("c"),
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse user defined function call", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT udf.tax(c.price, 0.21), UDF.now() FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:    parsers.FunctionCallUserDefined,
+							UdfName: "tax",
+							Arguments: []interface{}{
+								testutils.SelectItem_Path("c", "price"),
+								testutils.SelectItem_Constant_Float(0.21),
+							},
+						},
+					},
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallUserDefined,
+							UdfName:   "now",
+							Arguments: []interface{}{},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
 }
@@ -41,7 +41,7 @@ func makeSelectStmt(
 	}
 
 	switch v := whereClause.(type) {
-	case parsers.ComparisonExpression, parsers.LogicalExpression, parsers.Constant, parsers.SelectItem:
+	case parsers.ComparisonExpression, parsers.LogicalExpression, parsers.BetweenExpression, parsers.Constant, parsers.SelectItem:
 		selectStmt.Filters = v
 	}
 
@@ -170,6 +170,59 @@ func createFunctionCall(functionType parsers.FunctionCallType, arguments []inter
 	return parsers.FunctionCall{Type: functionType, Arguments: arguments}, nil
 }
 
+func makeComparisonExpression(left interface{}, comparison interface{}) (interface{}, error) {
+	switch typedComparison := comparison.(type) {
+	case parsers.ComparisonExpression:
+		typedComparison.Left = left
+		return typedComparison, nil
+	case parsers.BetweenExpression:
+		typedComparison.Value = left
+		return typedComparison, nil
+	}
+
+	return left, nil
+}
+
+func makeTernaryExpression(condition interface{}, branches interface{}) (interface{}, error) {
+	branchesArray, ok := branches.([]interface{})
+	if !ok {
+		return condition, nil
+	}
+
+	return parsers.SelectItem{
+		Type: parsers.SelectItemTypeFunctionCall,
+		Value: parsers.FunctionCall{
+			Type: parsers.FunctionCallIif,
+			Arguments: []interface{}{
+				makeExpressionSelectItem(condition),
+				makeExpressionSelectItem(branchesArray[0]),
+				makeExpressionSelectItem(branchesArray[1]),
+			},
+		},
+	}, nil
+}
+
+func makeLikeExpression(right interface{}, invert bool, escape interface{}) (parsers.ComparisonExpression, error) {
+	expression := parsers.ComparisonExpression{
+		Right:     right,
+		Operation: "LIKE",
+	}
+
+	if invert {
+		expression.Operation = "NOT LIKE"
+	}
+
+	if escapeConstant, ok := escape.(parsers.Constant); ok {
+		escapeValue := escapeConstant.Value.(string)
+		if len([]rune(escapeValue)) != 1 {
+			return expression, errors.New("the ESCAPE clause must be a single character")
+		}
+		expression.Escape = escapeValue
+	}
+
+	return expression, nil
+}
+
 func joinStrings(array []interface{}) string {
 	var stringsArray []string
 	for _, elem := range array {
@@ -194,6 +247,43 @@ func combineExpressions(ex1 interface{}, exs interface{}, operation parsers.Logi
 	}, nil
 }
 
+func makeExpressionSelectItem(ex interface{}) parsers.SelectItem {
+	switch typedValue := ex.(type) {
+	case parsers.SelectItem:
+		return typedValue
+	case parsers.Constant:
+		return parsers.SelectItem{Type: parsers.SelectItemTypeConstant, Value: typedValue}
+	default:
+		return parsers.SelectItem{Type: parsers.SelectItemTypeExpression, Value: typedValue}
+	}
+}
+
+func makeUnaryExpression(operation string, ex interface{}) (parsers.SelectItem, error) {
+	selectItem := makeExpressionSelectItem(ex)
+
+	// Fold negative number literals into constants
+	if constant, ok := selectItem.Value.(parsers.Constant); ok && operation == "-" && selectItem.Type == parsers.SelectItemTypeConstant {
+		switch value := constant.Value.(type) {
+		case int:
+			constant.Value = -value
+			selectItem.Value = constant
+			return selectItem, nil
+		case float64:
+			constant.Value = -value
+			selectItem.Value = constant
+			return selectItem, nil
+		}
+	}
+
+	return parsers.SelectItem{
+		Type: parsers.SelectItemTypeUnaryExpression,
+		Value: parsers.UnaryExpression{
+			Operation: operation,
+			Value:     selectItem,
+		},
+	}, nil
+}
+
 func makeMathExpression(left interface{}, operations interface{}) (interface{}, error) {
 	if operations == nil || len(operations.([]interface{})) == 0 {
 		return left, nil
@@ -224,114 +314,127 @@ var g = &grammar{
 	rules: []*rule{
 		{
 			name: "Input",
-			pos:  position{line: 209, col: 1, offset: 5961},
+			pos:  position{line: 299, col: 1, offset: 8878},
 			expr: &actionExpr{
-				pos: position{line: 209, col: 10, offset: 5970},
+				pos: position{line: 299, col: 10, offset: 8887},
 				run: (*parser).callonInput1,
-				expr: &labeledExpr{
-					pos:   position{line: 209, col: 10, offset: 5970},
-					label: "selectStmt",
-					expr: &ruleRefExpr{
-						pos:  position{line: 209, col: 21, offset: 5981},
-						name: "SelectStmt",
+				expr: &seqExpr{
+					pos: position{line: 299, col: 10, offset: 8887},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 299, col: 10, offset: 8887},
+							label: "selectStmt",
+							expr: &ruleRefExpr{
+								pos:  position{line: 299, col: 21, offset: 8898},
+								name: "SelectStmt",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 299, col: 32, offset: 8909},
+							name: "ws",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 299, col: 35, offset: 8912},
+							name: "EOF",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "SelectStmt",
-			pos:  position{line: 213, col: 1, offset: 6024},
+			pos:  position{line: 303, col: 1, offset: 8948},
 			expr: &actionExpr{
-				pos: position{line: 213, col: 15, offset: 6038},
+				pos: position{line: 303, col: 15, offset: 8962},
 				run: (*parser).callonSelectStmt1,
 				expr: &seqExpr{
-					pos: position{line: 213, col: 15, offset: 6038},
+					pos: position{line: 303, col: 15, offset: 8962},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 213, col: 15, offset: 6038},
+							pos:  position{line: 303, col: 15, offset: 8962},
 							name: "Select",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 213, col: 22, offset: 6045},
+							pos:  position{line: 303, col: 22, offset: 8969},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 214, col: 5, offset: 6052},
+							pos:   position{line: 304, col: 5, offset: 8976},
 							label: "distinctClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 214, col: 20, offset: 6067},
+								pos: position{line: 304, col: 20, offset: 8991},
 								expr: &ruleRefExpr{
-									pos:  position{line: 214, col: 20, offset: 6067},
+									pos:  position{line: 304, col: 20, offset: 8991},
 									name: "DistinctClause",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 214, col: 36, offset: 6083},
+							pos:  position{line: 304, col: 36, offset: 9007},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 215, col: 5, offset: 6090},
+							pos:   position{line: 305, col: 5, offset: 9014},
 							label: "topClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 215, col: 15, offset: 6100},
+								pos: position{line: 305, col: 15, offset: 9024},
 								expr: &ruleRefExpr{
-									pos:  position{line: 215, col: 15, offset: 6100},
+									pos:  position{line: 305, col: 15, offset: 9024},
 									name: "TopClause",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 215, col: 26, offset: 6111},
+							pos:  position{line: 305, col: 26, offset: 9035},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 216, col: 5, offset: 6118},
+							pos:   position{line: 306, col: 5, offset: 9042},
 							label: "columns",
 							expr: &ruleRefExpr{
-								pos:  position{line: 216, col: 13, offset: 6126},
+								pos:  position{line: 306, col: 13, offset: 9050},
 								name: "Selection",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 216, col: 23, offset: 6136},
+							pos:  position{line: 306, col: 23, offset: 9060},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 5, offset: 6143},
+							pos:   position{line: 307, col: 5, offset: 9067},
 							label: "fromClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 217, col: 16, offset: 6154},
+								pos: position{line: 307, col: 16, offset: 9078},
 								expr: &ruleRefExpr{
-									pos:  position{line: 217, col: 16, offset: 6154},
+									pos:  position{line: 307, col: 16, offset: 9078},
 									name: "FromClause",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 217, col: 28, offset: 6166},
+							pos:  position{line: 307, col: 28, offset: 9090},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 5, offset: 6173},
+							pos:   position{line: 308, col: 5, offset: 9097},
 							label: "joinClauses",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 218, col: 17, offset: 6185},
+								pos: position{line: 308, col: 17, offset: 9109},
 								expr: &actionExpr{
-									pos: position{line: 218, col: 18, offset: 6186},
+									pos: position{line: 308, col: 18, offset: 9110},
 									run: (*parser).callonSelectStmt22,
 									expr: &seqExpr{
-										pos: position{line: 218, col: 18, offset: 6186},
+										pos: position{line: 308, col: 18, offset: 9110},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 218, col: 18, offset: 6186},
+												pos:  position{line: 308, col: 18, offset: 9110},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 218, col: 21, offset: 6189},
+												pos:   position{line: 308, col: 21, offset: 9113},
 												label: "join",
 												expr: &ruleRefExpr{
-													pos:  position{line: 218, col: 26, offset: 6194},
+													pos:  position{line: 308, col: 26, offset: 9118},
 													name: "JoinClause",
 												},
 											},
@@ -341,37 +444,37 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 218, col: 60, offset: 6228},
+							pos:  position{line: 308, col: 60, offset: 9152},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 219, col: 5, offset: 6235},
+							pos:   position{line: 309, col: 5, offset: 9159},
 							label: "whereClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 219, col: 17, offset: 6247},
+								pos: position{line: 309, col: 17, offset: 9171},
 								expr: &actionExpr{
-									pos: position{line: 219, col: 18, offset: 6248},
+									pos: position{line: 309, col: 18, offset: 9172},
 									run: (*parser).callonSelectStmt30,
 									expr: &seqExpr{
-										pos: position{line: 219, col: 18, offset: 6248},
+										pos: position{line: 309, col: 18, offset: 9172},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 219, col: 18, offset: 6248},
+												pos:  position{line: 309, col: 18, offset: 9172},
 												name: "ws",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 219, col: 21, offset: 6251},
+												pos:  position{line: 309, col: 21, offset: 9175},
 												name: "Where",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 219, col: 27, offset: 6257},
+												pos:  position{line: 309, col: 27, offset: 9181},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 219, col: 30, offset: 6260},
+												pos:   position{line: 309, col: 30, offset: 9184},
 												label: "condition",
 												expr: &ruleRefExpr{
-													pos:  position{line: 219, col: 40, offset: 6270},
+													pos:  position{line: 309, col: 40, offset: 9194},
 													name: "Condition",
 												},
 											},
@@ -381,33 +484,33 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 220, col: 5, offset: 6312},
+							pos:   position{line: 310, col: 5, offset: 9236},
 							label: "groupByClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 220, col: 19, offset: 6326},
+								pos: position{line: 310, col: 19, offset: 9250},
 								expr: &actionExpr{
-									pos: position{line: 220, col: 20, offset: 6327},
+									pos: position{line: 310, col: 20, offset: 9251},
 									run: (*parser).callonSelectStmt39,
 									expr: &seqExpr{
-										pos: position{line: 220, col: 20, offset: 6327},
+										pos: position{line: 310, col: 20, offset: 9251},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 220, col: 20, offset: 6327},
+												pos:  position{line: 310, col: 20, offset: 9251},
 												name: "ws",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 220, col: 23, offset: 6330},
+												pos:  position{line: 310, col: 23, offset: 9254},
 												name: "GroupBy",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 220, col: 31, offset: 6338},
+												pos:  position{line: 310, col: 31, offset: 9262},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 220, col: 34, offset: 6341},
+												pos:   position{line: 310, col: 34, offset: 9265},
 												label: "columns",
 												expr: &ruleRefExpr{
-													pos:  position{line: 220, col: 42, offset: 6349},
+													pos:  position{line: 310, col: 42, offset: 9273},
 													name: "ColumnList",
 												},
 											},
@@ -417,25 +520,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 221, col: 5, offset: 6390},
+							pos:   position{line: 311, col: 5, offset: 9314},
 							label: "orderByClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 221, col: 19, offset: 6404},
+								pos: position{line: 311, col: 19, offset: 9328},
 								expr: &actionExpr{
-									pos: position{line: 221, col: 20, offset: 6405},
+									pos: position{line: 311, col: 20, offset: 9329},
 									run: (*parser).callonSelectStmt48,
 									expr: &seqExpr{
-										pos: position{line: 221, col: 20, offset: 6405},
+										pos: position{line: 311, col: 20, offset: 9329},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 221, col: 20, offset: 6405},
+												pos:  position{line: 311, col: 20, offset: 9329},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 221, col: 23, offset: 6408},
+												pos:   position{line: 311, col: 23, offset: 9332},
 												label: "order",
 												expr: &ruleRefExpr{
-													pos:  position{line: 221, col: 29, offset: 6414},
+													pos:  position{line: 311, col: 29, offset: 9338},
 													name: "OrderByClause",
 												},
 											},
@@ -445,25 +548,25 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 222, col: 5, offset: 6456},
+							pos:   position{line: 312, col: 5, offset: 9380},
 							label: "offsetClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 222, col: 18, offset: 6469},
+								pos: position{line: 312, col: 18, offset: 9393},
 								expr: &actionExpr{
-									pos: position{line: 222, col: 19, offset: 6470},
+									pos: position{line: 312, col: 19, offset: 9394},
 									run: (*parser).callonSelectStmt55,
 									expr: &seqExpr{
-										pos: position{line: 222, col: 19, offset: 6470},
+										pos: position{line: 312, col: 19, offset: 9394},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 222, col: 19, offset: 6470},
+												pos:  position{line: 312, col: 19, offset: 9394},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 222, col: 22, offset: 6473},
+												pos:   position{line: 312, col: 22, offset: 9397},
 												label: "offset",
 												expr: &ruleRefExpr{
-													pos:  position{line: 222, col: 29, offset: 6480},
+													pos:  position{line: 312, col: 29, offset: 9404},
 													name: "OffsetClause",
 												},
 											},
@@ -478,9 +581,9 @@ var g = &grammar{
 		},
 		{
 			name: "DistinctClause",
-			pos:  position{line: 227, col: 1, offset: 6675},
+			pos:  position{line: 317, col: 1, offset: 9599},
 			expr: &litMatcher{
-				pos:        position{line: 227, col: 19, offset: 6693},
+				pos:        position{line: 317, col: 19, offset: 9617},
 				val:        "distinct",
 				ignoreCase: true,
 				want:       "\"DISTINCT\"i",
@@ -488,26 +591,26 @@ var g = &grammar{
 		},
 		{
 			name: "TopClause",
-			pos:  position{line: 229, col: 1, offset: 6706},
+			pos:  position{line: 319, col: 1, offset: 9630},
 			expr: &actionExpr{
-				pos: position{line: 229, col: 14, offset: 6719},
+				pos: position{line: 319, col: 14, offset: 9643},
 				run: (*parser).callonTopClause1,
 				expr: &seqExpr{
-					pos: position{line: 229, col: 14, offset: 6719},
+					pos: position{line: 319, col: 14, offset: 9643},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 229, col: 14, offset: 6719},
+							pos:  position{line: 319, col: 14, offset: 9643},
 							name: "Top",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 229, col: 18, offset: 6723},
+							pos:  position{line: 319, col: 18, offset: 9647},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 229, col: 21, offset: 6726},
+							pos:   position{line: 319, col: 21, offset: 9650},
 							label: "count",
 							expr: &ruleRefExpr{
-								pos:  position{line: 229, col: 27, offset: 6732},
+								pos:  position{line: 319, col: 27, offset: 9656},
 								name: "Integer",
 							},
 						},
@@ -517,58 +620,58 @@ var g = &grammar{
 		},
 		{
 			name: "FromClause",
-			pos:  position{line: 233, col: 1, offset: 6767},
+			pos:  position{line: 323, col: 1, offset: 9691},
 			expr: &choiceExpr{
-				pos: position{line: 233, col: 15, offset: 6781},
+				pos: position{line: 323, col: 15, offset: 9705},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 233, col: 15, offset: 6781},
+						pos: position{line: 323, col: 15, offset: 9705},
 						run: (*parser).callonFromClause2,
 						expr: &seqExpr{
-							pos: position{line: 233, col: 15, offset: 6781},
+							pos: position{line: 323, col: 15, offset: 9705},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 233, col: 15, offset: 6781},
+									pos:  position{line: 323, col: 15, offset: 9705},
 									name: "From",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 233, col: 20, offset: 6786},
+									pos:  position{line: 323, col: 20, offset: 9710},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 233, col: 23, offset: 6789},
+									pos:   position{line: 323, col: 23, offset: 9713},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 233, col: 29, offset: 6795},
+										pos:  position{line: 323, col: 29, offset: 9719},
 										name: "TableName",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 233, col: 39, offset: 6805},
+									pos:   position{line: 323, col: 39, offset: 9729},
 									label: "selectItem",
 									expr: &actionExpr{
-										pos: position{line: 233, col: 51, offset: 6817},
+										pos: position{line: 323, col: 51, offset: 9741},
 										run: (*parser).callonFromClause9,
 										expr: &seqExpr{
-											pos: position{line: 233, col: 51, offset: 6817},
+											pos: position{line: 323, col: 51, offset: 9741},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 233, col: 51, offset: 6817},
+													pos:  position{line: 323, col: 51, offset: 9741},
 													name: "ws",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 233, col: 54, offset: 6820},
+													pos:  position{line: 323, col: 54, offset: 9744},
 													name: "In",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 233, col: 57, offset: 6823},
+													pos:  position{line: 323, col: 57, offset: 9747},
 													name: "ws",
 												},
 												&labeledExpr{
-													pos:   position{line: 233, col: 60, offset: 6826},
+													pos:   position{line: 323, col: 60, offset: 9750},
 													label: "column",
 													expr: &ruleRefExpr{
-														pos:  position{line: 233, col: 67, offset: 6833},
+														pos:  position{line: 323, col: 67, offset: 9757},
 														name: "SelectItemWithAlias",
 													},
 												},
@@ -580,24 +683,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 242, col: 5, offset: 7086},
+						pos: position{line: 332, col: 5, offset: 10010},
 						run: (*parser).callonFromClause16,
 						expr: &seqExpr{
-							pos: position{line: 242, col: 5, offset: 7086},
+							pos: position{line: 332, col: 5, offset: 10010},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 242, col: 5, offset: 7086},
+									pos:  position{line: 332, col: 5, offset: 10010},
 									name: "From",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 242, col: 10, offset: 7091},
+									pos:  position{line: 332, col: 10, offset: 10015},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 242, col: 13, offset: 7094},
+									pos:   position{line: 332, col: 13, offset: 10018},
 									label: "column",
 									expr: &ruleRefExpr{
-										pos:  position{line: 242, col: 20, offset: 7101},
+										pos:  position{line: 332, col: 20, offset: 10025},
 										name: "SelectItemWithAlias",
 									},
 								},
@@ -605,24 +708,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 249, col: 5, offset: 7309},
+						pos: position{line: 339, col: 5, offset: 10233},
 						run: (*parser).callonFromClause22,
 						expr: &seqExpr{
-							pos: position{line: 249, col: 5, offset: 7309},
+							pos: position{line: 339, col: 5, offset: 10233},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 249, col: 5, offset: 7309},
+									pos:  position{line: 339, col: 5, offset: 10233},
 									name: "From",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 249, col: 10, offset: 7314},
+									pos:  position{line: 339, col: 10, offset: 10238},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 249, col: 13, offset: 7317},
+									pos:   position{line: 339, col: 13, offset: 10241},
 									label: "subQuery",
 									expr: &ruleRefExpr{
-										pos:  position{line: 249, col: 22, offset: 7326},
+										pos:  position{line: 339, col: 22, offset: 10250},
 										name: "SubQuerySelectItem",
 									},
 								},
@@ -634,34 +737,34 @@ var g = &grammar{
 		},
 		{
 			name: "SubQuery",
-			pos:  position{line: 258, col: 1, offset: 7528},
+			pos:  position{line: 348, col: 1, offset: 10452},
 			expr: &actionExpr{
-				pos: position{line: 258, col: 13, offset: 7540},
+				pos: position{line: 348, col: 13, offset: 10464},
 				run: (*parser).callonSubQuery1,
 				expr: &seqExpr{
-					pos: position{line: 258, col: 13, offset: 7540},
+					pos: position{line: 348, col: 13, offset: 10464},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 258, col: 13, offset: 7540},
+							pos:   position{line: 348, col: 13, offset: 10464},
 							label: "exists",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 258, col: 20, offset: 7547},
+								pos: position{line: 348, col: 20, offset: 10471},
 								expr: &actionExpr{
-									pos: position{line: 258, col: 21, offset: 7548},
+									pos: position{line: 348, col: 21, offset: 10472},
 									run: (*parser).callonSubQuery5,
 									expr: &seqExpr{
-										pos: position{line: 258, col: 21, offset: 7548},
+										pos: position{line: 348, col: 21, offset: 10472},
 										exprs: []any{
 											&labeledExpr{
-												pos:   position{line: 258, col: 21, offset: 7548},
+												pos:   position{line: 348, col: 21, offset: 10472},
 												label: "exists",
 												expr: &ruleRefExpr{
-													pos:  position{line: 258, col: 28, offset: 7555},
+													pos:  position{line: 348, col: 28, offset: 10479},
 													name: "Exists",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 258, col: 35, offset: 7562},
+												pos:  position{line: 348, col: 35, offset: 10486},
 												name: "ws",
 											},
 										},
@@ -670,29 +773,29 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 258, col: 63, offset: 7590},
+							pos:        position{line: 348, col: 63, offset: 10514},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 258, col: 67, offset: 7594},
+							pos:  position{line: 348, col: 67, offset: 10518},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 258, col: 70, offset: 7597},
+							pos:   position{line: 348, col: 70, offset: 10521},
 							label: "selectStmt",
 							expr: &ruleRefExpr{
-								pos:  position{line: 258, col: 81, offset: 7608},
+								pos:  position{line: 348, col: 81, offset: 10532},
 								name: "SelectStmt",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 258, col: 92, offset: 7619},
+							pos:  position{line: 348, col: 92, offset: 10543},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 258, col: 95, offset: 7622},
+							pos:        position{line: 348, col: 95, offset: 10546},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -703,41 +806,41 @@ var g = &grammar{
 		},
 		{
 			name: "SubQuerySelectItem",
-			pos:  position{line: 267, col: 1, offset: 7834},
+			pos:  position{line: 357, col: 1, offset: 10758},
 			expr: &actionExpr{
-				pos: position{line: 267, col: 23, offset: 7856},
+				pos: position{line: 357, col: 23, offset: 10780},
 				run: (*parser).callonSubQuerySelectItem1,
 				expr: &seqExpr{
-					pos: position{line: 267, col: 23, offset: 7856},
+					pos: position{line: 357, col: 23, offset: 10780},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 267, col: 23, offset: 7856},
+							pos:   position{line: 357, col: 23, offset: 10780},
 							label: "subQuery",
 							expr: &ruleRefExpr{
-								pos:  position{line: 267, col: 32, offset: 7865},
+								pos:  position{line: 357, col: 32, offset: 10789},
 								name: "SubQuery",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 267, col: 41, offset: 7874},
+							pos:   position{line: 357, col: 41, offset: 10798},
 							label: "asClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 267, col: 50, offset: 7883},
+								pos: position{line: 357, col: 50, offset: 10807},
 								expr: &actionExpr{
-									pos: position{line: 267, col: 51, offset: 7884},
+									pos: position{line: 357, col: 51, offset: 10808},
 									run: (*parser).callonSubQuerySelectItem7,
 									expr: &seqExpr{
-										pos: position{line: 267, col: 51, offset: 7884},
+										pos: position{line: 357, col: 51, offset: 10808},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 267, col: 51, offset: 7884},
+												pos:  position{line: 357, col: 51, offset: 10808},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 267, col: 54, offset: 7887},
+												pos:   position{line: 357, col: 54, offset: 10811},
 												label: "alias",
 												expr: &ruleRefExpr{
-													pos:  position{line: 267, col: 60, offset: 7893},
+													pos:  position{line: 357, col: 60, offset: 10817},
 													name: "AsClause",
 												},
 											},
@@ -752,49 +855,49 @@ var g = &grammar{
 		},
 		{
 			name: "JoinClause",
-			pos:  position{line: 280, col: 1, offset: 8178},
+			pos:  position{line: 370, col: 1, offset: 11102},
 			expr: &choiceExpr{
-				pos: position{line: 280, col: 15, offset: 8192},
+				pos: position{line: 370, col: 15, offset: 11116},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 280, col: 15, offset: 8192},
+						pos: position{line: 370, col: 15, offset: 11116},
 						run: (*parser).callonJoinClause2,
 						expr: &seqExpr{
-							pos: position{line: 280, col: 15, offset: 8192},
+							pos: position{line: 370, col: 15, offset: 11116},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 15, offset: 8192},
+									pos:  position{line: 370, col: 15, offset: 11116},
 									name: "Join",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 20, offset: 8197},
+									pos:  position{line: 370, col: 20, offset: 11121},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 280, col: 23, offset: 8200},
+									pos:   position{line: 370, col: 23, offset: 11124},
 									label: "table",
 									expr: &ruleRefExpr{
-										pos:  position{line: 280, col: 29, offset: 8206},
+										pos:  position{line: 370, col: 29, offset: 11130},
 										name: "TableName",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 39, offset: 8216},
+									pos:  position{line: 370, col: 39, offset: 11140},
 									name: "ws",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 42, offset: 8219},
+									pos:  position{line: 370, col: 42, offset: 11143},
 									name: "In",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 280, col: 45, offset: 8222},
+									pos:  position{line: 370, col: 45, offset: 11146},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 280, col: 48, offset: 8225},
+									pos:   position{line: 370, col: 48, offset: 11149},
 									label: "column",
 									expr: &ruleRefExpr{
-										pos:  position{line: 280, col: 55, offset: 8232},
+										pos:  position{line: 370, col: 55, offset: 11156},
 										name: "SelectItemWithAlias",
 									},
 								},
@@ -802,24 +905,24 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 282, col: 5, offset: 8293},
+						pos: position{line: 372, col: 5, offset: 11217},
 						run: (*parser).callonJoinClause13,
 						expr: &seqExpr{
-							pos: position{line: 282, col: 5, offset: 8293},
+							pos: position{line: 372, col: 5, offset: 11217},
 							exprs: []any{
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 5, offset: 8293},
+									pos:  position{line: 372, col: 5, offset: 11217},
 									name: "Join",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 282, col: 10, offset: 8298},
+									pos:  position{line: 372, col: 10, offset: 11222},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 282, col: 13, offset: 8301},
+									pos:   position{line: 372, col: 13, offset: 11225},
 									label: "subQuery",
 									expr: &ruleRefExpr{
-										pos:  position{line: 282, col: 22, offset: 8310},
+										pos:  position{line: 372, col: 22, offset: 11234},
 										name: "SubQuerySelectItem",
 									},
 								},
@@ -831,48 +934,48 @@ var g = &grammar{
 		},
 		{
 			name: "OffsetClause",
-			pos:  position{line: 286, col: 1, offset: 8369},
+			pos:  position{line: 376, col: 1, offset: 11293},
 			expr: &actionExpr{
-				pos: position{line: 286, col: 17, offset: 8385},
+				pos: position{line: 376, col: 17, offset: 11309},
 				run: (*parser).callonOffsetClause1,
 				expr: &seqExpr{
-					pos: position{line: 286, col: 17, offset: 8385},
+					pos: position{line: 376, col: 17, offset: 11309},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 286, col: 17, offset: 8385},
+							pos:  position{line: 376, col: 17, offset: 11309},
 							name: "Offset",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 286, col: 24, offset: 8392},
+							pos:  position{line: 376, col: 24, offset: 11316},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 286, col: 27, offset: 8395},
+							pos:   position{line: 376, col: 27, offset: 11319},
 							label: "offset",
 							expr: &ruleRefExpr{
-								pos:  position{line: 286, col: 34, offset: 8402},
+								pos:  position{line: 376, col: 34, offset: 11326},
 								name: "IntegerLiteral",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 286, col: 49, offset: 8417},
+							pos:  position{line: 376, col: 49, offset: 11341},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 286, col: 52, offset: 8420},
+							pos:        position{line: 376, col: 52, offset: 11344},
 							val:        "limit",
 							ignoreCase: true,
 							want:       "\"LIMIT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 286, col: 61, offset: 8429},
+							pos:  position{line: 376, col: 61, offset: 11353},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 286, col: 64, offset: 8432},
+							pos:   position{line: 376, col: 64, offset: 11356},
 							label: "limit",
 							expr: &ruleRefExpr{
-								pos:  position{line: 286, col: 70, offset: 8438},
+								pos:  position{line: 376, col: 70, offset: 11362},
 								name: "IntegerLiteral",
 							},
 						},
@@ -882,20 +985,20 @@ var g = &grammar{
 		},
 		{
 			name: "Selection",
-			pos:  position{line: 290, col: 1, offset: 8553},
+			pos:  position{line: 380, col: 1, offset: 11477},
 			expr: &choiceExpr{
-				pos: position{line: 290, col: 14, offset: 8566},
+				pos: position{line: 380, col: 14, offset: 11490},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 290, col: 14, offset: 8566},
+						pos:  position{line: 380, col: 14, offset: 11490},
 						name: "SelectValueSpec",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 290, col: 32, offset: 8584},
+						pos:  position{line: 380, col: 32, offset: 11508},
 						name: "ColumnList",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 290, col: 45, offset: 8597},
+						pos:  position{line: 380, col: 45, offset: 11521},
 						name: "SelectAsterisk",
 					},
 				},
@@ -903,12 +1006,12 @@ var g = &grammar{
 		},
 		{
 			name: "SelectAsterisk",
-			pos:  position{line: 292, col: 1, offset: 8613},
+			pos:  position{line: 382, col: 1, offset: 11537},
 			expr: &actionExpr{
-				pos: position{line: 292, col: 19, offset: 8631},
+				pos: position{line: 382, col: 19, offset: 11555},
 				run: (*parser).callonSelectAsterisk1,
 				expr: &litMatcher{
-					pos:        position{line: 292, col: 19, offset: 8631},
+					pos:        position{line: 382, col: 19, offset: 11555},
 					val:        "*",
 					ignoreCase: false,
 					want:       "\"*\"",
@@ -917,51 +1020,51 @@ var g = &grammar{
 		},
 		{
 			name: "ColumnList",
-			pos:  position{line: 298, col: 1, offset: 8829},
+			pos:  position{line: 388, col: 1, offset: 11753},
 			expr: &actionExpr{
-				pos: position{line: 298, col: 15, offset: 8843},
+				pos: position{line: 388, col: 15, offset: 11767},
 				run: (*parser).callonColumnList1,
 				expr: &seqExpr{
-					pos: position{line: 298, col: 15, offset: 8843},
+					pos: position{line: 388, col: 15, offset: 11767},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 298, col: 15, offset: 8843},
+							pos:   position{line: 388, col: 15, offset: 11767},
 							label: "column",
 							expr: &ruleRefExpr{
-								pos:  position{line: 298, col: 22, offset: 8850},
+								pos:  position{line: 388, col: 22, offset: 11774},
 								name: "ExpressionOrSelectItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 298, col: 45, offset: 8873},
+							pos:   position{line: 388, col: 45, offset: 11797},
 							label: "other_columns",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 298, col: 59, offset: 8887},
+								pos: position{line: 388, col: 59, offset: 11811},
 								expr: &actionExpr{
-									pos: position{line: 298, col: 60, offset: 8888},
+									pos: position{line: 388, col: 60, offset: 11812},
 									run: (*parser).callonColumnList7,
 									expr: &seqExpr{
-										pos: position{line: 298, col: 60, offset: 8888},
+										pos: position{line: 388, col: 60, offset: 11812},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 298, col: 60, offset: 8888},
+												pos:  position{line: 388, col: 60, offset: 11812},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 298, col: 63, offset: 8891},
+												pos:        position{line: 388, col: 63, offset: 11815},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 298, col: 67, offset: 8895},
+												pos:  position{line: 388, col: 67, offset: 11819},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 298, col: 70, offset: 8898},
+												pos:   position{line: 388, col: 70, offset: 11822},
 												label: "coll",
 												expr: &ruleRefExpr{
-													pos:  position{line: 298, col: 75, offset: 8903},
+													pos:  position{line: 388, col: 75, offset: 11827},
 													name: "ExpressionOrSelectItem",
 												},
 											},
@@ -976,31 +1079,31 @@ var g = &grammar{
 		},
 		{
 			name: "ExpressionOrSelectItem",
-			pos:  position{line: 302, col: 1, offset: 9002},
+			pos:  position{line: 392, col: 1, offset: 11926},
 			expr: &choiceExpr{
-				pos: position{line: 302, col: 27, offset: 9028},
+				pos: position{line: 392, col: 27, offset: 11952},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 302, col: 27, offset: 9028},
+						pos: position{line: 392, col: 27, offset: 11952},
 						run: (*parser).callonExpressionOrSelectItem2,
 						expr: &seqExpr{
-							pos: position{line: 302, col: 27, offset: 9028},
+							pos: position{line: 392, col: 27, offset: 11952},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 302, col: 27, offset: 9028},
+									pos:   position{line: 392, col: 27, offset: 11952},
 									label: "expression",
 									expr: &ruleRefExpr{
-										pos:  position{line: 302, col: 38, offset: 9039},
-										name: "OrExpression",
+										pos:  position{line: 392, col: 38, offset: 11963},
+										name: "TernaryExpression",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 302, col: 51, offset: 9052},
+									pos:   position{line: 392, col: 56, offset: 11981},
 									label: "asClause",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 302, col: 60, offset: 9061},
+										pos: position{line: 392, col: 65, offset: 11990},
 										expr: &ruleRefExpr{
-											pos:  position{line: 302, col: 60, offset: 9061},
+											pos:  position{line: 392, col: 65, offset: 11990},
 											name: "AsClause",
 										},
 									},
@@ -1009,13 +1112,13 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 323, col: 5, offset: 9676},
+						pos: position{line: 413, col: 5, offset: 12632},
 						run: (*parser).callonExpressionOrSelectItem9,
 						expr: &labeledExpr{
-							pos:   position{line: 323, col: 5, offset: 9676},
+							pos:   position{line: 413, col: 5, offset: 12632},
 							label: "item",
 							expr: &ruleRefExpr{
-								pos:  position{line: 323, col: 10, offset: 9681},
+								pos:  position{line: 413, col: 10, offset: 12637},
 								name: "SelectItemWithAlias",
 							},
 						},
@@ -1025,29 +1128,29 @@ var g = &grammar{
 		},
 		{
 			name: "SelectValueSpec",
-			pos:  position{line: 325, col: 1, offset: 9723},
+			pos:  position{line: 415, col: 1, offset: 12679},
 			expr: &actionExpr{
-				pos: position{line: 325, col: 20, offset: 9742},
+				pos: position{line: 415, col: 20, offset: 12698},
 				run: (*parser).callonSelectValueSpec1,
 				expr: &seqExpr{
-					pos: position{line: 325, col: 20, offset: 9742},
+					pos: position{line: 415, col: 20, offset: 12698},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 325, col: 20, offset: 9742},
+							pos:        position{line: 415, col: 20, offset: 12698},
 							val:        "value",
 							ignoreCase: true,
 							want:       "\"VALUE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 325, col: 29, offset: 9751},
+							pos:  position{line: 415, col: 29, offset: 12707},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 325, col: 32, offset: 9754},
+							pos:   position{line: 415, col: 32, offset: 12710},
 							label: "column",
 							expr: &ruleRefExpr{
-								pos:  position{line: 325, col: 39, offset: 9761},
-								name: "SelectItemWithAlias",
+								pos:  position{line: 415, col: 39, offset: 12717},
+								name: "ExpressionOrSelectItem",
 							},
 						},
 					},
@@ -1056,15 +1159,15 @@ var g = &grammar{
 		},
 		{
 			name: "TableName",
-			pos:  position{line: 331, col: 1, offset: 9927},
+			pos:  position{line: 421, col: 1, offset: 12886},
 			expr: &actionExpr{
-				pos: position{line: 331, col: 14, offset: 9940},
+				pos: position{line: 421, col: 14, offset: 12899},
 				run: (*parser).callonTableName1,
 				expr: &labeledExpr{
-					pos:   position{line: 331, col: 14, offset: 9940},
+					pos:   position{line: 421, col: 14, offset: 12899},
 					label: "key",
 					expr: &ruleRefExpr{
-						pos:  position{line: 331, col: 18, offset: 9944},
+						pos:  position{line: 421, col: 18, offset: 12903},
 						name: "Identifier",
 					},
 				},
@@ -1072,40 +1175,70 @@ var g = &grammar{
 		},
 		{
 			name: "SelectArray",
-			pos:  position{line: 335, col: 1, offset: 10011},
-			expr: &actionExpr{
-				pos: position{line: 335, col: 16, offset: 10026},
-				run: (*parser).callonSelectArray1,
-				expr: &seqExpr{
-					pos: position{line: 335, col: 16, offset: 10026},
-					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 335, col: 16, offset: 10026},
-							val:        "[",
-							ignoreCase: false,
-							want:       "\"[\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 335, col: 20, offset: 10030},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 335, col: 23, offset: 10033},
-							label: "columns",
-							expr: &ruleRefExpr{
-								pos:  position{line: 335, col: 31, offset: 10041},
-								name: "ColumnList",
+			pos:  position{line: 425, col: 1, offset: 12970},
+			expr: &choiceExpr{
+				pos: position{line: 425, col: 16, offset: 12985},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 425, col: 16, offset: 12985},
+						run: (*parser).callonSelectArray2,
+						expr: &seqExpr{
+							pos: position{line: 425, col: 16, offset: 12985},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 425, col: 16, offset: 12985},
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 425, col: 20, offset: 12989},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 425, col: 23, offset: 12992},
+									label: "columns",
+									expr: &ruleRefExpr{
+										pos:  position{line: 425, col: 31, offset: 13000},
+										name: "ColumnList",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 425, col: 42, offset: 13011},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 425, col: 45, offset: 13014},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 335, col: 42, offset: 10052},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 335, col: 45, offset: 10055},
-							val:        "]",
-							ignoreCase: false,
-							want:       "\"]\"",
+					},
+					&actionExpr{
+						pos: position{line: 427, col: 5, offset: 13060},
+						run: (*parser).callonSelectArray10,
+						expr: &seqExpr{
+							pos: position{line: 427, col: 5, offset: 13060},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 427, col: 5, offset: 13060},
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 427, col: 9, offset: 13064},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 427, col: 12, offset: 13067},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
+								},
+							},
 						},
 					},
 				},
@@ -1113,68 +1246,68 @@ var g = &grammar{
 		},
 		{
 			name: "SelectObject",
-			pos:  position{line: 339, col: 1, offset: 10100},
+			pos:  position{line: 434, col: 1, offset: 13213},
 			expr: &choiceExpr{
-				pos: position{line: 339, col: 17, offset: 10116},
+				pos: position{line: 434, col: 17, offset: 13229},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 339, col: 17, offset: 10116},
+						pos: position{line: 434, col: 17, offset: 13229},
 						run: (*parser).callonSelectObject2,
 						expr: &seqExpr{
-							pos: position{line: 339, col: 17, offset: 10116},
+							pos: position{line: 434, col: 17, offset: 13229},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 339, col: 17, offset: 10116},
+									pos:        position{line: 434, col: 17, offset: 13229},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 339, col: 21, offset: 10120},
+									pos:  position{line: 434, col: 21, offset: 13233},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 339, col: 24, offset: 10123},
+									pos:   position{line: 434, col: 24, offset: 13236},
 									label: "field",
 									expr: &ruleRefExpr{
-										pos:  position{line: 339, col: 30, offset: 10129},
+										pos:  position{line: 434, col: 30, offset: 13242},
 										name: "SelectObjectField",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 339, col: 48, offset: 10147},
+									pos:  position{line: 434, col: 48, offset: 13260},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 339, col: 51, offset: 10150},
+									pos:   position{line: 434, col: 51, offset: 13263},
 									label: "other_fields",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 339, col: 64, offset: 10163},
+										pos: position{line: 434, col: 64, offset: 13276},
 										expr: &actionExpr{
-											pos: position{line: 339, col: 65, offset: 10164},
+											pos: position{line: 434, col: 65, offset: 13277},
 											run: (*parser).callonSelectObject11,
 											expr: &seqExpr{
-												pos: position{line: 339, col: 65, offset: 10164},
+												pos: position{line: 434, col: 65, offset: 13277},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 339, col: 65, offset: 10164},
+														pos:  position{line: 434, col: 65, offset: 13277},
 														name: "ws",
 													},
 													&litMatcher{
-														pos:        position{line: 339, col: 68, offset: 10167},
+														pos:        position{line: 434, col: 68, offset: 13280},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 339, col: 72, offset: 10171},
+														pos:  position{line: 434, col: 72, offset: 13284},
 														name: "ws",
 													},
 													&labeledExpr{
-														pos:   position{line: 339, col: 75, offset: 10174},
+														pos:   position{line: 434, col: 75, offset: 13287},
 														label: "coll",
 														expr: &ruleRefExpr{
-															pos:  position{line: 339, col: 80, offset: 10179},
+															pos:  position{line: 434, col: 80, offset: 13292},
 															name: "SelectObjectField",
 														},
 													},
@@ -1184,11 +1317,11 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 339, col: 120, offset: 10219},
+									pos:  position{line: 434, col: 120, offset: 13332},
 									name: "ws",
 								},
 								&litMatcher{
-									pos:        position{line: 339, col: 123, offset: 10222},
+									pos:        position{line: 434, col: 123, offset: 13335},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -1197,23 +1330,23 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 341, col: 5, offset: 10281},
+						pos: position{line: 436, col: 5, offset: 13394},
 						run: (*parser).callonSelectObject20,
 						expr: &seqExpr{
-							pos: position{line: 341, col: 5, offset: 10281},
+							pos: position{line: 436, col: 5, offset: 13394},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 341, col: 5, offset: 10281},
+									pos:        position{line: 436, col: 5, offset: 13394},
 									val:        "{",
 									ignoreCase: false,
 									want:       "\"{\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 341, col: 9, offset: 10285},
+									pos:  position{line: 436, col: 9, offset: 13398},
 									name: "ws",
 								},
 								&litMatcher{
-									pos:        position{line: 341, col: 12, offset: 10288},
+									pos:        position{line: 436, col: 12, offset: 13401},
 									val:        "}",
 									ignoreCase: false,
 									want:       "\"}\"",
@@ -1226,45 +1359,45 @@ var g = &grammar{
 		},
 		{
 			name: "SelectObjectField",
-			pos:  position{line: 348, col: 1, offset: 10435},
+			pos:  position{line: 443, col: 1, offset: 13548},
 			expr: &actionExpr{
-				pos: position{line: 348, col: 22, offset: 10456},
+				pos: position{line: 443, col: 22, offset: 13569},
 				run: (*parser).callonSelectObjectField1,
 				expr: &seqExpr{
-					pos: position{line: 348, col: 22, offset: 10456},
+					pos: position{line: 443, col: 22, offset: 13569},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 348, col: 22, offset: 10456},
+							pos:   position{line: 443, col: 22, offset: 13569},
 							label: "name",
 							expr: &choiceExpr{
-								pos: position{line: 348, col: 28, offset: 10462},
+								pos: position{line: 443, col: 28, offset: 13575},
 								alternatives: []any{
 									&ruleRefExpr{
-										pos:  position{line: 348, col: 28, offset: 10462},
+										pos:  position{line: 443, col: 28, offset: 13575},
 										name: "Identifier",
 									},
 									&actionExpr{
-										pos: position{line: 348, col: 41, offset: 10475},
+										pos: position{line: 443, col: 41, offset: 13588},
 										run: (*parser).callonSelectObjectField6,
 										expr: &seqExpr{
-											pos: position{line: 348, col: 41, offset: 10475},
+											pos: position{line: 443, col: 41, offset: 13588},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 348, col: 41, offset: 10475},
+													pos:        position{line: 443, col: 41, offset: 13588},
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
 												},
 												&labeledExpr{
-													pos:   position{line: 348, col: 46, offset: 10480},
+													pos:   position{line: 443, col: 46, offset: 13593},
 													label: "key",
 													expr: &ruleRefExpr{
-														pos:  position{line: 348, col: 50, offset: 10484},
+														pos:  position{line: 443, col: 50, offset: 13597},
 														name: "Identifier",
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 348, col: 61, offset: 10495},
+													pos:        position{line: 443, col: 61, offset: 13608},
 													val:        "\"",
 													ignoreCase: false,
 													want:       "\"\\\"\"",
@@ -1272,29 +1405,41 @@ var g = &grammar{
 											},
 										},
 									},
+									&actionExpr{
+										pos: position{line: 443, col: 88, offset: 13635},
+										run: (*parser).callonSelectObjectField12,
+										expr: &labeledExpr{
+											pos:   position{line: 443, col: 88, offset: 13635},
+											label: "key",
+											expr: &ruleRefExpr{
+												pos:  position{line: 443, col: 92, offset: 13639},
+												name: "StringLiteral",
+											},
+										},
+									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 348, col: 87, offset: 10521},
+							pos:  position{line: 443, col: 152, offset: 13699},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 348, col: 90, offset: 10524},
+							pos:        position{line: 443, col: 155, offset: 13702},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 348, col: 94, offset: 10528},
+							pos:  position{line: 443, col: 159, offset: 13706},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 348, col: 97, offset: 10531},
+							pos:   position{line: 443, col: 162, offset: 13709},
 							label: "selectItem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 348, col: 108, offset: 10542},
-								name: "SelectItem",
+								pos:  position{line: 443, col: 173, offset: 13720},
+								name: "TernaryExpression",
 							},
 						},
 					},
@@ -1303,35 +1448,35 @@ var g = &grammar{
 		},
 		{
 			name: "SelectProperty",
-			pos:  position{line: 354, col: 1, offset: 10654},
+			pos:  position{line: 449, col: 1, offset: 13844},
 			expr: &actionExpr{
-				pos: position{line: 354, col: 19, offset: 10672},
+				pos: position{line: 449, col: 19, offset: 13862},
 				run: (*parser).callonSelectProperty1,
 				expr: &seqExpr{
-					pos: position{line: 354, col: 19, offset: 10672},
+					pos: position{line: 449, col: 19, offset: 13862},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 354, col: 19, offset: 10672},
+							pos:   position{line: 449, col: 19, offset: 13862},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 354, col: 24, offset: 10677},
+								pos:  position{line: 449, col: 24, offset: 13867},
 								name: "Identifier",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 354, col: 35, offset: 10688},
+							pos:   position{line: 449, col: 35, offset: 13878},
 							label: "path",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 354, col: 40, offset: 10693},
+								pos: position{line: 449, col: 40, offset: 13883},
 								expr: &choiceExpr{
-									pos: position{line: 354, col: 41, offset: 10694},
+									pos: position{line: 449, col: 41, offset: 13884},
 									alternatives: []any{
 										&ruleRefExpr{
-											pos:  position{line: 354, col: 41, offset: 10694},
+											pos:  position{line: 449, col: 41, offset: 13884},
 											name: "DotFieldAccess",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 354, col: 58, offset: 10711},
+											pos:  position{line: 449, col: 58, offset: 13901},
 											name: "ArrayFieldAccess",
 										},
 									},
@@ -1344,28 +1489,28 @@ var g = &grammar{
 		},
 		{
 			name: "SelectItemWithAlias",
-			pos:  position{line: 358, col: 1, offset: 10802},
+			pos:  position{line: 453, col: 1, offset: 13992},
 			expr: &actionExpr{
-				pos: position{line: 358, col: 24, offset: 10825},
+				pos: position{line: 453, col: 24, offset: 14015},
 				run: (*parser).callonSelectItemWithAlias1,
 				expr: &seqExpr{
-					pos: position{line: 358, col: 24, offset: 10825},
+					pos: position{line: 453, col: 24, offset: 14015},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 358, col: 24, offset: 10825},
+							pos:   position{line: 453, col: 24, offset: 14015},
 							label: "selectItem",
 							expr: &ruleRefExpr{
-								pos:  position{line: 358, col: 35, offset: 10836},
+								pos:  position{line: 453, col: 35, offset: 14026},
 								name: "SelectItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 358, col: 46, offset: 10847},
+							pos:   position{line: 453, col: 46, offset: 14037},
 							label: "asClause",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 358, col: 55, offset: 10856},
+								pos: position{line: 453, col: 55, offset: 14046},
 								expr: &ruleRefExpr{
-									pos:  position{line: 358, col: 55, offset: 10856},
+									pos:  position{line: 453, col: 55, offset: 14046},
 									name: "AsClause",
 								},
 							},
@@ -1376,38 +1521,38 @@ var g = &grammar{
 		},
 		{
 			name: "SelectItem",
-			pos:  position{line: 366, col: 1, offset: 11023},
+			pos:  position{line: 461, col: 1, offset: 14213},
 			expr: &actionExpr{
-				pos: position{line: 366, col: 15, offset: 11037},
+				pos: position{line: 461, col: 15, offset: 14227},
 				run: (*parser).callonSelectItem1,
 				expr: &labeledExpr{
-					pos:   position{line: 366, col: 15, offset: 11037},
+					pos:   position{line: 461, col: 15, offset: 14227},
 					label: "selectItem",
 					expr: &choiceExpr{
-						pos: position{line: 366, col: 27, offset: 11049},
+						pos: position{line: 461, col: 27, offset: 14239},
 						alternatives: []any{
 							&ruleRefExpr{
-								pos:  position{line: 366, col: 27, offset: 11049},
+								pos:  position{line: 461, col: 27, offset: 14239},
 								name: "SubQuerySelectItem",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 366, col: 48, offset: 11070},
+								pos:  position{line: 461, col: 48, offset: 14260},
 								name: "Literal",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 366, col: 58, offset: 11080},
+								pos:  position{line: 461, col: 58, offset: 14270},
 								name: "FunctionCall",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 366, col: 73, offset: 11095},
+								pos:  position{line: 461, col: 73, offset: 14285},
 								name: "SelectArray",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 366, col: 87, offset: 11109},
+								pos:  position{line: 461, col: 87, offset: 14299},
 								name: "SelectObject",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 366, col: 102, offset: 11124},
+								pos:  position{line: 461, col: 102, offset: 14314},
 								name: "SelectProperty",
 							},
 						},
@@ -1417,45 +1562,45 @@ var g = &grammar{
 		},
 		{
 			name: "AsClause",
-			pos:  position{line: 386, col: 1, offset: 11649},
+			pos:  position{line: 481, col: 1, offset: 14839},
 			expr: &actionExpr{
-				pos: position{line: 386, col: 13, offset: 11661},
+				pos: position{line: 481, col: 13, offset: 14851},
 				run: (*parser).callonAsClause1,
 				expr: &seqExpr{
-					pos: position{line: 386, col: 13, offset: 11661},
+					pos: position{line: 481, col: 13, offset: 14851},
 					exprs: []any{
 						&zeroOrOneExpr{
-							pos: position{line: 386, col: 13, offset: 11661},
+							pos: position{line: 481, col: 13, offset: 14851},
 							expr: &seqExpr{
-								pos: position{line: 386, col: 14, offset: 11662},
+								pos: position{line: 481, col: 14, offset: 14852},
 								exprs: []any{
 									&ruleRefExpr{
-										pos:  position{line: 386, col: 14, offset: 11662},
+										pos:  position{line: 481, col: 14, offset: 14852},
 										name: "ws",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 386, col: 17, offset: 11665},
+										pos:  position{line: 481, col: 17, offset: 14855},
 										name: "As",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 386, col: 22, offset: 11670},
+							pos:  position{line: 481, col: 22, offset: 14860},
 							name: "ws",
 						},
 						&notExpr{
-							pos: position{line: 386, col: 25, offset: 11673},
+							pos: position{line: 481, col: 25, offset: 14863},
 							expr: &ruleRefExpr{
-								pos:  position{line: 386, col: 26, offset: 11674},
+								pos:  position{line: 481, col: 26, offset: 14864},
 								name: "ExcludedKeywords",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 386, col: 43, offset: 11691},
+							pos:   position{line: 481, col: 43, offset: 14881},
 							label: "alias",
 							expr: &ruleRefExpr{
-								pos:  position{line: 386, col: 49, offset: 11697},
+								pos:  position{line: 481, col: 49, offset: 14887},
 								name: "Identifier",
 							},
 						},
@@ -1465,89 +1610,117 @@ var g = &grammar{
 		},
 		{
 			name: "ExcludedKeywords",
-			pos:  position{line: 390, col: 1, offset: 11735},
-			expr: &choiceExpr{
-				pos: position{line: 390, col: 21, offset: 11755},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 21, offset: 11755},
-						name: "Select",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 30, offset: 11764},
-						name: "Top",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 36, offset: 11770},
-						name: "As",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 41, offset: 11775},
-						name: "From",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 48, offset: 11782},
-						name: "In",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 53, offset: 11787},
-						name: "Join",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 60, offset: 11794},
-						name: "Exists",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 69, offset: 11803},
-						name: "Where",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 77, offset: 11811},
-						name: "And",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 83, offset: 11817},
-						name: "Or",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 88, offset: 11822},
-						name: "Not",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 94, offset: 11828},
-						name: "GroupBy",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 104, offset: 11838},
-						name: "OrderBy",
+			pos:  position{line: 485, col: 1, offset: 14925},
+			expr: &seqExpr{
+				pos: position{line: 485, col: 21, offset: 14945},
+				exprs: []any{
+					&choiceExpr{
+						pos: position{line: 485, col: 22, offset: 14946},
+						alternatives: []any{
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 22, offset: 14946},
+								name: "Select",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 31, offset: 14955},
+								name: "Top",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 37, offset: 14961},
+								name: "As",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 42, offset: 14966},
+								name: "From",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 49, offset: 14973},
+								name: "In",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 54, offset: 14978},
+								name: "Join",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 61, offset: 14985},
+								name: "Exists",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 70, offset: 14994},
+								name: "Where",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 78, offset: 15002},
+								name: "And",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 84, offset: 15008},
+								name: "Or",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 89, offset: 15013},
+								name: "Not",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 95, offset: 15019},
+								name: "GroupBy",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 105, offset: 15029},
+								name: "OrderBy",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 115, offset: 15039},
+								name: "Offset",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 124, offset: 15048},
+								name: "Like",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 131, offset: 15055},
+								name: "Escape",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 485, col: 140, offset: 15064},
+								name: "Between",
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 390, col: 114, offset: 11848},
-						name: "Offset",
+					&notExpr{
+						pos: position{line: 485, col: 149, offset: 15073},
+						expr: &charClassMatcher{
+							pos:        position{line: 485, col: 150, offset: 15074},
+							val:        "[a-zA-Z0-9_]",
+							chars:      []rune{'_'},
+							ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
+							ignoreCase: false,
+							inverted:   false,
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "DotFieldAccess",
-			pos:  position{line: 392, col: 1, offset: 11856},
+			pos:  position{line: 487, col: 1, offset: 15088},
 			expr: &actionExpr{
-				pos: position{line: 392, col: 19, offset: 11874},
+				pos: position{line: 487, col: 19, offset: 15106},
 				run: (*parser).callonDotFieldAccess1,
 				expr: &seqExpr{
-					pos: position{line: 392, col: 19, offset: 11874},
+					pos: position{line: 487, col: 19, offset: 15106},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 392, col: 19, offset: 11874},
+							pos:        position{line: 487, col: 19, offset: 15106},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 392, col: 23, offset: 11878},
+							pos:   position{line: 487, col: 23, offset: 15110},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 392, col: 26, offset: 11881},
+								pos:  position{line: 487, col: 26, offset: 15113},
 								name: "Identifier",
 							},
 						},
@@ -1557,32 +1730,32 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayFieldAccess",
-			pos:  position{line: 396, col: 1, offset: 11916},
+			pos:  position{line: 491, col: 1, offset: 15148},
 			expr: &choiceExpr{
-				pos: position{line: 396, col: 21, offset: 11936},
+				pos: position{line: 491, col: 21, offset: 15168},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 396, col: 21, offset: 11936},
+						pos: position{line: 491, col: 21, offset: 15168},
 						run: (*parser).callonArrayFieldAccess2,
 						expr: &seqExpr{
-							pos: position{line: 396, col: 21, offset: 11936},
+							pos: position{line: 491, col: 21, offset: 15168},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 396, col: 21, offset: 11936},
+									pos:        position{line: 491, col: 21, offset: 15168},
 									val:        "[\"",
 									ignoreCase: false,
 									want:       "\"[\\\"\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 396, col: 27, offset: 11942},
+									pos:   position{line: 491, col: 27, offset: 15174},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 396, col: 30, offset: 11945},
+										pos:  position{line: 491, col: 30, offset: 15177},
 										name: "Identifier",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 396, col: 41, offset: 11956},
+									pos:        position{line: 491, col: 41, offset: 15188},
 									val:        "\"]",
 									ignoreCase: false,
 									want:       "\"\\\"]\"",
@@ -1591,27 +1764,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 397, col: 5, offset: 11985},
+						pos: position{line: 492, col: 5, offset: 15217},
 						run: (*parser).callonArrayFieldAccess8,
 						expr: &seqExpr{
-							pos: position{line: 397, col: 5, offset: 11985},
+							pos: position{line: 492, col: 5, offset: 15217},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 397, col: 5, offset: 11985},
+									pos:        position{line: 492, col: 5, offset: 15217},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 397, col: 9, offset: 11989},
+									pos:   position{line: 492, col: 9, offset: 15221},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 397, col: 12, offset: 11992},
+										pos:  position{line: 492, col: 12, offset: 15224},
 										name: "Integer",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 397, col: 20, offset: 12000},
+									pos:        position{line: 492, col: 20, offset: 15232},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -1620,27 +1793,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 398, col: 5, offset: 12047},
+						pos: position{line: 493, col: 5, offset: 15279},
 						run: (*parser).callonArrayFieldAccess14,
 						expr: &seqExpr{
-							pos: position{line: 398, col: 5, offset: 12047},
+							pos: position{line: 493, col: 5, offset: 15279},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 398, col: 5, offset: 12047},
+									pos:        position{line: 493, col: 5, offset: 15279},
 									val:        "[",
 									ignoreCase: false,
 									want:       "\"[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 398, col: 9, offset: 12051},
+									pos:   position{line: 493, col: 9, offset: 15283},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 398, col: 12, offset: 12054},
+										pos:  position{line: 493, col: 12, offset: 15286},
 										name: "ParameterConstant",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 398, col: 30, offset: 12072},
+									pos:        position{line: 493, col: 30, offset: 15304},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -1653,15 +1826,15 @@ var g = &grammar{
 		},
 		{
 			name: "Identifier",
-			pos:  position{line: 400, col: 1, offset: 12130},
+			pos:  position{line: 495, col: 1, offset: 15362},
 			expr: &actionExpr{
-				pos: position{line: 400, col: 15, offset: 12144},
+				pos: position{line: 495, col: 15, offset: 15376},
 				run: (*parser).callonIdentifier1,
 				expr: &seqExpr{
-					pos: position{line: 400, col: 15, offset: 12144},
+					pos: position{line: 495, col: 15, offset: 15376},
 					exprs: []any{
 						&charClassMatcher{
-							pos:        position{line: 400, col: 15, offset: 12144},
+							pos:        position{line: 495, col: 15, offset: 15376},
 							val:        "[a-zA-Z_]",
 							chars:      []rune{'_'},
 							ranges:     []rune{'a', 'z', 'A', 'Z'},
@@ -1669,9 +1842,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 400, col: 24, offset: 12153},
+							pos: position{line: 495, col: 24, offset: 15385},
 							expr: &charClassMatcher{
-								pos:        position{line: 400, col: 24, offset: 12153},
+								pos:        position{line: 495, col: 24, offset: 15385},
 								val:        "[a-zA-Z0-9_]",
 								chars:      []rune{'_'},
 								ranges:     []rune{'a', 'z', 'A', 'Z', '0', '9'},
@@ -1685,66 +1858,99 @@ var g = &grammar{
 		},
 		{
 			name: "Condition",
-			pos:  position{line: 404, col: 1, offset: 12203},
+			pos:  position{line: 499, col: 1, offset: 15435},
 			expr: &actionExpr{
-				pos: position{line: 404, col: 14, offset: 12216},
+				pos: position{line: 499, col: 14, offset: 15448},
 				run: (*parser).callonCondition1,
 				expr: &labeledExpr{
-					pos:   position{line: 404, col: 14, offset: 12216},
+					pos:   position{line: 499, col: 14, offset: 15448},
 					label: "expression",
 					expr: &ruleRefExpr{
-						pos:  position{line: 404, col: 25, offset: 12227},
-						name: "OrExpression",
+						pos:  position{line: 499, col: 25, offset: 15459},
+						name: "TernaryExpression",
 					},
 				},
 			},
 		},
 		{
-			name: "OrExpression",
-			pos:  position{line: 408, col: 1, offset: 12272},
+			name: "TernaryExpression",
+			pos:  position{line: 503, col: 1, offset: 15509},
 			expr: &actionExpr{
-				pos: position{line: 408, col: 17, offset: 12288},
-				run: (*parser).callonOrExpression1,
+				pos: position{line: 503, col: 22, offset: 15530},
+				run: (*parser).callonTernaryExpression1,
 				expr: &seqExpr{
-					pos: position{line: 408, col: 17, offset: 12288},
+					pos: position{line: 503, col: 22, offset: 15530},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 408, col: 17, offset: 12288},
-							label: "ex1",
+							pos:   position{line: 503, col: 22, offset: 15530},
+							label: "condition",
 							expr: &ruleRefExpr{
-								pos:  position{line: 408, col: 21, offset: 12292},
-								name: "AndExpression",
+								pos:  position{line: 503, col: 32, offset: 15540},
+								name: "CoalesceExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 408, col: 35, offset: 12306},
-							label: "ex2",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 408, col: 39, offset: 12310},
+							pos:   position{line: 503, col: 51, offset: 15559},
+							label: "branches",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 503, col: 60, offset: 15568},
 								expr: &actionExpr{
-									pos: position{line: 408, col: 40, offset: 12311},
-									run: (*parser).callonOrExpression7,
+									pos: position{line: 503, col: 61, offset: 15569},
+									run: (*parser).callonTernaryExpression7,
 									expr: &seqExpr{
-										pos: position{line: 408, col: 40, offset: 12311},
+										pos: position{line: 503, col: 61, offset: 15569},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 408, col: 40, offset: 12311},
+												pos:  position{line: 503, col: 61, offset: 15569},
 												name: "ws",
 											},
+											&litMatcher{
+												pos:        position{line: 503, col: 64, offset: 15572},
+												val:        "?",
+												ignoreCase: false,
+												want:       "\"?\"",
+											},
+											&notExpr{
+												pos: position{line: 503, col: 68, offset: 15576},
+												expr: &litMatcher{
+													pos:        position{line: 503, col: 69, offset: 15577},
+													val:        "?",
+													ignoreCase: false,
+													want:       "\"?\"",
+												},
+											},
 											&ruleRefExpr{
-												pos:  position{line: 408, col: 43, offset: 12314},
-												name: "Or",
+												pos:  position{line: 503, col: 73, offset: 15581},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 503, col: 76, offset: 15584},
+												label: "trueValue",
+												expr: &ruleRefExpr{
+													pos:  position{line: 503, col: 86, offset: 15594},
+													name: "TernaryExpression",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 503, col: 104, offset: 15612},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 503, col: 107, offset: 15615},
+												val:        ":",
+												ignoreCase: false,
+												want:       "\":\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 408, col: 46, offset: 12317},
+												pos:  position{line: 503, col: 111, offset: 15619},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 408, col: 49, offset: 12320},
-												label: "ex",
+												pos:   position{line: 503, col: 114, offset: 15622},
+												label: "falseValue",
 												expr: &ruleRefExpr{
-													pos:  position{line: 408, col: 52, offset: 12323},
-													name: "AndExpression",
+													pos:  position{line: 503, col: 125, offset: 15633},
+													name: "TernaryExpression",
 												},
 											},
 										},
@@ -1757,51 +1963,55 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "AndExpression",
-			pos:  position{line: 412, col: 1, offset: 12436},
+			name: "CoalesceExpression",
+			pos:  position{line: 507, col: 1, offset: 15765},
 			expr: &actionExpr{
-				pos: position{line: 412, col: 18, offset: 12453},
-				run: (*parser).callonAndExpression1,
+				pos: position{line: 507, col: 23, offset: 15787},
+				run: (*parser).callonCoalesceExpression1,
 				expr: &seqExpr{
-					pos: position{line: 412, col: 18, offset: 12453},
+					pos: position{line: 507, col: 23, offset: 15787},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 412, col: 18, offset: 12453},
-							label: "ex1",
+							pos:   position{line: 507, col: 23, offset: 15787},
+							label: "left",
 							expr: &ruleRefExpr{
-								pos:  position{line: 412, col: 22, offset: 12457},
-								name: "ComparisonExpression",
+								pos:  position{line: 507, col: 28, offset: 15792},
+								name: "OrExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 412, col: 43, offset: 12478},
-							label: "ex2",
+							pos:   position{line: 507, col: 41, offset: 15805},
+							label: "operations",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 412, col: 47, offset: 12482},
+								pos: position{line: 507, col: 52, offset: 15816},
 								expr: &actionExpr{
-									pos: position{line: 412, col: 48, offset: 12483},
-									run: (*parser).callonAndExpression7,
+									pos: position{line: 507, col: 53, offset: 15817},
+									run: (*parser).callonCoalesceExpression7,
 									expr: &seqExpr{
-										pos: position{line: 412, col: 48, offset: 12483},
+										pos: position{line: 507, col: 53, offset: 15817},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 412, col: 48, offset: 12483},
+												pos:  position{line: 507, col: 53, offset: 15817},
 												name: "ws",
 											},
-											&ruleRefExpr{
-												pos:  position{line: 412, col: 51, offset: 12486},
-												name: "And",
+											&labeledExpr{
+												pos:   position{line: 507, col: 56, offset: 15820},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 507, col: 59, offset: 15823},
+													name: "CoalesceOperation",
+												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 412, col: 55, offset: 12490},
+												pos:  position{line: 507, col: 77, offset: 15841},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 412, col: 58, offset: 12493},
-												label: "ex",
+												pos:   position{line: 507, col: 80, offset: 15844},
+												label: "right",
 												expr: &ruleRefExpr{
-													pos:  position{line: 412, col: 61, offset: 12496},
-													name: "ComparisonExpression",
+													pos:  position{line: 507, col: 86, offset: 15850},
+													name: "OrExpression",
 												},
 											},
 										},
@@ -1814,117 +2024,51 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ComparisonExpression",
-			pos:  position{line: 416, col: 1, offset: 12617},
-			expr: &choiceExpr{
-				pos: position{line: 416, col: 25, offset: 12641},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 416, col: 25, offset: 12641},
-						run: (*parser).callonComparisonExpression2,
-						expr: &seqExpr{
-							pos: position{line: 416, col: 25, offset: 12641},
-							exprs: []any{
-								&labeledExpr{
-									pos:   position{line: 416, col: 25, offset: 12641},
-									label: "left",
-									expr: &ruleRefExpr{
-										pos:  position{line: 416, col: 30, offset: 12646},
-										name: "AddSubExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 416, col: 47, offset: 12663},
-									name: "ws",
-								},
-								&labeledExpr{
-									pos:   position{line: 416, col: 50, offset: 12666},
-									label: "op",
-									expr: &ruleRefExpr{
-										pos:  position{line: 416, col: 53, offset: 12669},
-										name: "ComparisonOperator",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 416, col: 72, offset: 12688},
-									name: "ws",
-								},
-								&labeledExpr{
-									pos:   position{line: 416, col: 75, offset: 12691},
-									label: "right",
-									expr: &ruleRefExpr{
-										pos:  position{line: 416, col: 81, offset: 12697},
-										name: "AddSubExpression",
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 418, col: 5, offset: 12810},
-						run: (*parser).callonComparisonExpression12,
-						expr: &labeledExpr{
-							pos:   position{line: 418, col: 5, offset: 12810},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 418, col: 8, offset: 12813},
-								name: "AddSubExpression",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "AddSubExpression",
-			pos:  position{line: 420, col: 1, offset: 12850},
+			name: "OrExpression",
+			pos:  position{line: 514, col: 1, offset: 16109},
 			expr: &actionExpr{
-				pos: position{line: 420, col: 21, offset: 12870},
-				run: (*parser).callonAddSubExpression1,
+				pos: position{line: 514, col: 17, offset: 16125},
+				run: (*parser).callonOrExpression1,
 				expr: &seqExpr{
-					pos: position{line: 420, col: 21, offset: 12870},
+					pos: position{line: 514, col: 17, offset: 16125},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 420, col: 21, offset: 12870},
-							label: "left",
+							pos:   position{line: 514, col: 17, offset: 16125},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 420, col: 26, offset: 12875},
-								name: "MulDivExpression",
+								pos:  position{line: 514, col: 21, offset: 16129},
+								name: "AndExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 420, col: 43, offset: 12892},
-							label: "operations",
+							pos:   position{line: 514, col: 35, offset: 16143},
+							label: "ex2",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 420, col: 54, offset: 12903},
+								pos: position{line: 514, col: 39, offset: 16147},
 								expr: &actionExpr{
-									pos: position{line: 420, col: 55, offset: 12904},
-									run: (*parser).callonAddSubExpression7,
+									pos: position{line: 514, col: 40, offset: 16148},
+									run: (*parser).callonOrExpression7,
 									expr: &seqExpr{
-										pos: position{line: 420, col: 55, offset: 12904},
+										pos: position{line: 514, col: 40, offset: 16148},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 420, col: 55, offset: 12904},
+												pos:  position{line: 514, col: 40, offset: 16148},
 												name: "ws",
 											},
-											&labeledExpr{
-												pos:   position{line: 420, col: 58, offset: 12907},
-												label: "op",
-												expr: &ruleRefExpr{
-													pos:  position{line: 420, col: 61, offset: 12910},
-													name: "AddOrSubtractOperation",
-												},
+											&ruleRefExpr{
+												pos:  position{line: 514, col: 43, offset: 16151},
+												name: "Or",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 420, col: 84, offset: 12933},
+												pos:  position{line: 514, col: 46, offset: 16154},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 420, col: 87, offset: 12936},
-												label: "right",
+												pos:   position{line: 514, col: 49, offset: 16157},
+												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 420, col: 93, offset: 12942},
-													name: "MulDivExpression",
+													pos:  position{line: 514, col: 52, offset: 16160},
+													name: "AndExpression",
 												},
 											},
 										},
@@ -1937,55 +2081,51 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MulDivExpression",
-			pos:  position{line: 424, col: 1, offset: 13055},
+			name: "AndExpression",
+			pos:  position{line: 518, col: 1, offset: 16273},
 			expr: &actionExpr{
-				pos: position{line: 424, col: 21, offset: 13075},
-				run: (*parser).callonMulDivExpression1,
+				pos: position{line: 518, col: 18, offset: 16290},
+				run: (*parser).callonAndExpression1,
 				expr: &seqExpr{
-					pos: position{line: 424, col: 21, offset: 13075},
+					pos: position{line: 518, col: 18, offset: 16290},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 424, col: 21, offset: 13075},
-							label: "left",
+							pos:   position{line: 518, col: 18, offset: 16290},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 424, col: 26, offset: 13080},
-								name: "SelectItemWithParentheses",
+								pos:  position{line: 518, col: 22, offset: 16294},
+								name: "ComparisonExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 424, col: 52, offset: 13106},
-							label: "operations",
+							pos:   position{line: 518, col: 43, offset: 16315},
+							label: "ex2",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 424, col: 63, offset: 13117},
+								pos: position{line: 518, col: 47, offset: 16319},
 								expr: &actionExpr{
-									pos: position{line: 424, col: 64, offset: 13118},
-									run: (*parser).callonMulDivExpression7,
+									pos: position{line: 518, col: 48, offset: 16320},
+									run: (*parser).callonAndExpression7,
 									expr: &seqExpr{
-										pos: position{line: 424, col: 64, offset: 13118},
+										pos: position{line: 518, col: 48, offset: 16320},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 424, col: 64, offset: 13118},
+												pos:  position{line: 518, col: 48, offset: 16320},
 												name: "ws",
 											},
-											&labeledExpr{
-												pos:   position{line: 424, col: 67, offset: 13121},
-												label: "op",
-												expr: &ruleRefExpr{
-													pos:  position{line: 424, col: 70, offset: 13124},
-													name: "MultiplyOrDivideOperation",
-												},
+											&ruleRefExpr{
+												pos:  position{line: 518, col: 51, offset: 16323},
+												name: "And",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 424, col: 96, offset: 13150},
+												pos:  position{line: 518, col: 55, offset: 16327},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 424, col: 99, offset: 13153},
-												label: "right",
+												pos:   position{line: 518, col: 58, offset: 16330},
+												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 424, col: 105, offset: 13159},
-													name: "SelectItemWithParentheses",
+													pos:  position{line: 518, col: 61, offset: 16333},
+													name: "ComparisonExpression",
 												},
 											},
 										},
@@ -1998,114 +2138,81 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SelectItemWithParentheses",
-			pos:  position{line: 428, col: 1, offset: 13281},
-			expr: &choiceExpr{
-				pos: position{line: 428, col: 30, offset: 13310},
-				alternatives: []any{
-					&actionExpr{
-						pos: position{line: 428, col: 30, offset: 13310},
-						run: (*parser).callonSelectItemWithParentheses2,
-						expr: &seqExpr{
-							pos: position{line: 428, col: 30, offset: 13310},
-							exprs: []any{
-								&labeledExpr{
-									pos:   position{line: 428, col: 30, offset: 13310},
-									label: "inv",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 428, col: 34, offset: 13314},
-										expr: &seqExpr{
-											pos: position{line: 428, col: 35, offset: 13315},
-											exprs: []any{
-												&ruleRefExpr{
-													pos:  position{line: 428, col: 35, offset: 13315},
-													name: "Not",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 428, col: 39, offset: 13319},
-													name: "ws",
-												},
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 428, col: 44, offset: 13324},
-									val:        "(",
-									ignoreCase: false,
-									want:       "\"(\"",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 428, col: 48, offset: 13328},
-									name: "ws",
-								},
-								&labeledExpr{
-									pos:   position{line: 428, col: 51, offset: 13331},
-									label: "ex",
-									expr: &ruleRefExpr{
-										pos:  position{line: 428, col: 54, offset: 13334},
-										name: "OrExpression",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 428, col: 67, offset: 13347},
-									name: "ws",
-								},
-								&litMatcher{
-									pos:        position{line: 428, col: 70, offset: 13350},
-									val:        ")",
-									ignoreCase: false,
-									want:       "\")\"",
-								},
+			name: "ComparisonExpression",
+			pos:  position{line: 522, col: 1, offset: 16454},
+			expr: &actionExpr{
+				pos: position{line: 522, col: 25, offset: 16478},
+				run: (*parser).callonComparisonExpression1,
+				expr: &seqExpr{
+					pos: position{line: 522, col: 25, offset: 16478},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 522, col: 25, offset: 16478},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 522, col: 30, offset: 16483},
+								name: "BitwiseOrExpression",
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 437, col: 7, offset: 13529},
-						run: (*parser).callonSelectItemWithParentheses15,
-						expr: &seqExpr{
-							pos: position{line: 437, col: 7, offset: 13529},
-							exprs: []any{
-								&labeledExpr{
-									pos:   position{line: 437, col: 7, offset: 13529},
-									label: "inv",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 437, col: 11, offset: 13533},
-										expr: &seqExpr{
-											pos: position{line: 437, col: 12, offset: 13534},
-											exprs: []any{
-												&ruleRefExpr{
-													pos:  position{line: 437, col: 12, offset: 13534},
-													name: "Not",
-												},
-												&ruleRefExpr{
-													pos:  position{line: 437, col: 16, offset: 13538},
-													name: "ws",
-												},
-											},
+						&labeledExpr{
+							pos:   position{line: 522, col: 50, offset: 16503},
+							label: "comparison",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 522, col: 61, offset: 16514},
+								expr: &choiceExpr{
+									pos: position{line: 522, col: 62, offset: 16515},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 522, col: 62, offset: 16515},
+											name: "ComparisonOperatorTail",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 522, col: 87, offset: 16540},
+											name: "LikeTail",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 522, col: 98, offset: 16551},
+											name: "BetweenTail",
 										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 437, col: 21, offset: 13543},
-									label: "ex",
-									expr: &ruleRefExpr{
-										pos:  position{line: 437, col: 24, offset: 13546},
-										name: "SelectItem",
 									},
 								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 444, col: 5, offset: 13697},
-						run: (*parser).callonSelectItemWithParentheses24,
-						expr: &labeledExpr{
-							pos:   position{line: 444, col: 5, offset: 13697},
-							label: "ex",
+				},
+			},
+		},
+		{
+			name: "ComparisonOperatorTail",
+			pos:  position{line: 526, col: 1, offset: 16624},
+			expr: &actionExpr{
+				pos: position{line: 526, col: 27, offset: 16650},
+				run: (*parser).callonComparisonOperatorTail1,
+				expr: &seqExpr{
+					pos: position{line: 526, col: 27, offset: 16650},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 526, col: 27, offset: 16650},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 526, col: 30, offset: 16653},
+							label: "op",
 							expr: &ruleRefExpr{
-								pos:  position{line: 444, col: 8, offset: 13700},
-								name: "BooleanLiteral",
+								pos:  position{line: 526, col: 33, offset: 16656},
+								name: "ComparisonOperator",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 526, col: 52, offset: 16675},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 526, col: 55, offset: 16678},
+							label: "right",
+							expr: &ruleRefExpr{
+								pos:  position{line: 526, col: 61, offset: 16684},
+								name: "BitwiseOrExpression",
 							},
 						},
 					},
@@ -2113,61 +2220,83 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "OrderByClause",
-			pos:  position{line: 446, col: 1, offset: 13735},
+			name: "LikeTail",
+			pos:  position{line: 530, col: 1, offset: 16789},
 			expr: &actionExpr{
-				pos: position{line: 446, col: 18, offset: 13752},
-				run: (*parser).callonOrderByClause1,
+				pos: position{line: 530, col: 13, offset: 16801},
+				run: (*parser).callonLikeTail1,
 				expr: &seqExpr{
-					pos: position{line: 446, col: 18, offset: 13752},
+					pos: position{line: 530, col: 13, offset: 16801},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 446, col: 18, offset: 13752},
-							name: "OrderBy",
+							pos:  position{line: 530, col: 13, offset: 16801},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 530, col: 16, offset: 16804},
+							label: "inv",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 530, col: 20, offset: 16808},
+								expr: &seqExpr{
+									pos: position{line: 530, col: 21, offset: 16809},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 530, col: 21, offset: 16809},
+											name: "Not",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 530, col: 25, offset: 16813},
+											name: "ws",
+										},
+									},
+								},
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 446, col: 26, offset: 13760},
+							pos:  position{line: 530, col: 30, offset: 16818},
+							name: "Like",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 530, col: 35, offset: 16823},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 446, col: 29, offset: 13763},
-							label: "ex1",
+							pos:   position{line: 530, col: 38, offset: 16826},
+							label: "right",
 							expr: &ruleRefExpr{
-								pos:  position{line: 446, col: 33, offset: 13767},
-								name: "OrderExpression",
+								pos:  position{line: 530, col: 44, offset: 16832},
+								name: "BitwiseOrExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 446, col: 49, offset: 13783},
-							label: "others",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 446, col: 56, offset: 13790},
+							pos:   position{line: 530, col: 64, offset: 16852},
+							label: "escape",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 530, col: 71, offset: 16859},
 								expr: &actionExpr{
-									pos: position{line: 446, col: 57, offset: 13791},
-									run: (*parser).callonOrderByClause9,
+									pos: position{line: 530, col: 72, offset: 16860},
+									run: (*parser).callonLikeTail15,
 									expr: &seqExpr{
-										pos: position{line: 446, col: 57, offset: 13791},
+										pos: position{line: 530, col: 72, offset: 16860},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 446, col: 57, offset: 13791},
+												pos:  position{line: 530, col: 72, offset: 16860},
 												name: "ws",
 											},
-											&litMatcher{
-												pos:        position{line: 446, col: 60, offset: 13794},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
+											&ruleRefExpr{
+												pos:  position{line: 530, col: 75, offset: 16863},
+												name: "Escape",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 446, col: 64, offset: 13798},
+												pos:  position{line: 530, col: 82, offset: 16870},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 446, col: 67, offset: 13801},
+												pos:   position{line: 530, col: 85, offset: 16873},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 446, col: 70, offset: 13804},
-													name: "OrderExpression",
+													pos:  position{line: 530, col: 88, offset: 16876},
+													name: "StringLiteral",
 												},
 											},
 										},
@@ -2180,35 +2309,72 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "OrderExpression",
-			pos:  position{line: 450, col: 1, offset: 13888},
+			name: "BetweenTail",
+			pos:  position{line: 534, col: 1, offset: 16973},
 			expr: &actionExpr{
-				pos: position{line: 450, col: 20, offset: 13907},
-				run: (*parser).callonOrderExpression1,
+				pos: position{line: 534, col: 16, offset: 16988},
+				run: (*parser).callonBetweenTail1,
 				expr: &seqExpr{
-					pos: position{line: 450, col: 20, offset: 13907},
+					pos: position{line: 534, col: 16, offset: 16988},
 					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 534, col: 16, offset: 16988},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 450, col: 20, offset: 13907},
-							label: "field",
+							pos:   position{line: 534, col: 19, offset: 16991},
+							label: "inv",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 534, col: 23, offset: 16995},
+								expr: &seqExpr{
+									pos: position{line: 534, col: 24, offset: 16996},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 534, col: 24, offset: 16996},
+											name: "Not",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 534, col: 28, offset: 17000},
+											name: "ws",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 534, col: 33, offset: 17005},
+							name: "Between",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 534, col: 41, offset: 17013},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 534, col: 44, offset: 17016},
+							label: "low",
 							expr: &ruleRefExpr{
-								pos:  position{line: 450, col: 26, offset: 13913},
-								name: "SelectProperty",
+								pos:  position{line: 534, col: 48, offset: 17020},
+								name: "BitwiseOrExpression",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 450, col: 41, offset: 13928},
+							pos:  position{line: 534, col: 68, offset: 17040},
+							name: "ws",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 534, col: 71, offset: 17043},
+							name: "And",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 534, col: 75, offset: 17047},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 450, col: 44, offset: 13931},
-							label: "order",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 450, col: 50, offset: 13937},
-								expr: &ruleRefExpr{
-									pos:  position{line: 450, col: 50, offset: 13937},
-									name: "OrderDirection",
-								},
+							pos:   position{line: 534, col: 78, offset: 17050},
+							label: "high",
+							expr: &ruleRefExpr{
+								pos:  position{line: 534, col: 83, offset: 17055},
+								name: "BitwiseOrExpression",
 							},
 						},
 					},
@@ -2216,919 +2382,3837 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "OrderDirection",
-			pos:  position{line: 454, col: 1, offset: 14003},
+			name: "BetweenExpression",
+			pos:  position{line: 538, col: 1, offset: 17164},
 			expr: &actionExpr{
-				pos: position{line: 454, col: 19, offset: 14021},
-				run: (*parser).callonOrderDirection1,
-				expr: &choiceExpr{
-					pos: position{line: 454, col: 20, offset: 14022},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 454, col: 20, offset: 14022},
-							val:        "asc",
-							ignoreCase: true,
-							want:       "\"ASC\"i",
+				pos: position{line: 538, col: 22, offset: 17185},
+				run: (*parser).callonBetweenExpression1,
+				expr: &seqExpr{
+					pos: position{line: 538, col: 22, offset: 17185},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 538, col: 22, offset: 17185},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 538, col: 25, offset: 17188},
+								name: "BitwiseOrExpression",
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 454, col: 29, offset: 14031},
-							val:        "desc",
-							ignoreCase: true,
-							want:       "\"DESC\"i",
+						&labeledExpr{
+							pos:   position{line: 538, col: 45, offset: 17208},
+							label: "between",
+							expr: &ruleRefExpr{
+								pos:  position{line: 538, col: 53, offset: 17216},
+								name: "BetweenTail",
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "Select",
-			pos:  position{line: 462, col: 1, offset: 14192},
-			expr: &litMatcher{
-				pos:        position{line: 462, col: 11, offset: 14202},
-				val:        "select",
-				ignoreCase: true,
-				want:       "\"SELECT\"i",
-			},
-		},
-		{
-			name: "Top",
-			pos:  position{line: 464, col: 1, offset: 14213},
-			expr: &litMatcher{
-				pos:        position{line: 464, col: 8, offset: 14220},
-				val:        "top",
-				ignoreCase: true,
-				want:       "\"TOP\"i",
-			},
-		},
-		{
-			name: "As",
-			pos:  position{line: 466, col: 1, offset: 14228},
-			expr: &litMatcher{
-				pos:        position{line: 466, col: 7, offset: 14234},
-				val:        "as",
-				ignoreCase: true,
-				want:       "\"AS\"i",
-			},
-		},
-		{
-			name: "From",
-			pos:  position{line: 468, col: 1, offset: 14241},
-			expr: &litMatcher{
-				pos:        position{line: 468, col: 9, offset: 14249},
-				val:        "from",
-				ignoreCase: true,
-				want:       "\"FROM\"i",
-			},
-		},
-		{
-			name: "In",
-			pos:  position{line: 470, col: 1, offset: 14258},
-			expr: &litMatcher{
-				pos:        position{line: 470, col: 7, offset: 14264},
-				val:        "in",
-				ignoreCase: true,
-				want:       "\"IN\"i",
-			},
-		},
-		{
-			name: "Join",
-			pos:  position{line: 472, col: 1, offset: 14271},
-			expr: &litMatcher{
-				pos:        position{line: 472, col: 9, offset: 14279},
-				val:        "join",
-				ignoreCase: true,
-				want:       "\"JOIN\"i",
-			},
-		},
-		{
-			name: "Exists",
-			pos:  position{line: 474, col: 1, offset: 14288},
-			expr: &litMatcher{
-				pos:        position{line: 474, col: 11, offset: 14298},
-				val:        "exists",
-				ignoreCase: true,
-				want:       "\"EXISTS\"i",
-			},
-		},
-		{
-			name: "Where",
-			pos:  position{line: 476, col: 1, offset: 14309},
-			expr: &litMatcher{
-				pos:        position{line: 476, col: 10, offset: 14318},
-				val:        "where",
-				ignoreCase: true,
-				want:       "\"WHERE\"i",
-			},
-		},
-		{
-			name: "And",
-			pos:  position{line: 478, col: 1, offset: 14328},
-			expr: &litMatcher{
-				pos:        position{line: 478, col: 8, offset: 14335},
-				val:        "and",
-				ignoreCase: true,
-				want:       "\"AND\"i",
-			},
-		},
-		{
-			name: "Or",
-			pos:  position{line: 480, col: 1, offset: 14343},
-			expr: &seqExpr{
-				pos: position{line: 480, col: 7, offset: 14349},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 480, col: 7, offset: 14349},
-						val:        "or",
-						ignoreCase: true,
-						want:       "\"OR\"i",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 480, col: 13, offset: 14355},
-						name: "wss",
+			name: "BitwiseOrExpression",
+			pos:  position{line: 542, col: 1, offset: 17282},
+			expr: &actionExpr{
+				pos: position{line: 542, col: 24, offset: 17305},
+				run: (*parser).callonBitwiseOrExpression1,
+				expr: &seqExpr{
+					pos: position{line: 542, col: 24, offset: 17305},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 542, col: 24, offset: 17305},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 542, col: 29, offset: 17310},
+								name: "BitwiseXorExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 542, col: 50, offset: 17331},
+							label: "operations",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 542, col: 61, offset: 17342},
+								expr: &actionExpr{
+									pos: position{line: 542, col: 62, offset: 17343},
+									run: (*parser).callonBitwiseOrExpression7,
+									expr: &seqExpr{
+										pos: position{line: 542, col: 62, offset: 17343},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 542, col: 62, offset: 17343},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 542, col: 65, offset: 17346},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 542, col: 68, offset: 17349},
+													name: "BitwiseOrOperation",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 542, col: 87, offset: 17368},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 542, col: 90, offset: 17371},
+												label: "right",
+												expr: &ruleRefExpr{
+													pos:  position{line: 542, col: 96, offset: 17377},
+													name: "BitwiseXorExpression",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Not",
-			pos:  position{line: 482, col: 1, offset: 14360},
-			expr: &litMatcher{
-				pos:        position{line: 482, col: 8, offset: 14367},
-				val:        "not",
-				ignoreCase: true,
-				want:       "\"NOT\"i",
+			name: "BitwiseXorExpression",
+			pos:  position{line: 546, col: 1, offset: 17494},
+			expr: &actionExpr{
+				pos: position{line: 546, col: 25, offset: 17518},
+				run: (*parser).callonBitwiseXorExpression1,
+				expr: &seqExpr{
+					pos: position{line: 546, col: 25, offset: 17518},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 546, col: 25, offset: 17518},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 546, col: 30, offset: 17523},
+								name: "BitwiseAndExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 546, col: 51, offset: 17544},
+							label: "operations",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 546, col: 62, offset: 17555},
+								expr: &actionExpr{
+									pos: position{line: 546, col: 63, offset: 17556},
+									run: (*parser).callonBitwiseXorExpression7,
+									expr: &seqExpr{
+										pos: position{line: 546, col: 63, offset: 17556},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 546, col: 63, offset: 17556},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 546, col: 66, offset: 17559},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 546, col: 69, offset: 17562},
+													name: "BitwiseXorOperation",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 546, col: 89, offset: 17582},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 546, col: 92, offset: 17585},
+												label: "right",
+												expr: &ruleRefExpr{
+													pos:  position{line: 546, col: 98, offset: 17591},
+													name: "BitwiseAndExpression",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		{
-			name: "GroupBy",
-			pos:  position{line: 484, col: 1, offset: 14375},
-			expr: &seqExpr{
-				pos: position{line: 484, col: 12, offset: 14386},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 484, col: 12, offset: 14386},
-						val:        "group",
-						ignoreCase: true,
-						want:       "\"GROUP\"i",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 484, col: 21, offset: 14395},
-						name: "ws",
-					},
-					&litMatcher{
-						pos:        position{line: 484, col: 24, offset: 14398},
-						val:        "by",
-						ignoreCase: true,
-						want:       "\"BY\"i",
+			name: "BitwiseAndExpression",
+			pos:  position{line: 550, col: 1, offset: 17708},
+			expr: &actionExpr{
+				pos: position{line: 550, col: 25, offset: 17732},
+				run: (*parser).callonBitwiseAndExpression1,
+				expr: &seqExpr{
+					pos: position{line: 550, col: 25, offset: 17732},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 550, col: 25, offset: 17732},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 550, col: 30, offset: 17737},
+								name: "ShiftExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 550, col: 46, offset: 17753},
+							label: "operations",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 550, col: 57, offset: 17764},
+								expr: &actionExpr{
+									pos: position{line: 550, col: 58, offset: 17765},
+									run: (*parser).callonBitwiseAndExpression7,
+									expr: &seqExpr{
+										pos: position{line: 550, col: 58, offset: 17765},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 550, col: 58, offset: 17765},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 550, col: 61, offset: 17768},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 550, col: 64, offset: 17771},
+													name: "BitwiseAndOperation",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 550, col: 84, offset: 17791},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 550, col: 87, offset: 17794},
+												label: "right",
+												expr: &ruleRefExpr{
+													pos:  position{line: 550, col: 93, offset: 17800},
+													name: "ShiftExpression",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "OrderBy",
-			pos:  position{line: 486, col: 1, offset: 14405},
-			expr: &seqExpr{
-				pos: position{line: 486, col: 12, offset: 14416},
-				exprs: []any{
-					&litMatcher{
-						pos:        position{line: 486, col: 12, offset: 14416},
-						val:        "order",
-						ignoreCase: true,
-						want:       "\"ORDER\"i",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 486, col: 21, offset: 14425},
-						name: "ws",
-					},
-					&litMatcher{
-						pos:        position{line: 486, col: 24, offset: 14428},
-						val:        "by",
-						ignoreCase: true,
-						want:       "\"BY\"i",
+			name: "ShiftExpression",
+			pos:  position{line: 554, col: 1, offset: 17912},
+			expr: &actionExpr{
+				pos: position{line: 554, col: 20, offset: 17931},
+				run: (*parser).callonShiftExpression1,
+				expr: &seqExpr{
+					pos: position{line: 554, col: 20, offset: 17931},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 554, col: 20, offset: 17931},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 554, col: 25, offset: 17936},
+								name: "AddSubExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 554, col: 42, offset: 17953},
+							label: "operations",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 554, col: 53, offset: 17964},
+								expr: &actionExpr{
+									pos: position{line: 554, col: 54, offset: 17965},
+									run: (*parser).callonShiftExpression7,
+									expr: &seqExpr{
+										pos: position{line: 554, col: 54, offset: 17965},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 554, col: 54, offset: 17965},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 554, col: 57, offset: 17968},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 554, col: 60, offset: 17971},
+													name: "ShiftOperation",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 554, col: 75, offset: 17986},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 554, col: 78, offset: 17989},
+												label: "right",
+												expr: &ruleRefExpr{
+													pos:  position{line: 554, col: 84, offset: 17995},
+													name: "AddSubExpression",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Offset",
-			pos:  position{line: 488, col: 1, offset: 14435},
-			expr: &litMatcher{
-				pos:        position{line: 488, col: 11, offset: 14445},
-				val:        "offset",
-				ignoreCase: true,
-				want:       "\"OFFSET\"i",
-			},
-		},
-		{
-			name: "ComparisonOperator",
-			pos:  position{line: 490, col: 1, offset: 14456},
+			name: "AddSubExpression",
+			pos:  position{line: 558, col: 1, offset: 18108},
 			expr: &actionExpr{
-				pos: position{line: 490, col: 23, offset: 14478},
-				run: (*parser).callonComparisonOperator1,
-				expr: &choiceExpr{
-					pos: position{line: 490, col: 24, offset: 14479},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 490, col: 24, offset: 14479},
-							val:        "<=",
-							ignoreCase: false,
-							want:       "\"<=\"",
-						},
-						&litMatcher{
-							pos:        position{line: 490, col: 31, offset: 14486},
-							val:        ">=",
-							ignoreCase: false,
-							want:       "\">=\"",
-						},
-						&litMatcher{
-							pos:        position{line: 490, col: 38, offset: 14493},
-							val:        "=",
-							ignoreCase: false,
-							want:       "\"=\"",
-						},
-						&litMatcher{
-							pos:        position{line: 490, col: 44, offset: 14499},
-							val:        "!=",
-							ignoreCase: false,
-							want:       "\"!=\"",
-						},
-						&litMatcher{
-							pos:        position{line: 490, col: 51, offset: 14506},
-							val:        "<",
-							ignoreCase: false,
-							want:       "\"<\"",
+				pos: position{line: 558, col: 21, offset: 18128},
+				run: (*parser).callonAddSubExpression1,
+				expr: &seqExpr{
+					pos: position{line: 558, col: 21, offset: 18128},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 558, col: 21, offset: 18128},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 558, col: 26, offset: 18133},
+								name: "MulDivExpression",
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 490, col: 57, offset: 14512},
-							val:        ">",
-							ignoreCase: false,
-							want:       "\">\"",
+						&labeledExpr{
+							pos:   position{line: 558, col: 43, offset: 18150},
+							label: "operations",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 558, col: 54, offset: 18161},
+								expr: &actionExpr{
+									pos: position{line: 558, col: 55, offset: 18162},
+									run: (*parser).callonAddSubExpression7,
+									expr: &seqExpr{
+										pos: position{line: 558, col: 55, offset: 18162},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 558, col: 55, offset: 18162},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 558, col: 58, offset: 18165},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 558, col: 61, offset: 18168},
+													name: "AddOrSubtractOperation",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 558, col: 84, offset: 18191},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 558, col: 87, offset: 18194},
+												label: "right",
+												expr: &ruleRefExpr{
+													pos:  position{line: 558, col: 93, offset: 18200},
+													name: "MulDivExpression",
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "AddOrSubtractOperation",
-			pos:  position{line: 494, col: 1, offset: 14553},
+			name: "MulDivExpression",
+			pos:  position{line: 562, col: 1, offset: 18313},
 			expr: &actionExpr{
-				pos: position{line: 494, col: 27, offset: 14579},
-				run: (*parser).callonAddOrSubtractOperation1,
-				expr: &choiceExpr{
-					pos: position{line: 494, col: 28, offset: 14580},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 494, col: 28, offset: 14580},
-							val:        "+",
-							ignoreCase: false,
-							want:       "\"+\"",
+				pos: position{line: 562, col: 21, offset: 18333},
+				run: (*parser).callonMulDivExpression1,
+				expr: &seqExpr{
+					pos: position{line: 562, col: 21, offset: 18333},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 562, col: 21, offset: 18333},
+							label: "left",
+							expr: &ruleRefExpr{
+								pos:  position{line: 562, col: 26, offset: 18338},
+								name: "UnaryExpression",
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 494, col: 34, offset: 14586},
-							val:        "-",
-							ignoreCase: false,
-							want:       "\"-\"",
+						&labeledExpr{
+							pos:   position{line: 562, col: 42, offset: 18354},
+							label: "operations",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 562, col: 53, offset: 18365},
+								expr: &actionExpr{
+									pos: position{line: 562, col: 54, offset: 18366},
+									run: (*parser).callonMulDivExpression7,
+									expr: &seqExpr{
+										pos: position{line: 562, col: 54, offset: 18366},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 562, col: 54, offset: 18366},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 562, col: 57, offset: 18369},
+												label: "op",
+												expr: &ruleRefExpr{
+													pos:  position{line: 562, col: 60, offset: 18372},
+													name: "MultiplyOrDivideOperation",
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 562, col: 86, offset: 18398},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 562, col: 89, offset: 18401},
+												label: "right",
+												expr: &ruleRefExpr{
+													pos:  position{line: 562, col: 95, offset: 18407},
+													name: "UnaryExpression",
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "MultiplyOrDivideOperation",
-			pos:  position{line: 496, col: 1, offset: 14623},
-			expr: &actionExpr{
-				pos: position{line: 496, col: 30, offset: 14652},
-				run: (*parser).callonMultiplyOrDivideOperation1,
-				expr: &choiceExpr{
-					pos: position{line: 496, col: 31, offset: 14653},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 496, col: 31, offset: 14653},
-							val:        "*",
-							ignoreCase: false,
-							want:       "\"*\"",
+			name: "UnaryExpression",
+			pos:  position{line: 566, col: 1, offset: 18519},
+			expr: &choiceExpr{
+				pos: position{line: 566, col: 20, offset: 18538},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 566, col: 20, offset: 18538},
+						run: (*parser).callonUnaryExpression2,
+						expr: &seqExpr{
+							pos: position{line: 566, col: 20, offset: 18538},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 566, col: 20, offset: 18538},
+									label: "op",
+									expr: &ruleRefExpr{
+										pos:  position{line: 566, col: 23, offset: 18541},
+										name: "UnaryOperation",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 566, col: 38, offset: 18556},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 566, col: 41, offset: 18559},
+									label: "ex",
+									expr: &ruleRefExpr{
+										pos:  position{line: 566, col: 44, offset: 18562},
+										name: "UnaryExpression",
+									},
+								},
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 496, col: 37, offset: 14659},
-							val:        "/",
-							ignoreCase: false,
-							want:       "\"/\"",
+					},
+					&actionExpr{
+						pos: position{line: 568, col: 5, offset: 18632},
+						run: (*parser).callonUnaryExpression9,
+						expr: &labeledExpr{
+							pos:   position{line: 568, col: 5, offset: 18632},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 568, col: 8, offset: 18635},
+								name: "SelectItemWithParentheses",
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "Literal",
-			pos:  position{line: 498, col: 1, offset: 14696},
+			name: "SelectItemWithParentheses",
+			pos:  position{line: 570, col: 1, offset: 18681},
 			expr: &choiceExpr{
-				pos: position{line: 498, col: 12, offset: 14707},
+				pos: position{line: 570, col: 30, offset: 18710},
 				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 498, col: 12, offset: 14707},
-						name: "FloatLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 498, col: 27, offset: 14722},
-						name: "IntegerLiteral",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 498, col: 44, offset: 14739},
-						name: "StringLiteral",
+					&actionExpr{
+						pos: position{line: 570, col: 30, offset: 18710},
+						run: (*parser).callonSelectItemWithParentheses2,
+						expr: &seqExpr{
+							pos: position{line: 570, col: 30, offset: 18710},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 570, col: 30, offset: 18710},
+									label: "inv",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 570, col: 34, offset: 18714},
+										expr: &seqExpr{
+											pos: position{line: 570, col: 35, offset: 18715},
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 570, col: 35, offset: 18715},
+													name: "Not",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 570, col: 39, offset: 18719},
+													name: "ws",
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 570, col: 44, offset: 18724},
+									val:        "(",
+									ignoreCase: false,
+									want:       "\"(\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 570, col: 48, offset: 18728},
+									name: "ws",
+								},
+								&labeledExpr{
+									pos:   position{line: 570, col: 51, offset: 18731},
+									label: "ex",
+									expr: &ruleRefExpr{
+										pos:  position{line: 570, col: 54, offset: 18734},
+										name: "TernaryExpression",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 570, col: 72, offset: 18752},
+									name: "ws",
+								},
+								&litMatcher{
+									pos:        position{line: 570, col: 75, offset: 18755},
+									val:        ")",
+									ignoreCase: false,
+									want:       "\")\"",
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 498, col: 60, offset: 14755},
-						name: "BooleanLiteral",
+					&actionExpr{
+						pos: position{line: 579, col: 7, offset: 18934},
+						run: (*parser).callonSelectItemWithParentheses15,
+						expr: &seqExpr{
+							pos: position{line: 579, col: 7, offset: 18934},
+							exprs: []any{
+								&labeledExpr{
+									pos:   position{line: 579, col: 7, offset: 18934},
+									label: "inv",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 579, col: 11, offset: 18938},
+										expr: &seqExpr{
+											pos: position{line: 579, col: 12, offset: 18939},
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 579, col: 12, offset: 18939},
+													name: "Not",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 579, col: 16, offset: 18943},
+													name: "ws",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 579, col: 21, offset: 18948},
+									label: "ex",
+									expr: &ruleRefExpr{
+										pos:  position{line: 579, col: 24, offset: 18951},
+										name: "SelectItem",
+									},
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 498, col: 77, offset: 14772},
-						name: "ParameterConstant",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 498, col: 97, offset: 14792},
-						name: "NullConstant",
+					&actionExpr{
+						pos: position{line: 586, col: 5, offset: 19102},
+						run: (*parser).callonSelectItemWithParentheses24,
+						expr: &labeledExpr{
+							pos:   position{line: 586, col: 5, offset: 19102},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 586, col: 8, offset: 19105},
+								name: "BooleanLiteral",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ParameterConstant",
-			pos:  position{line: 500, col: 1, offset: 14806},
+			name: "OrderByClause",
+			pos:  position{line: 588, col: 1, offset: 19140},
 			expr: &actionExpr{
-				pos: position{line: 500, col: 22, offset: 14827},
-				run: (*parser).callonParameterConstant1,
+				pos: position{line: 588, col: 18, offset: 19157},
+				run: (*parser).callonOrderByClause1,
 				expr: &seqExpr{
-					pos: position{line: 500, col: 22, offset: 14827},
+					pos: position{line: 588, col: 18, offset: 19157},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 500, col: 22, offset: 14827},
-							val:        "@",
-							ignoreCase: false,
-							want:       "\"@\"",
+						&ruleRefExpr{
+							pos:  position{line: 588, col: 18, offset: 19157},
+							name: "OrderBy",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 500, col: 26, offset: 14831},
-							name: "Identifier",
+							pos:  position{line: 588, col: 26, offset: 19165},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 588, col: 29, offset: 19168},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 588, col: 33, offset: 19172},
+								name: "OrderExpression",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 588, col: 49, offset: 19188},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 588, col: 56, offset: 19195},
+								expr: &actionExpr{
+									pos: position{line: 588, col: 57, offset: 19196},
+									run: (*parser).callonOrderByClause9,
+									expr: &seqExpr{
+										pos: position{line: 588, col: 57, offset: 19196},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 588, col: 57, offset: 19196},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 588, col: 60, offset: 19199},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 588, col: 64, offset: 19203},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 588, col: 67, offset: 19206},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 588, col: 70, offset: 19209},
+													name: "OrderExpression",
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "NullConstant",
-			pos:  position{line: 503, col: 1, offset: 14947},
-			expr: &actionExpr{
-				pos: position{line: 503, col: 17, offset: 14963},
-				run: (*parser).callonNullConstant1,
-				expr: &litMatcher{
-					pos:        position{line: 503, col: 17, offset: 14963},
-					val:        "null",
-					ignoreCase: true,
-					want:       "\"null\"i",
-				},
-			},
-		},
-		{
-			name: "IntegerLiteral",
-			pos:  position{line: 507, col: 1, offset: 15021},
-			expr: &actionExpr{
-				pos: position{line: 507, col: 19, offset: 15039},
-				run: (*parser).callonIntegerLiteral1,
-				expr: &labeledExpr{
-					pos:   position{line: 507, col: 19, offset: 15039},
-					label: "number",
-					expr: &ruleRefExpr{
-						pos:  position{line: 507, col: 26, offset: 15046},
-						name: "Integer",
-					},
-				},
-			},
-		},
-		{
-			name: "StringLiteral",
-			pos:  position{line: 510, col: 1, offset: 15147},
+			name: "OrderExpression",
+			pos:  position{line: 592, col: 1, offset: 19293},
 			expr: &actionExpr{
-				pos: position{line: 510, col: 18, offset: 15164},
-				run: (*parser).callonStringLiteral1,
+				pos: position{line: 592, col: 20, offset: 19312},
+				run: (*parser).callonOrderExpression1,
 				expr: &seqExpr{
-					pos: position{line: 510, col: 18, offset: 15164},
+					pos: position{line: 592, col: 20, offset: 19312},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 510, col: 18, offset: 15164},
-							val:        "\"",
-							ignoreCase: false,
-							want:       "\"\\\"\"",
+						&labeledExpr{
+							pos:   position{line: 592, col: 20, offset: 19312},
+							label: "field",
+							expr: &ruleRefExpr{
+								pos:  position{line: 592, col: 26, offset: 19318},
+								name: "OrderBySelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 592, col: 44, offset: 19336},
+							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 510, col: 23, offset: 15169},
-							label: "chars",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 510, col: 29, offset: 15175},
+							pos:   position{line: 592, col: 47, offset: 19339},
+							label: "order",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 592, col: 53, offset: 19345},
 								expr: &ruleRefExpr{
-									pos:  position{line: 510, col: 29, offset: 15175},
-									name: "StringCharacter",
+									pos:  position{line: 592, col: 53, offset: 19345},
+									name: "OrderDirection",
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 510, col: 46, offset: 15192},
-							val:        "\"",
-							ignoreCase: false,
-							want:       "\"\\\"\"",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "FloatLiteral",
-			pos:  position{line: 513, col: 1, offset: 15310},
-			expr: &actionExpr{
-				pos: position{line: 513, col: 17, offset: 15326},
-				run: (*parser).callonFloatLiteral1,
-				expr: &seqExpr{
-					pos: position{line: 513, col: 17, offset: 15326},
-					exprs: []any{
-						&oneOrMoreExpr{
-							pos: position{line: 513, col: 17, offset: 15326},
-							expr: &charClassMatcher{
-								pos:        position{line: 513, col: 17, offset: 15326},
-								val:        "[0-9]",
-								ranges:     []rune{'0', '9'},
-								ignoreCase: false,
-								inverted:   false,
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 513, col: 23, offset: 15332},
-							val:        ".",
-							ignoreCase: false,
-							want:       "\".\"",
-						},
-						&oneOrMoreExpr{
-							pos: position{line: 513, col: 26, offset: 15335},
-							expr: &charClassMatcher{
-								pos:        position{line: 513, col: 26, offset: 15335},
-								val:        "[0-9]",
-								ranges:     []rune{'0', '9'},
-								ignoreCase: false,
-								inverted:   false,
+			name: "OrderBySelectItem",
+			pos:  position{line: 596, col: 1, offset: 19411},
+			expr: &choiceExpr{
+				pos: position{line: 596, col: 22, offset: 19432},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 596, col: 22, offset: 19432},
+						run: (*parser).callonOrderBySelectItem2,
+						expr: &labeledExpr{
+							pos:   position{line: 596, col: 22, offset: 19432},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 596, col: 25, offset: 19435},
+								name: "BetweenExpression",
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 598, col: 5, offset: 19545},
+						name: "SelectProperty",
+					},
 				},
 			},
 		},
 		{
-			name: "BooleanLiteral",
-			pos:  position{line: 517, col: 1, offset: 15491},
+			name: "OrderDirection",
+			pos:  position{line: 600, col: 1, offset: 19561},
 			expr: &actionExpr{
-				pos: position{line: 517, col: 19, offset: 15509},
-				run: (*parser).callonBooleanLiteral1,
+				pos: position{line: 600, col: 19, offset: 19579},
+				run: (*parser).callonOrderDirection1,
 				expr: &choiceExpr{
-					pos: position{line: 517, col: 20, offset: 15510},
+					pos: position{line: 600, col: 20, offset: 19580},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 517, col: 20, offset: 15510},
-							val:        "true",
+							pos:        position{line: 600, col: 20, offset: 19580},
+							val:        "asc",
 							ignoreCase: true,
-							want:       "\"true\"i",
+							want:       "\"ASC\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 517, col: 30, offset: 15520},
-							val:        "false",
+							pos:        position{line: 600, col: 29, offset: 19589},
+							val:        "desc",
 							ignoreCase: true,
-							want:       "\"false\"i",
+							want:       "\"DESC\"i",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "FunctionCall",
-			pos:  position{line: 522, col: 1, offset: 15675},
-			expr: &choiceExpr{
-				pos: position{line: 522, col: 17, offset: 15691},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 522, col: 17, offset: 15691},
-						name: "StringFunctions",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 523, col: 7, offset: 15713},
-						name: "TypeCheckingFunctions",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 524, col: 7, offset: 15741},
-						name: "ArrayFunctions",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 525, col: 7, offset: 15762},
-						name: "ConditionalFunctions",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 526, col: 7, offset: 15789},
-						name: "InFunction",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 527, col: 7, offset: 15806},
-						name: "AggregateFunctions",
+			name: "Select",
+			pos:  position{line: 608, col: 1, offset: 19750},
+			expr: &litMatcher{
+				pos:        position{line: 608, col: 11, offset: 19760},
+				val:        "select",
+				ignoreCase: true,
+				want:       "\"SELECT\"i",
+			},
+		},
+		{
+			name: "Top",
+			pos:  position{line: 610, col: 1, offset: 19771},
+			expr: &litMatcher{
+				pos:        position{line: 610, col: 8, offset: 19778},
+				val:        "top",
+				ignoreCase: true,
+				want:       "\"TOP\"i",
+			},
+		},
+		{
+			name: "As",
+			pos:  position{line: 612, col: 1, offset: 19786},
+			expr: &litMatcher{
+				pos:        position{line: 612, col: 7, offset: 19792},
+				val:        "as",
+				ignoreCase: true,
+				want:       "\"AS\"i",
+			},
+		},
+		{
+			name: "From",
+			pos:  position{line: 614, col: 1, offset: 19799},
+			expr: &litMatcher{
+				pos:        position{line: 614, col: 9, offset: 19807},
+				val:        "from",
+				ignoreCase: true,
+				want:       "\"FROM\"i",
+			},
+		},
+		{
+			name: "In",
+			pos:  position{line: 616, col: 1, offset: 19816},
+			expr: &litMatcher{
+				pos:        position{line: 616, col: 7, offset: 19822},
+				val:        "in",
+				ignoreCase: true,
+				want:       "\"IN\"i",
+			},
+		},
+		{
+			name: "Join",
+			pos:  position{line: 618, col: 1, offset: 19829},
+			expr: &litMatcher{
+				pos:        position{line: 618, col: 9, offset: 19837},
+				val:        "join",
+				ignoreCase: true,
+				want:       "\"JOIN\"i",
+			},
+		},
+		{
+			name: "Exists",
+			pos:  position{line: 620, col: 1, offset: 19846},
+			expr: &litMatcher{
+				pos:        position{line: 620, col: 11, offset: 19856},
+				val:        "exists",
+				ignoreCase: true,
+				want:       "\"EXISTS\"i",
+			},
+		},
+		{
+			name: "Where",
+			pos:  position{line: 622, col: 1, offset: 19867},
+			expr: &litMatcher{
+				pos:        position{line: 622, col: 10, offset: 19876},
+				val:        "where",
+				ignoreCase: true,
+				want:       "\"WHERE\"i",
+			},
+		},
+		{
+			name: "And",
+			pos:  position{line: 624, col: 1, offset: 19886},
+			expr: &litMatcher{
+				pos:        position{line: 624, col: 8, offset: 19893},
+				val:        "and",
+				ignoreCase: true,
+				want:       "\"AND\"i",
+			},
+		},
+		{
+			name: "Or",
+			pos:  position{line: 626, col: 1, offset: 19901},
+			expr: &seqExpr{
+				pos: position{line: 626, col: 7, offset: 19907},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 626, col: 7, offset: 19907},
+						val:        "or",
+						ignoreCase: true,
+						want:       "\"OR\"i",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 528, col: 7, offset: 15831},
-						name: "MathFunctions",
+						pos:  position{line: 626, col: 13, offset: 19913},
+						name: "wss",
 					},
 				},
 			},
 		},
 		{
-			name: "StringFunctions",
-			pos:  position{line: 530, col: 1, offset: 15846},
-			expr: &choiceExpr{
-				pos: position{line: 530, col: 20, offset: 15865},
-				alternatives: []any{
+			name: "Not",
+			pos:  position{line: 628, col: 1, offset: 19918},
+			expr: &litMatcher{
+				pos:        position{line: 628, col: 8, offset: 19925},
+				val:        "not",
+				ignoreCase: true,
+				want:       "\"NOT\"i",
+			},
+		},
+		{
+			name: "GroupBy",
+			pos:  position{line: 630, col: 1, offset: 19933},
+			expr: &seqExpr{
+				pos: position{line: 630, col: 12, offset: 19944},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 630, col: 12, offset: 19944},
+						val:        "group",
+						ignoreCase: true,
+						want:       "\"GROUP\"i",
+					},
 					&ruleRefExpr{
-						pos:  position{line: 530, col: 20, offset: 15865},
-						name: "StringEqualsExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 531, col: 7, offset: 15894},
-						name: "ToStringExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 532, col: 7, offset: 15919},
-						name: "ConcatExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 533, col: 7, offset: 15942},
-						name: "ThreeArgumentStringFunctionExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 534, col: 7, offset: 15986},
-						name: "UpperExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 535, col: 7, offset: 16008},
-						name: "LowerExpression",
+						pos:  position{line: 630, col: 21, offset: 19953},
+						name: "ws",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 536, col: 7, offset: 16030},
-						name: "LeftExpression",
+					&litMatcher{
+						pos:        position{line: 630, col: 24, offset: 19956},
+						val:        "by",
+						ignoreCase: true,
+						want:       "\"BY\"i",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 537, col: 7, offset: 16051},
-						name: "LengthExpression",
+				},
+			},
+		},
+		{
+			name: "OrderBy",
+			pos:  position{line: 632, col: 1, offset: 19963},
+			expr: &seqExpr{
+				pos: position{line: 632, col: 12, offset: 19974},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 632, col: 12, offset: 19974},
+						val:        "order",
+						ignoreCase: true,
+						want:       "\"ORDER\"i",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 538, col: 7, offset: 16074},
-						name: "LTrimExpression",
+						pos:  position{line: 632, col: 21, offset: 19983},
+						name: "ws",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 539, col: 7, offset: 16096},
-						name: "ReplaceExpression",
+					&litMatcher{
+						pos:        position{line: 632, col: 24, offset: 19986},
+						val:        "by",
+						ignoreCase: true,
+						want:       "\"BY\"i",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 540, col: 7, offset: 16120},
-						name: "ReplicateExpression",
+				},
+			},
+		},
+		{
+			name: "Offset",
+			pos:  position{line: 634, col: 1, offset: 19993},
+			expr: &litMatcher{
+				pos:        position{line: 634, col: 11, offset: 20003},
+				val:        "offset",
+				ignoreCase: true,
+				want:       "\"OFFSET\"i",
+			},
+		},
+		{
+			name: "Like",
+			pos:  position{line: 636, col: 1, offset: 20014},
+			expr: &litMatcher{
+				pos:        position{line: 636, col: 9, offset: 20022},
+				val:        "like",
+				ignoreCase: true,
+				want:       "\"LIKE\"i",
+			},
+		},
+		{
+			name: "Escape",
+			pos:  position{line: 638, col: 1, offset: 20031},
+			expr: &litMatcher{
+				pos:        position{line: 638, col: 11, offset: 20041},
+				val:        "escape",
+				ignoreCase: true,
+				want:       "\"ESCAPE\"i",
+			},
+		},
+		{
+			name: "Between",
+			pos:  position{line: 640, col: 1, offset: 20052},
+			expr: &litMatcher{
+				pos:        position{line: 640, col: 12, offset: 20063},
+				val:        "between",
+				ignoreCase: true,
+				want:       "\"BETWEEN\"i",
+			},
+		},
+		{
+			name: "ComparisonOperator",
+			pos:  position{line: 642, col: 1, offset: 20075},
+			expr: &actionExpr{
+				pos: position{line: 642, col: 23, offset: 20097},
+				run: (*parser).callonComparisonOperator1,
+				expr: &choiceExpr{
+					pos: position{line: 642, col: 24, offset: 20098},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 642, col: 24, offset: 20098},
+							val:        "<=",
+							ignoreCase: false,
+							want:       "\"<=\"",
+						},
+						&litMatcher{
+							pos:        position{line: 642, col: 31, offset: 20105},
+							val:        ">=",
+							ignoreCase: false,
+							want:       "\">=\"",
+						},
+						&litMatcher{
+							pos:        position{line: 642, col: 38, offset: 20112},
+							val:        "=",
+							ignoreCase: false,
+							want:       "\"=\"",
+						},
+						&litMatcher{
+							pos:        position{line: 642, col: 44, offset: 20118},
+							val:        "!=",
+							ignoreCase: false,
+							want:       "\"!=\"",
+						},
+						&litMatcher{
+							pos:        position{line: 642, col: 51, offset: 20125},
+							val:        "<",
+							ignoreCase: false,
+							want:       "\"<\"",
+						},
+						&litMatcher{
+							pos:        position{line: 642, col: 57, offset: 20131},
+							val:        ">",
+							ignoreCase: false,
+							want:       "\">\"",
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 541, col: 7, offset: 16146},
-						name: "ReverseExpression",
+				},
+			},
+		},
+		{
+			name: "AddOrSubtractOperation",
+			pos:  position{line: 646, col: 1, offset: 20172},
+			expr: &actionExpr{
+				pos: position{line: 646, col: 27, offset: 20198},
+				run: (*parser).callonAddOrSubtractOperation1,
+				expr: &choiceExpr{
+					pos: position{line: 646, col: 28, offset: 20199},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 646, col: 28, offset: 20199},
+							val:        "+",
+							ignoreCase: false,
+							want:       "\"+\"",
+						},
+						&litMatcher{
+							pos:        position{line: 646, col: 34, offset: 20205},
+							val:        "-",
+							ignoreCase: false,
+							want:       "\"-\"",
+						},
+						&litMatcher{
+							pos:        position{line: 646, col: 40, offset: 20211},
+							val:        "||",
+							ignoreCase: false,
+							want:       "\"||\"",
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 542, col: 7, offset: 16170},
-						name: "RightExpression",
+				},
+			},
+		},
+		{
+			name: "MultiplyOrDivideOperation",
+			pos:  position{line: 648, col: 1, offset: 20249},
+			expr: &actionExpr{
+				pos: position{line: 648, col: 30, offset: 20278},
+				run: (*parser).callonMultiplyOrDivideOperation1,
+				expr: &choiceExpr{
+					pos: position{line: 648, col: 31, offset: 20279},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 648, col: 31, offset: 20279},
+							val:        "*",
+							ignoreCase: false,
+							want:       "\"*\"",
+						},
+						&litMatcher{
+							pos:        position{line: 648, col: 37, offset: 20285},
+							val:        "/",
+							ignoreCase: false,
+							want:       "\"/\"",
+						},
+						&litMatcher{
+							pos:        position{line: 648, col: 43, offset: 20291},
+							val:        "%",
+							ignoreCase: false,
+							want:       "\"%\"",
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 543, col: 7, offset: 16192},
-						name: "RTrimExpression",
+				},
+			},
+		},
+		{
+			name: "ShiftOperation",
+			pos:  position{line: 650, col: 1, offset: 20328},
+			expr: &actionExpr{
+				pos: position{line: 650, col: 19, offset: 20346},
+				run: (*parser).callonShiftOperation1,
+				expr: &choiceExpr{
+					pos: position{line: 650, col: 20, offset: 20347},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 650, col: 20, offset: 20347},
+							val:        ">>>",
+							ignoreCase: false,
+							want:       "\">>>\"",
+						},
+						&litMatcher{
+							pos:        position{line: 650, col: 28, offset: 20355},
+							val:        "<<",
+							ignoreCase: false,
+							want:       "\"<<\"",
+						},
+						&litMatcher{
+							pos:        position{line: 650, col: 35, offset: 20362},
+							val:        ">>",
+							ignoreCase: false,
+							want:       "\">>\"",
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 544, col: 7, offset: 16214},
-						name: "SubstringExpression",
+				},
+			},
+		},
+		{
+			name: "BitwiseAndOperation",
+			pos:  position{line: 652, col: 1, offset: 20400},
+			expr: &actionExpr{
+				pos: position{line: 652, col: 24, offset: 20423},
+				run: (*parser).callonBitwiseAndOperation1,
+				expr: &litMatcher{
+					pos:        position{line: 652, col: 24, offset: 20423},
+					val:        "&",
+					ignoreCase: false,
+					want:       "\"&\"",
+				},
+			},
+		},
+		{
+			name: "BitwiseXorOperation",
+			pos:  position{line: 654, col: 1, offset: 20459},
+			expr: &actionExpr{
+				pos: position{line: 654, col: 24, offset: 20482},
+				run: (*parser).callonBitwiseXorOperation1,
+				expr: &litMatcher{
+					pos:        position{line: 654, col: 24, offset: 20482},
+					val:        "^",
+					ignoreCase: false,
+					want:       "\"^\"",
+				},
+			},
+		},
+		{
+			name: "BitwiseOrOperation",
+			pos:  position{line: 656, col: 1, offset: 20518},
+			expr: &actionExpr{
+				pos: position{line: 656, col: 23, offset: 20540},
+				run: (*parser).callonBitwiseOrOperation1,
+				expr: &seqExpr{
+					pos: position{line: 656, col: 23, offset: 20540},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 656, col: 23, offset: 20540},
+							val:        "|",
+							ignoreCase: false,
+							want:       "\"|\"",
+						},
+						&notExpr{
+							pos: position{line: 656, col: 27, offset: 20544},
+							expr: &litMatcher{
+								pos:        position{line: 656, col: 28, offset: 20545},
+								val:        "|",
+								ignoreCase: false,
+								want:       "\"|\"",
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 545, col: 7, offset: 16240},
-						name: "TrimExpression",
+				},
+			},
+		},
+		{
+			name: "UnaryOperation",
+			pos:  position{line: 658, col: 1, offset: 20570},
+			expr: &actionExpr{
+				pos: position{line: 658, col: 19, offset: 20588},
+				run: (*parser).callonUnaryOperation1,
+				expr: &choiceExpr{
+					pos: position{line: 658, col: 20, offset: 20589},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 658, col: 20, offset: 20589},
+							val:        "~",
+							ignoreCase: false,
+							want:       "\"~\"",
+						},
+						&litMatcher{
+							pos:        position{line: 658, col: 26, offset: 20595},
+							val:        "-",
+							ignoreCase: false,
+							want:       "\"-\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "TypeCheckingFunctions",
-			pos:  position{line: 547, col: 1, offset: 16256},
+			name: "CoalesceOperation",
+			pos:  position{line: 660, col: 1, offset: 20632},
+			expr: &actionExpr{
+				pos: position{line: 660, col: 22, offset: 20653},
+				run: (*parser).callonCoalesceOperation1,
+				expr: &litMatcher{
+					pos:        position{line: 660, col: 22, offset: 20653},
+					val:        "??",
+					ignoreCase: false,
+					want:       "\"??\"",
+				},
+			},
+		},
+		{
+			name: "Literal",
+			pos:  position{line: 662, col: 1, offset: 20690},
 			expr: &choiceExpr{
-				pos: position{line: 547, col: 26, offset: 16281},
+				pos: position{line: 662, col: 12, offset: 20701},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 547, col: 26, offset: 16281},
-						name: "IsDefined",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 548, col: 7, offset: 16297},
-						name: "IsArray",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 549, col: 7, offset: 16311},
-						name: "IsBool",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 550, col: 7, offset: 16324},
-						name: "IsFiniteNumber",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 551, col: 7, offset: 16345},
-						name: "IsInteger",
+						pos:  position{line: 662, col: 12, offset: 20701},
+						name: "FloatLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 552, col: 7, offset: 16361},
-						name: "IsNull",
+						pos:  position{line: 662, col: 27, offset: 20716},
+						name: "IntegerLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 553, col: 7, offset: 16374},
-						name: "IsNumber",
+						pos:  position{line: 662, col: 44, offset: 20733},
+						name: "StringLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 554, col: 7, offset: 16389},
-						name: "IsObject",
+						pos:  position{line: 662, col: 60, offset: 20749},
+						name: "BooleanLiteral",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 555, col: 7, offset: 16404},
-						name: "IsPrimitive",
+						pos:  position{line: 662, col: 77, offset: 20766},
+						name: "ParameterConstant",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 556, col: 7, offset: 16422},
-						name: "IsString",
+						pos:  position{line: 662, col: 97, offset: 20786},
+						name: "NullConstant",
 					},
 				},
 			},
 		},
 		{
-			name: "AggregateFunctions",
-			pos:  position{line: 558, col: 1, offset: 16432},
-			expr: &choiceExpr{
-				pos: position{line: 558, col: 23, offset: 16454},
-				alternatives: []any{
-					&ruleRefExpr{
-						pos:  position{line: 558, col: 23, offset: 16454},
-						name: "AvgAggregateExpression",
+			name: "ParameterConstant",
+			pos:  position{line: 664, col: 1, offset: 20800},
+			expr: &actionExpr{
+				pos: position{line: 664, col: 22, offset: 20821},
+				run: (*parser).callonParameterConstant1,
+				expr: &seqExpr{
+					pos: position{line: 664, col: 22, offset: 20821},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 664, col: 22, offset: 20821},
+							val:        "@",
+							ignoreCase: false,
+							want:       "\"@\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 664, col: 26, offset: 20825},
+							name: "Identifier",
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 559, col: 7, offset: 16483},
-						name: "CountAggregateExpression",
+				},
+			},
+		},
+		{
+			name: "NullConstant",
+			pos:  position{line: 667, col: 1, offset: 20941},
+			expr: &actionExpr{
+				pos: position{line: 667, col: 17, offset: 20957},
+				run: (*parser).callonNullConstant1,
+				expr: &litMatcher{
+					pos:        position{line: 667, col: 17, offset: 20957},
+					val:        "null",
+					ignoreCase: true,
+					want:       "\"null\"i",
+				},
+			},
+		},
+		{
+			name: "IntegerLiteral",
+			pos:  position{line: 671, col: 1, offset: 21015},
+			expr: &actionExpr{
+				pos: position{line: 671, col: 19, offset: 21033},
+				run: (*parser).callonIntegerLiteral1,
+				expr: &labeledExpr{
+					pos:   position{line: 671, col: 19, offset: 21033},
+					label: "number",
+					expr: &ruleRefExpr{
+						pos:  position{line: 671, col: 26, offset: 21040},
+						name: "Integer",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 560, col: 7, offset: 16514},
-						name: "MaxAggregateExpression",
+				},
+			},
+		},
+		{
+			name: "StringLiteral",
+			pos:  position{line: 674, col: 1, offset: 21141},
+			expr: &choiceExpr{
+				pos: position{line: 674, col: 18, offset: 21158},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 674, col: 18, offset: 21158},
+						run: (*parser).callonStringLiteral2,
+						expr: &seqExpr{
+							pos: position{line: 674, col: 18, offset: 21158},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 674, col: 18, offset: 21158},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 674, col: 23, offset: 21163},
+									label: "chars",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 674, col: 29, offset: 21169},
+										expr: &ruleRefExpr{
+											pos:  position{line: 674, col: 29, offset: 21169},
+											name: "StringCharacter",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 674, col: 46, offset: 21186},
+									val:        "\"",
+									ignoreCase: false,
+									want:       "\"\\\"\"",
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 561, col: 7, offset: 16543},
-						name: "MinAggregateExpression",
+					&actionExpr{
+						pos: position{line: 676, col: 5, offset: 21306},
+						run: (*parser).callonStringLiteral9,
+						expr: &seqExpr{
+							pos: position{line: 676, col: 5, offset: 21306},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 676, col: 5, offset: 21306},
+									val:        "'",
+									ignoreCase: false,
+									want:       "\"'\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 676, col: 9, offset: 21310},
+									label: "chars",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 676, col: 15, offset: 21316},
+										expr: &ruleRefExpr{
+											pos:  position{line: 676, col: 15, offset: 21316},
+											name: "SingleQuotedStringCharacter",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 676, col: 44, offset: 21345},
+									val:        "'",
+									ignoreCase: false,
+									want:       "\"'\"",
+								},
+							},
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 562, col: 7, offset: 16572},
-						name: "SumAggregateExpression",
+				},
+			},
+		},
+		{
+			name: "FloatLiteral",
+			pos:  position{line: 679, col: 1, offset: 21462},
+			expr: &actionExpr{
+				pos: position{line: 679, col: 17, offset: 21478},
+				run: (*parser).callonFloatLiteral1,
+				expr: &seqExpr{
+					pos: position{line: 679, col: 17, offset: 21478},
+					exprs: []any{
+						&oneOrMoreExpr{
+							pos: position{line: 679, col: 17, offset: 21478},
+							expr: &charClassMatcher{
+								pos:        position{line: 679, col: 17, offset: 21478},
+								val:        "[0-9]",
+								ranges:     []rune{'0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 679, col: 23, offset: 21484},
+							val:        ".",
+							ignoreCase: false,
+							want:       "\".\"",
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 679, col: 26, offset: 21487},
+							expr: &charClassMatcher{
+								pos:        position{line: 679, col: 26, offset: 21487},
+								val:        "[0-9]",
+								ranges:     []rune{'0', '9'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ArrayFunctions",
-			pos:  position{line: 564, col: 1, offset: 16596},
+			name: "BooleanLiteral",
+			pos:  position{line: 683, col: 1, offset: 21643},
+			expr: &actionExpr{
+				pos: position{line: 683, col: 19, offset: 21661},
+				run: (*parser).callonBooleanLiteral1,
+				expr: &choiceExpr{
+					pos: position{line: 683, col: 20, offset: 21662},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 683, col: 20, offset: 21662},
+							val:        "true",
+							ignoreCase: true,
+							want:       "\"true\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 683, col: 30, offset: 21672},
+							val:        "false",
+							ignoreCase: true,
+							want:       "\"false\"i",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FunctionCall",
+			pos:  position{line: 688, col: 1, offset: 21827},
 			expr: &choiceExpr{
-				pos: position{line: 564, col: 19, offset: 16614},
+				pos: position{line: 688, col: 17, offset: 21843},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 564, col: 19, offset: 16614},
-						name: "ArrayConcatExpression",
+						pos:  position{line: 688, col: 17, offset: 21843},
+						name: "StringFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 565, col: 7, offset: 16642},
-						name: "ArrayContainsExpression",
+						pos:  position{line: 689, col: 7, offset: 21865},
+						name: "TypeCheckingFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 566, col: 7, offset: 16672},
-						name: "ArrayContainsAnyExpression",
+						pos:  position{line: 690, col: 7, offset: 21893},
+						name: "ArrayFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 567, col: 7, offset: 16705},
-						name: "ArrayContainsAllExpression",
+						pos:  position{line: 691, col: 7, offset: 21914},
+						name: "ConditionalFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 568, col: 7, offset: 16738},
-						name: "ArrayLengthExpression",
+						pos:  position{line: 692, col: 7, offset: 21941},
+						name: "DateTimeFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 569, col: 7, offset: 16766},
-						name: "ArraySliceExpression",
+						pos:  position{line: 693, col: 7, offset: 21965},
+						name: "SpatialFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 570, col: 7, offset: 16793},
-						name: "SetIntersectExpression",
+						pos:  position{line: 694, col: 7, offset: 21988},
+						name: "ItemFunctions",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 571, col: 7, offset: 16822},
-						name: "SetUnionExpression",
+						pos:  position{line: 695, col: 7, offset: 22008},
+						name: "InFunction",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 696, col: 7, offset: 22025},
+						name: "AggregateFunctions",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 697, col: 7, offset: 22050},
+						name: "MathFunctions",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 698, col: 7, offset: 22070},
+						name: "UserDefinedFunctionCall",
 					},
 				},
 			},
 		},
 		{
-			name: "ConditionalFunctions",
-			pos:  position{line: 573, col: 1, offset: 16842},
-			expr: &ruleRefExpr{
-				pos:  position{line: 573, col: 25, offset: 16866},
-				name: "IifExpression",
-			},
-		},
-		{
-			name: "MathFunctions",
-			pos:  position{line: 575, col: 1, offset: 16881},
+			name: "StringFunctions",
+			pos:  position{line: 700, col: 1, offset: 22095},
 			expr: &choiceExpr{
-				pos: position{line: 575, col: 18, offset: 16898},
+				pos: position{line: 700, col: 20, offset: 22114},
 				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 575, col: 18, offset: 16898},
-						name: "MathAbsExpression",
+						pos:  position{line: 700, col: 20, offset: 22114},
+						name: "StringEqualsExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 576, col: 7, offset: 16922},
-						name: "MathAcosExpression",
+						pos:  position{line: 701, col: 7, offset: 22143},
+						name: "ToStringExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 577, col: 7, offset: 16947},
-						name: "MathAsinExpression",
+						pos:  position{line: 702, col: 7, offset: 22168},
+						name: "ConcatExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 578, col: 7, offset: 16972},
-						name: "MathAtanExpression",
+						pos:  position{line: 703, col: 7, offset: 22191},
+						name: "ThreeArgumentStringFunctionExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 579, col: 7, offset: 16997},
-						name: "MathCeilingExpression",
+						pos:  position{line: 704, col: 7, offset: 22235},
+						name: "UpperExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 580, col: 7, offset: 17025},
-						name: "MathCosExpression",
+						pos:  position{line: 705, col: 7, offset: 22257},
+						name: "LowerExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 581, col: 7, offset: 17049},
-						name: "MathCotExpression",
+						pos:  position{line: 706, col: 7, offset: 22279},
+						name: "LeftExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 582, col: 7, offset: 17073},
-						name: "MathDegreesExpression",
+						pos:  position{line: 707, col: 7, offset: 22300},
+						name: "LengthExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 583, col: 7, offset: 17101},
-						name: "MathExpExpression",
+						pos:  position{line: 708, col: 7, offset: 22323},
+						name: "LTrimExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 584, col: 7, offset: 17125},
-						name: "MathFloorExpression",
+						pos:  position{line: 709, col: 7, offset: 22345},
+						name: "ReplaceExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 585, col: 7, offset: 17151},
-						name: "MathIntBitNotExpression",
+						pos:  position{line: 710, col: 7, offset: 22369},
+						name: "ReplicateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 586, col: 7, offset: 17181},
-						name: "MathLog10Expression",
+						pos:  position{line: 711, col: 7, offset: 22395},
+						name: "ReverseExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 587, col: 7, offset: 17207},
-						name: "MathRadiansExpression",
+						pos:  position{line: 712, col: 7, offset: 22419},
+						name: "RightExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 588, col: 7, offset: 17235},
-						name: "MathRoundExpression",
+						pos:  position{line: 713, col: 7, offset: 22441},
+						name: "RTrimExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 589, col: 7, offset: 17261},
-						name: "MathSignExpression",
+						pos:  position{line: 714, col: 7, offset: 22463},
+						name: "SubstringExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 590, col: 7, offset: 17286},
-						name: "MathSinExpression",
+						pos:  position{line: 715, col: 7, offset: 22489},
+						name: "TrimExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 591, col: 7, offset: 17310},
-						name: "MathSqrtExpression",
+						pos:  position{line: 716, col: 7, offset: 22510},
+						name: "StringToArrayExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 592, col: 7, offset: 17335},
-						name: "MathSquareExpression",
+						pos:  position{line: 717, col: 7, offset: 22540},
+						name: "StringToBooleanExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 593, col: 7, offset: 17362},
-						name: "MathTanExpression",
+						pos:  position{line: 718, col: 7, offset: 22572},
+						name: "StringToNullExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 594, col: 7, offset: 17386},
-						name: "MathTruncExpression",
+						pos:  position{line: 719, col: 7, offset: 22601},
+						name: "StringToNumberExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 595, col: 7, offset: 17412},
-						name: "MathAtn2Expression",
+						pos:  position{line: 720, col: 7, offset: 22632},
+						name: "StringToObjectExpression",
 					},
+				},
+			},
+		},
+		{
+			name: "TypeCheckingFunctions",
+			pos:  position{line: 722, col: 1, offset: 22658},
+			expr: &choiceExpr{
+				pos: position{line: 722, col: 26, offset: 22683},
+				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 596, col: 7, offset: 17437},
-						name: "MathIntAddExpression",
+						pos:  position{line: 722, col: 26, offset: 22683},
+						name: "IsDefined",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 597, col: 7, offset: 17464},
-						name: "MathIntBitAndExpression",
+						pos:  position{line: 723, col: 7, offset: 22699},
+						name: "IsArray",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 598, col: 7, offset: 17494},
-						name: "MathIntBitLeftShiftExpression",
+						pos:  position{line: 724, col: 7, offset: 22713},
+						name: "IsBool",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 599, col: 7, offset: 17530},
-						name: "MathIntBitOrExpression",
+						pos:  position{line: 725, col: 7, offset: 22726},
+						name: "IsFiniteNumber",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 600, col: 7, offset: 17559},
-						name: "MathIntBitRightShiftExpression",
+						pos:  position{line: 726, col: 7, offset: 22747},
+						name: "IsInteger",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 601, col: 7, offset: 17596},
-						name: "MathIntBitXorExpression",
+						pos:  position{line: 727, col: 7, offset: 22763},
+						name: "IsNull",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 602, col: 7, offset: 17626},
-						name: "MathIntDivExpression",
+						pos:  position{line: 728, col: 7, offset: 22776},
+						name: "IsNumber",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 603, col: 7, offset: 17653},
-						name: "MathIntModExpression",
+						pos:  position{line: 729, col: 7, offset: 22791},
+						name: "IsObject",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 604, col: 7, offset: 17680},
-						name: "MathIntMulExpression",
+						pos:  position{line: 730, col: 7, offset: 22806},
+						name: "IsPrimitive",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 605, col: 7, offset: 17707},
-						name: "MathIntSubExpression",
+						pos:  position{line: 731, col: 7, offset: 22824},
+						name: "IsString",
 					},
+				},
+			},
+		},
+		{
+			name: "AggregateFunctions",
+			pos:  position{line: 733, col: 1, offset: 22834},
+			expr: &choiceExpr{
+				pos: position{line: 733, col: 23, offset: 22856},
+				alternatives: []any{
 					&ruleRefExpr{
-						pos:  position{line: 606, col: 7, offset: 17734},
-						name: "MathPowerExpression",
+						pos:  position{line: 733, col: 23, offset: 22856},
+						name: "AvgAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 607, col: 7, offset: 17760},
-						name: "MathLogExpression",
+						pos:  position{line: 734, col: 7, offset: 22885},
+						name: "CountAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 608, col: 7, offset: 17784},
-						name: "MathNumberBinExpression",
+						pos:  position{line: 735, col: 7, offset: 22916},
+						name: "MaxAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 609, col: 7, offset: 17814},
-						name: "MathPiExpression",
+						pos:  position{line: 736, col: 7, offset: 22945},
+						name: "MinAggregateExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 610, col: 7, offset: 17837},
-						name: "MathRandExpression",
+						pos:  position{line: 737, col: 7, offset: 22974},
+						name: "SumAggregateExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "ArrayFunctions",
+			pos:  position{line: 739, col: 1, offset: 22998},
+			expr: &choiceExpr{
+				pos: position{line: 739, col: 19, offset: 23016},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 739, col: 19, offset: 23016},
+						name: "ArrayConcatExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 740, col: 7, offset: 23044},
+						name: "ArrayContainsExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 741, col: 7, offset: 23074},
+						name: "ArrayContainsAnyExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 742, col: 7, offset: 23107},
+						name: "ArrayContainsAllExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 743, col: 7, offset: 23140},
+						name: "ArrayLengthExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 744, col: 7, offset: 23168},
+						name: "ArraySliceExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 745, col: 7, offset: 23195},
+						name: "ChooseExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 746, col: 7, offset: 23218},
+						name: "ObjectToArrayExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 747, col: 7, offset: 23248},
+						name: "SetIntersectExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 748, col: 7, offset: 23277},
+						name: "SetUnionExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "ConditionalFunctions",
+			pos:  position{line: 750, col: 1, offset: 23297},
+			expr: &ruleRefExpr{
+				pos:  position{line: 750, col: 25, offset: 23321},
+				name: "IifExpression",
+			},
+		},
+		{
+			name: "ItemFunctions",
+			pos:  position{line: 752, col: 1, offset: 23336},
+			expr: &ruleRefExpr{
+				pos:  position{line: 752, col: 18, offset: 23353},
+				name: "DocumentIdExpression",
+			},
+		},
+		{
+			name: "DateTimeFunctions",
+			pos:  position{line: 754, col: 1, offset: 23375},
+			expr: &choiceExpr{
+				pos: position{line: 754, col: 22, offset: 23396},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 754, col: 22, offset: 23396},
+						name: "DateTimeAddExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 755, col: 7, offset: 23424},
+						name: "DateTimeBinExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 756, col: 7, offset: 23452},
+						name: "DateTimeDiffExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 757, col: 7, offset: 23481},
+						name: "DateTimeFromPartsExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 758, col: 7, offset: 23515},
+						name: "DateTimePartExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 759, col: 7, offset: 23544},
+						name: "DateTimeToTicksExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 760, col: 7, offset: 23576},
+						name: "DateTimeToTimestampExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 761, col: 7, offset: 23612},
+						name: "GetCurrentDateTimeStaticExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 762, col: 7, offset: 23653},
+						name: "GetCurrentDateTimeExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 763, col: 7, offset: 23688},
+						name: "GetCurrentTicksStaticExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 764, col: 7, offset: 23726},
+						name: "GetCurrentTicksExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 765, col: 7, offset: 23758},
+						name: "GetCurrentTimestampStaticExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 766, col: 7, offset: 23800},
+						name: "GetCurrentTimestampExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 767, col: 7, offset: 23836},
+						name: "TicksToDateTimeExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 768, col: 7, offset: 23868},
+						name: "TimestampToDateTimeExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "SpatialFunctions",
+			pos:  position{line: 770, col: 1, offset: 23899},
+			expr: &choiceExpr{
+				pos: position{line: 770, col: 21, offset: 23919},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 770, col: 21, offset: 23919},
+						name: "StAreaExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 771, col: 7, offset: 23942},
+						name: "StDistanceExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 772, col: 7, offset: 23969},
+						name: "StWithinExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 773, col: 7, offset: 23994},
+						name: "StIntersectsExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 774, col: 7, offset: 24023},
+						name: "StIsValidDetailedExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 775, col: 7, offset: 24057},
+						name: "StIsValidExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "MathFunctions",
+			pos:  position{line: 777, col: 1, offset: 24078},
+			expr: &choiceExpr{
+				pos: position{line: 777, col: 18, offset: 24095},
+				alternatives: []any{
+					&ruleRefExpr{
+						pos:  position{line: 777, col: 18, offset: 24095},
+						name: "MathAbsExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 778, col: 7, offset: 24119},
+						name: "MathAcosExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 779, col: 7, offset: 24144},
+						name: "MathAsinExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 780, col: 7, offset: 24169},
+						name: "MathAtanExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 781, col: 7, offset: 24194},
+						name: "MathCeilingExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 782, col: 7, offset: 24222},
+						name: "MathCosExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 783, col: 7, offset: 24246},
+						name: "MathCotExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 784, col: 7, offset: 24270},
+						name: "MathDegreesExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 785, col: 7, offset: 24298},
+						name: "MathExpExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 786, col: 7, offset: 24322},
+						name: "MathFloorExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 787, col: 7, offset: 24348},
+						name: "MathIntBitNotExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 788, col: 7, offset: 24378},
+						name: "MathLog10Expression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 789, col: 7, offset: 24404},
+						name: "MathRadiansExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 790, col: 7, offset: 24432},
+						name: "MathRoundExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 791, col: 7, offset: 24458},
+						name: "MathSignExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 792, col: 7, offset: 24483},
+						name: "MathSinExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 793, col: 7, offset: 24507},
+						name: "MathSqrtExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 794, col: 7, offset: 24532},
+						name: "MathSquareExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 795, col: 7, offset: 24559},
+						name: "MathTanExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 796, col: 7, offset: 24583},
+						name: "MathTruncExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 797, col: 7, offset: 24609},
+						name: "MathAtn2Expression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 798, col: 7, offset: 24634},
+						name: "MathIntAddExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 799, col: 7, offset: 24661},
+						name: "MathIntBitAndExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 800, col: 7, offset: 24691},
+						name: "MathIntBitLeftShiftExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 801, col: 7, offset: 24727},
+						name: "MathIntBitOrExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 802, col: 7, offset: 24756},
+						name: "MathIntBitRightShiftExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 803, col: 7, offset: 24793},
+						name: "MathIntBitXorExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 804, col: 7, offset: 24823},
+						name: "MathIntDivExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 805, col: 7, offset: 24850},
+						name: "MathIntModExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 806, col: 7, offset: 24877},
+						name: "MathIntMulExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 807, col: 7, offset: 24904},
+						name: "MathIntSubExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 808, col: 7, offset: 24931},
+						name: "MathPowerExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 809, col: 7, offset: 24957},
+						name: "MathLogExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 810, col: 7, offset: 24981},
+						name: "MathNumberBinExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 811, col: 7, offset: 25011},
+						name: "MathPiExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 812, col: 7, offset: 25034},
+						name: "MathRandExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "UpperExpression",
+			pos:  position{line: 814, col: 1, offset: 25054},
+			expr: &actionExpr{
+				pos: position{line: 814, col: 20, offset: 25073},
+				run: (*parser).callonUpperExpression1,
+				expr: &seqExpr{
+					pos: position{line: 814, col: 20, offset: 25073},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 814, col: 20, offset: 25073},
+							val:        "upper",
+							ignoreCase: true,
+							want:       "\"UPPER\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 814, col: 29, offset: 25082},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 814, col: 32, offset: 25085},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 814, col: 36, offset: 25089},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 814, col: 39, offset: 25092},
+								name: "SelectItem",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 814, col: 50, offset: 25103},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LowerExpression",
+			pos:  position{line: 818, col: 1, offset: 25188},
+			expr: &actionExpr{
+				pos: position{line: 818, col: 20, offset: 25207},
+				run: (*parser).callonLowerExpression1,
+				expr: &seqExpr{
+					pos: position{line: 818, col: 20, offset: 25207},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 818, col: 20, offset: 25207},
+							val:        "lower",
+							ignoreCase: true,
+							want:       "\"LOWER\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 818, col: 29, offset: 25216},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 818, col: 32, offset: 25219},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 818, col: 36, offset: 25223},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 818, col: 39, offset: 25226},
+								name: "SelectItem",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 818, col: 50, offset: 25237},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringEqualsExpression",
+			pos:  position{line: 822, col: 1, offset: 25322},
+			expr: &actionExpr{
+				pos: position{line: 822, col: 27, offset: 25348},
+				run: (*parser).callonStringEqualsExpression1,
+				expr: &seqExpr{
+					pos: position{line: 822, col: 27, offset: 25348},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 822, col: 27, offset: 25348},
+							val:        "stringequals",
+							ignoreCase: true,
+							want:       "\"STRINGEQUALS\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 822, col: 43, offset: 25364},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 822, col: 46, offset: 25367},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 822, col: 50, offset: 25371},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 822, col: 53, offset: 25374},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 822, col: 57, offset: 25378},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 822, col: 68, offset: 25389},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 822, col: 71, offset: 25392},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 822, col: 75, offset: 25396},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 822, col: 78, offset: 25399},
+							label: "ex2",
+							expr: &ruleRefExpr{
+								pos:  position{line: 822, col: 82, offset: 25403},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 822, col: 93, offset: 25414},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 822, col: 96, offset: 25417},
+							label: "ignoreCase",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 822, col: 107, offset: 25428},
+								expr: &actionExpr{
+									pos: position{line: 822, col: 108, offset: 25429},
+									run: (*parser).callonStringEqualsExpression17,
+									expr: &seqExpr{
+										pos: position{line: 822, col: 108, offset: 25429},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 822, col: 108, offset: 25429},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 822, col: 112, offset: 25433},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 822, col: 115, offset: 25436},
+												label: "boolean",
+												expr: &ruleRefExpr{
+													pos:  position{line: 822, col: 123, offset: 25444},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 822, col: 160, offset: 25481},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ToStringExpression",
+			pos:  position{line: 826, col: 1, offset: 25591},
+			expr: &actionExpr{
+				pos: position{line: 826, col: 23, offset: 25613},
+				run: (*parser).callonToStringExpression1,
+				expr: &seqExpr{
+					pos: position{line: 826, col: 23, offset: 25613},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 826, col: 23, offset: 25613},
+							val:        "tostring",
+							ignoreCase: true,
+							want:       "\"TOSTRING\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 826, col: 35, offset: 25625},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 826, col: 38, offset: 25628},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 826, col: 42, offset: 25632},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 826, col: 45, offset: 25635},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 826, col: 48, offset: 25638},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 826, col: 59, offset: 25649},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 826, col: 62, offset: 25652},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConcatExpression",
+			pos:  position{line: 830, col: 1, offset: 25740},
+			expr: &actionExpr{
+				pos: position{line: 830, col: 21, offset: 25760},
+				run: (*parser).callonConcatExpression1,
+				expr: &seqExpr{
+					pos: position{line: 830, col: 21, offset: 25760},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 830, col: 21, offset: 25760},
+							val:        "concat",
+							ignoreCase: true,
+							want:       "\"CONCAT\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 830, col: 31, offset: 25770},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 830, col: 34, offset: 25773},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 830, col: 38, offset: 25777},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 830, col: 41, offset: 25780},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 830, col: 45, offset: 25784},
+								name: "SelectItem",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 830, col: 56, offset: 25795},
+							label: "others",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 830, col: 63, offset: 25802},
+								expr: &actionExpr{
+									pos: position{line: 830, col: 64, offset: 25803},
+									run: (*parser).callonConcatExpression11,
+									expr: &seqExpr{
+										pos: position{line: 830, col: 64, offset: 25803},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 830, col: 64, offset: 25803},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 830, col: 67, offset: 25806},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 830, col: 71, offset: 25810},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 830, col: 74, offset: 25813},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 830, col: 77, offset: 25816},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 830, col: 109, offset: 25848},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 830, col: 112, offset: 25851},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LeftExpression",
+			pos:  position{line: 835, col: 1, offset: 26000},
+			expr: &actionExpr{
+				pos: position{line: 835, col: 19, offset: 26018},
+				run: (*parser).callonLeftExpression1,
+				expr: &seqExpr{
+					pos: position{line: 835, col: 19, offset: 26018},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 835, col: 19, offset: 26018},
+							val:        "left",
+							ignoreCase: true,
+							want:       "\"LEFT\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 835, col: 27, offset: 26026},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 835, col: 30, offset: 26029},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 835, col: 34, offset: 26033},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 835, col: 37, offset: 26036},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 835, col: 40, offset: 26039},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 835, col: 51, offset: 26050},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 835, col: 54, offset: 26053},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 835, col: 58, offset: 26057},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 835, col: 61, offset: 26060},
+							label: "length",
+							expr: &ruleRefExpr{
+								pos:  position{line: 835, col: 68, offset: 26067},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 835, col: 79, offset: 26078},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 835, col: 82, offset: 26081},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LengthExpression",
+			pos:  position{line: 839, col: 1, offset: 26173},
+			expr: &actionExpr{
+				pos: position{line: 839, col: 21, offset: 26193},
+				run: (*parser).callonLengthExpression1,
+				expr: &seqExpr{
+					pos: position{line: 839, col: 21, offset: 26193},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 839, col: 21, offset: 26193},
+							val:        "length",
+							ignoreCase: true,
+							want:       "\"LENGTH\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 839, col: 31, offset: 26203},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 839, col: 34, offset: 26206},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 839, col: 38, offset: 26210},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 839, col: 41, offset: 26213},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 839, col: 44, offset: 26216},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 839, col: 55, offset: 26227},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 839, col: 58, offset: 26230},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LTrimExpression",
+			pos:  position{line: 843, col: 1, offset: 26316},
+			expr: &actionExpr{
+				pos: position{line: 843, col: 20, offset: 26335},
+				run: (*parser).callonLTrimExpression1,
+				expr: &seqExpr{
+					pos: position{line: 843, col: 20, offset: 26335},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 843, col: 20, offset: 26335},
+							val:        "ltrim",
+							ignoreCase: true,
+							want:       "\"LTRIM\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 843, col: 29, offset: 26344},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 843, col: 32, offset: 26347},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 843, col: 36, offset: 26351},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 843, col: 39, offset: 26354},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 843, col: 42, offset: 26357},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 843, col: 53, offset: 26368},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 843, col: 56, offset: 26371},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ReplaceExpression",
+			pos:  position{line: 847, col: 1, offset: 26456},
+			expr: &actionExpr{
+				pos: position{line: 847, col: 22, offset: 26477},
+				run: (*parser).callonReplaceExpression1,
+				expr: &seqExpr{
+					pos: position{line: 847, col: 22, offset: 26477},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 847, col: 22, offset: 26477},
+							val:        "replace",
+							ignoreCase: true,
+							want:       "\"REPLACE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 847, col: 33, offset: 26488},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 847, col: 36, offset: 26491},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 847, col: 40, offset: 26495},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 847, col: 43, offset: 26498},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 847, col: 47, offset: 26502},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 847, col: 58, offset: 26513},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 847, col: 61, offset: 26516},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 847, col: 65, offset: 26520},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 847, col: 68, offset: 26523},
+							label: "ex2",
+							expr: &ruleRefExpr{
+								pos:  position{line: 847, col: 72, offset: 26527},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 847, col: 83, offset: 26538},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 847, col: 86, offset: 26541},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 847, col: 90, offset: 26545},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 847, col: 93, offset: 26548},
+							label: "ex3",
+							expr: &ruleRefExpr{
+								pos:  position{line: 847, col: 97, offset: 26552},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 847, col: 108, offset: 26563},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 847, col: 111, offset: 26566},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ReplicateExpression",
+			pos:  position{line: 851, col: 1, offset: 26664},
+			expr: &actionExpr{
+				pos: position{line: 851, col: 24, offset: 26687},
+				run: (*parser).callonReplicateExpression1,
+				expr: &seqExpr{
+					pos: position{line: 851, col: 24, offset: 26687},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 851, col: 24, offset: 26687},
+							val:        "replicate",
+							ignoreCase: true,
+							want:       "\"REPLICATE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 851, col: 37, offset: 26700},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 851, col: 40, offset: 26703},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 851, col: 44, offset: 26707},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 851, col: 47, offset: 26710},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 851, col: 51, offset: 26714},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 851, col: 62, offset: 26725},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 851, col: 65, offset: 26728},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 851, col: 69, offset: 26732},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 851, col: 72, offset: 26735},
+							label: "ex2",
+							expr: &ruleRefExpr{
+								pos:  position{line: 851, col: 76, offset: 26739},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 851, col: 87, offset: 26750},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 851, col: 90, offset: 26753},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ReverseExpression",
+			pos:  position{line: 855, col: 1, offset: 26848},
+			expr: &actionExpr{
+				pos: position{line: 855, col: 22, offset: 26869},
+				run: (*parser).callonReverseExpression1,
+				expr: &seqExpr{
+					pos: position{line: 855, col: 22, offset: 26869},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 855, col: 22, offset: 26869},
+							val:        "reverse",
+							ignoreCase: true,
+							want:       "\"REVERSE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 855, col: 33, offset: 26880},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 855, col: 36, offset: 26883},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 855, col: 40, offset: 26887},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 855, col: 43, offset: 26890},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 855, col: 46, offset: 26893},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 855, col: 57, offset: 26904},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 855, col: 60, offset: 26907},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RightExpression",
+			pos:  position{line: 859, col: 1, offset: 26994},
+			expr: &actionExpr{
+				pos: position{line: 859, col: 20, offset: 27013},
+				run: (*parser).callonRightExpression1,
+				expr: &seqExpr{
+					pos: position{line: 859, col: 20, offset: 27013},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 859, col: 20, offset: 27013},
+							val:        "right",
+							ignoreCase: true,
+							want:       "\"RIGHT\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 859, col: 29, offset: 27022},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 859, col: 32, offset: 27025},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 859, col: 36, offset: 27029},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 859, col: 39, offset: 27032},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 859, col: 42, offset: 27035},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 859, col: 53, offset: 27046},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 859, col: 56, offset: 27049},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 859, col: 60, offset: 27053},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 859, col: 63, offset: 27056},
+							label: "length",
+							expr: &ruleRefExpr{
+								pos:  position{line: 859, col: 70, offset: 27063},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 859, col: 81, offset: 27074},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 859, col: 84, offset: 27077},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "RTrimExpression",
+			pos:  position{line: 863, col: 1, offset: 27170},
+			expr: &actionExpr{
+				pos: position{line: 863, col: 20, offset: 27189},
+				run: (*parser).callonRTrimExpression1,
+				expr: &seqExpr{
+					pos: position{line: 863, col: 20, offset: 27189},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 863, col: 20, offset: 27189},
+							val:        "rtrim",
+							ignoreCase: true,
+							want:       "\"RTRIM\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 863, col: 29, offset: 27198},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 863, col: 32, offset: 27201},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 863, col: 36, offset: 27205},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 863, col: 39, offset: 27208},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 863, col: 42, offset: 27211},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 863, col: 53, offset: 27222},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 863, col: 56, offset: 27225},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SubstringExpression",
+			pos:  position{line: 867, col: 1, offset: 27310},
+			expr: &actionExpr{
+				pos: position{line: 867, col: 24, offset: 27333},
+				run: (*parser).callonSubstringExpression1,
+				expr: &seqExpr{
+					pos: position{line: 867, col: 24, offset: 27333},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 867, col: 24, offset: 27333},
+							val:        "substring",
+							ignoreCase: true,
+							want:       "\"SUBSTRING\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 37, offset: 27346},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 867, col: 40, offset: 27349},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 44, offset: 27353},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 867, col: 47, offset: 27356},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 867, col: 50, offset: 27359},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 61, offset: 27370},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 867, col: 64, offset: 27373},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 68, offset: 27377},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 867, col: 71, offset: 27380},
+							label: "startPos",
+							expr: &ruleRefExpr{
+								pos:  position{line: 867, col: 80, offset: 27389},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 91, offset: 27400},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 867, col: 94, offset: 27403},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 98, offset: 27407},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 867, col: 101, offset: 27410},
+							label: "length",
+							expr: &ruleRefExpr{
+								pos:  position{line: 867, col: 108, offset: 27417},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 867, col: 119, offset: 27428},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 867, col: 122, offset: 27431},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TrimExpression",
+			pos:  position{line: 871, col: 1, offset: 27538},
+			expr: &actionExpr{
+				pos: position{line: 871, col: 19, offset: 27556},
+				run: (*parser).callonTrimExpression1,
+				expr: &seqExpr{
+					pos: position{line: 871, col: 19, offset: 27556},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 871, col: 19, offset: 27556},
+							val:        "trim",
+							ignoreCase: true,
+							want:       "\"TRIM\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 871, col: 27, offset: 27564},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 871, col: 30, offset: 27567},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 871, col: 34, offset: 27571},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 871, col: 37, offset: 27574},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 871, col: 40, offset: 27577},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 871, col: 51, offset: 27588},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 871, col: 54, offset: 27591},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringToArrayExpression",
+			pos:  position{line: 875, col: 1, offset: 27675},
+			expr: &actionExpr{
+				pos: position{line: 875, col: 28, offset: 27702},
+				run: (*parser).callonStringToArrayExpression1,
+				expr: &seqExpr{
+					pos: position{line: 875, col: 28, offset: 27702},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 875, col: 28, offset: 27702},
+							val:        "stringtoarray",
+							ignoreCase: true,
+							want:       "\"StringToArray\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 875, col: 45, offset: 27719},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 875, col: 48, offset: 27722},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 875, col: 52, offset: 27726},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 875, col: 55, offset: 27729},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 875, col: 58, offset: 27732},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 875, col: 69, offset: 27743},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 875, col: 72, offset: 27746},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringToBooleanExpression",
+			pos:  position{line: 879, col: 1, offset: 27839},
+			expr: &actionExpr{
+				pos: position{line: 879, col: 30, offset: 27868},
+				run: (*parser).callonStringToBooleanExpression1,
+				expr: &seqExpr{
+					pos: position{line: 879, col: 30, offset: 27868},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 879, col: 30, offset: 27868},
+							val:        "stringtoboolean",
+							ignoreCase: true,
+							want:       "\"StringToBoolean\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 879, col: 49, offset: 27887},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 879, col: 52, offset: 27890},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 879, col: 56, offset: 27894},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 879, col: 59, offset: 27897},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 879, col: 62, offset: 27900},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 879, col: 73, offset: 27911},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 879, col: 76, offset: 27914},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringToNullExpression",
+			pos:  position{line: 883, col: 1, offset: 28009},
+			expr: &actionExpr{
+				pos: position{line: 883, col: 27, offset: 28035},
+				run: (*parser).callonStringToNullExpression1,
+				expr: &seqExpr{
+					pos: position{line: 883, col: 27, offset: 28035},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 883, col: 27, offset: 28035},
+							val:        "stringtonull",
+							ignoreCase: true,
+							want:       "\"StringToNull\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 883, col: 43, offset: 28051},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 883, col: 46, offset: 28054},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 883, col: 50, offset: 28058},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 883, col: 53, offset: 28061},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 883, col: 56, offset: 28064},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 883, col: 67, offset: 28075},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 883, col: 70, offset: 28078},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringToNumberExpression",
+			pos:  position{line: 887, col: 1, offset: 28170},
+			expr: &actionExpr{
+				pos: position{line: 887, col: 29, offset: 28198},
+				run: (*parser).callonStringToNumberExpression1,
+				expr: &seqExpr{
+					pos: position{line: 887, col: 29, offset: 28198},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 887, col: 29, offset: 28198},
+							val:        "stringtonumber",
+							ignoreCase: true,
+							want:       "\"StringToNumber\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 887, col: 47, offset: 28216},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 887, col: 50, offset: 28219},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 887, col: 54, offset: 28223},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 887, col: 57, offset: 28226},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 887, col: 60, offset: 28229},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 887, col: 71, offset: 28240},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 887, col: 74, offset: 28243},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringToObjectExpression",
+			pos:  position{line: 891, col: 1, offset: 28337},
+			expr: &actionExpr{
+				pos: position{line: 891, col: 29, offset: 28365},
+				run: (*parser).callonStringToObjectExpression1,
+				expr: &seqExpr{
+					pos: position{line: 891, col: 29, offset: 28365},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 891, col: 29, offset: 28365},
+							val:        "stringtoobject",
+							ignoreCase: true,
+							want:       "\"StringToObject\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 891, col: 47, offset: 28383},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 891, col: 50, offset: 28386},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 891, col: 54, offset: 28390},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 891, col: 57, offset: 28393},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 891, col: 60, offset: 28396},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 891, col: 71, offset: 28407},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 891, col: 74, offset: 28410},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ThreeArgumentStringFunctionExpression",
+			pos:  position{line: 895, col: 1, offset: 28504},
+			expr: &actionExpr{
+				pos: position{line: 895, col: 42, offset: 28545},
+				run: (*parser).callonThreeArgumentStringFunctionExpression1,
+				expr: &seqExpr{
+					pos: position{line: 895, col: 42, offset: 28545},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 895, col: 42, offset: 28545},
+							label: "function",
+							expr: &ruleRefExpr{
+								pos:  position{line: 895, col: 51, offset: 28554},
+								name: "ThreeArgumentStringFunction",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 895, col: 79, offset: 28582},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 895, col: 82, offset: 28585},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 895, col: 86, offset: 28589},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 895, col: 89, offset: 28592},
+							label: "ex1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 895, col: 93, offset: 28596},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 895, col: 104, offset: 28607},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 895, col: 107, offset: 28610},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 895, col: 111, offset: 28614},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 895, col: 114, offset: 28617},
+							label: "ex2",
+							expr: &ruleRefExpr{
+								pos:  position{line: 895, col: 118, offset: 28621},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 895, col: 129, offset: 28632},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 895, col: 132, offset: 28635},
+							label: "ignoreCase",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 895, col: 143, offset: 28646},
+								expr: &actionExpr{
+									pos: position{line: 895, col: 144, offset: 28647},
+									run: (*parser).callonThreeArgumentStringFunctionExpression18,
+									expr: &seqExpr{
+										pos: position{line: 895, col: 144, offset: 28647},
+										exprs: []any{
+											&litMatcher{
+												pos:        position{line: 895, col: 144, offset: 28647},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 895, col: 148, offset: 28651},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 895, col: 151, offset: 28654},
+												label: "boolean",
+												expr: &ruleRefExpr{
+													pos:  position{line: 895, col: 159, offset: 28662},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 895, col: 196, offset: 28699},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ThreeArgumentStringFunction",
+			pos:  position{line: 915, col: 1, offset: 29298},
+			expr: &actionExpr{
+				pos: position{line: 915, col: 32, offset: 29329},
+				run: (*parser).callonThreeArgumentStringFunction1,
+				expr: &choiceExpr{
+					pos: position{line: 915, col: 33, offset: 29330},
+					alternatives: []any{
+						&litMatcher{
+							pos:        position{line: 915, col: 33, offset: 29330},
+							val:        "contains",
+							ignoreCase: true,
+							want:       "\"CONTAINS\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 915, col: 47, offset: 29344},
+							val:        "endswith",
+							ignoreCase: true,
+							want:       "\"ENDSWITH\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 915, col: 61, offset: 29358},
+							val:        "startswith",
+							ignoreCase: true,
+							want:       "\"STARTSWITH\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 915, col: 77, offset: 29374},
+							val:        "regexmatch",
+							ignoreCase: true,
+							want:       "\"REGEXMATCH\"i",
+						},
+						&litMatcher{
+							pos:        position{line: 915, col: 93, offset: 29390},
+							val:        "index_of",
+							ignoreCase: true,
+							want:       "\"INDEX_OF\"i",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsDefined",
+			pos:  position{line: 919, col: 1, offset: 29439},
+			expr: &actionExpr{
+				pos: position{line: 919, col: 14, offset: 29452},
+				run: (*parser).callonIsDefined1,
+				expr: &seqExpr{
+					pos: position{line: 919, col: 14, offset: 29452},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 919, col: 14, offset: 29452},
+							val:        "is_defined",
+							ignoreCase: true,
+							want:       "\"IS_DEFINED\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 919, col: 28, offset: 29466},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 919, col: 31, offset: 29469},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 919, col: 35, offset: 29473},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 919, col: 38, offset: 29476},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 919, col: 41, offset: 29479},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 919, col: 52, offset: 29490},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 919, col: 55, offset: 29493},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsArray",
+			pos:  position{line: 923, col: 1, offset: 29582},
+			expr: &actionExpr{
+				pos: position{line: 923, col: 12, offset: 29593},
+				run: (*parser).callonIsArray1,
+				expr: &seqExpr{
+					pos: position{line: 923, col: 12, offset: 29593},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 923, col: 12, offset: 29593},
+							val:        "is_array",
+							ignoreCase: true,
+							want:       "\"IS_ARRAY\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 923, col: 24, offset: 29605},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 923, col: 27, offset: 29608},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 923, col: 31, offset: 29612},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 923, col: 34, offset: 29615},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 923, col: 37, offset: 29618},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 923, col: 48, offset: 29629},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 923, col: 51, offset: 29632},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsBool",
+			pos:  position{line: 927, col: 1, offset: 29719},
+			expr: &actionExpr{
+				pos: position{line: 927, col: 11, offset: 29729},
+				run: (*parser).callonIsBool1,
+				expr: &seqExpr{
+					pos: position{line: 927, col: 11, offset: 29729},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 927, col: 11, offset: 29729},
+							val:        "is_bool",
+							ignoreCase: true,
+							want:       "\"IS_BOOL\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 927, col: 22, offset: 29740},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 927, col: 25, offset: 29743},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 927, col: 29, offset: 29747},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 927, col: 32, offset: 29750},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 927, col: 35, offset: 29753},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 927, col: 46, offset: 29764},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 927, col: 49, offset: 29767},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsFiniteNumber",
+			pos:  position{line: 931, col: 1, offset: 29853},
+			expr: &actionExpr{
+				pos: position{line: 931, col: 19, offset: 29871},
+				run: (*parser).callonIsFiniteNumber1,
+				expr: &seqExpr{
+					pos: position{line: 931, col: 19, offset: 29871},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 931, col: 19, offset: 29871},
+							val:        "is_finite_number",
+							ignoreCase: true,
+							want:       "\"IS_FINITE_NUMBER\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 931, col: 39, offset: 29891},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 931, col: 42, offset: 29894},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 931, col: 46, offset: 29898},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 931, col: 49, offset: 29901},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 931, col: 52, offset: 29904},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 931, col: 63, offset: 29915},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 931, col: 66, offset: 29918},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsInteger",
+			pos:  position{line: 935, col: 1, offset: 30012},
+			expr: &actionExpr{
+				pos: position{line: 935, col: 14, offset: 30025},
+				run: (*parser).callonIsInteger1,
+				expr: &seqExpr{
+					pos: position{line: 935, col: 14, offset: 30025},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 935, col: 14, offset: 30025},
+							val:        "is_integer",
+							ignoreCase: true,
+							want:       "\"IS_INTEGER\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 935, col: 28, offset: 30039},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 935, col: 31, offset: 30042},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 935, col: 35, offset: 30046},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 935, col: 38, offset: 30049},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 935, col: 41, offset: 30052},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 935, col: 52, offset: 30063},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 935, col: 55, offset: 30066},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsNull",
+			pos:  position{line: 939, col: 1, offset: 30155},
+			expr: &actionExpr{
+				pos: position{line: 939, col: 11, offset: 30165},
+				run: (*parser).callonIsNull1,
+				expr: &seqExpr{
+					pos: position{line: 939, col: 11, offset: 30165},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 939, col: 11, offset: 30165},
+							val:        "is_null",
+							ignoreCase: true,
+							want:       "\"IS_NULL\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 939, col: 22, offset: 30176},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 939, col: 25, offset: 30179},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 939, col: 29, offset: 30183},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 939, col: 32, offset: 30186},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 939, col: 35, offset: 30189},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 939, col: 46, offset: 30200},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 939, col: 49, offset: 30203},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsNumber",
+			pos:  position{line: 943, col: 1, offset: 30289},
+			expr: &actionExpr{
+				pos: position{line: 943, col: 13, offset: 30301},
+				run: (*parser).callonIsNumber1,
+				expr: &seqExpr{
+					pos: position{line: 943, col: 13, offset: 30301},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 943, col: 13, offset: 30301},
+							val:        "is_number",
+							ignoreCase: true,
+							want:       "\"IS_NUMBER\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 943, col: 26, offset: 30314},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 943, col: 29, offset: 30317},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 943, col: 33, offset: 30321},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 943, col: 36, offset: 30324},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 943, col: 39, offset: 30327},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 943, col: 50, offset: 30338},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 943, col: 53, offset: 30341},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsObject",
+			pos:  position{line: 947, col: 1, offset: 30429},
+			expr: &actionExpr{
+				pos: position{line: 947, col: 13, offset: 30441},
+				run: (*parser).callonIsObject1,
+				expr: &seqExpr{
+					pos: position{line: 947, col: 13, offset: 30441},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 947, col: 13, offset: 30441},
+							val:        "is_object",
+							ignoreCase: true,
+							want:       "\"IS_OBJECT\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 947, col: 26, offset: 30454},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 947, col: 29, offset: 30457},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 947, col: 33, offset: 30461},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 947, col: 36, offset: 30464},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 947, col: 39, offset: 30467},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 947, col: 50, offset: 30478},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 947, col: 53, offset: 30481},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IsPrimitive",
+			pos:  position{line: 951, col: 1, offset: 30569},
+			expr: &actionExpr{
+				pos: position{line: 951, col: 16, offset: 30584},
+				run: (*parser).callonIsPrimitive1,
+				expr: &seqExpr{
+					pos: position{line: 951, col: 16, offset: 30584},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 951, col: 16, offset: 30584},
+							val:        "is_primitive",
+							ignoreCase: true,
+							want:       "\"IS_PRIMITIVE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 951, col: 32, offset: 30600},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 951, col: 35, offset: 30603},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 951, col: 39, offset: 30607},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 951, col: 42, offset: 30610},
+							label: "ex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 951, col: 45, offset: 30613},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 951, col: 56, offset: 30624},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 951, col: 59, offset: 30627},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "UpperExpression",
-			pos:  position{line: 612, col: 1, offset: 17857},
+			name: "IsString",
+			pos:  position{line: 955, col: 1, offset: 30718},
 			expr: &actionExpr{
-				pos: position{line: 612, col: 20, offset: 17876},
-				run: (*parser).callonUpperExpression1,
+				pos: position{line: 955, col: 13, offset: 30730},
+				run: (*parser).callonIsString1,
 				expr: &seqExpr{
-					pos: position{line: 612, col: 20, offset: 17876},
+					pos: position{line: 955, col: 13, offset: 30730},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 612, col: 20, offset: 17876},
-							val:        "upper",
+							pos:        position{line: 955, col: 13, offset: 30730},
+							val:        "is_string",
 							ignoreCase: true,
-							want:       "\"UPPER\"i",
+							want:       "\"IS_STRING\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 612, col: 29, offset: 17885},
+							pos:  position{line: 955, col: 26, offset: 30743},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 612, col: 32, offset: 17888},
+							pos:        position{line: 955, col: 29, offset: 30746},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 955, col: 33, offset: 30750},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 612, col: 36, offset: 17892},
+							pos:   position{line: 955, col: 36, offset: 30753},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 612, col: 39, offset: 17895},
+								pos:  position{line: 955, col: 39, offset: 30756},
 								name: "SelectItem",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 955, col: 50, offset: 30767},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 612, col: 50, offset: 17906},
+							pos:        position{line: 955, col: 53, offset: 30770},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3138,40 +6222,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LowerExpression",
-			pos:  position{line: 616, col: 1, offset: 17991},
+			name: "ArrayConcatExpression",
+			pos:  position{line: 959, col: 1, offset: 30858},
 			expr: &actionExpr{
-				pos: position{line: 616, col: 20, offset: 18010},
-				run: (*parser).callonLowerExpression1,
+				pos: position{line: 959, col: 26, offset: 30883},
+				run: (*parser).callonArrayConcatExpression1,
 				expr: &seqExpr{
-					pos: position{line: 616, col: 20, offset: 18010},
+					pos: position{line: 959, col: 26, offset: 30883},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 616, col: 20, offset: 18010},
-							val:        "lower",
+							pos:        position{line: 959, col: 26, offset: 30883},
+							val:        "array_concat",
 							ignoreCase: true,
-							want:       "\"LOWER\"i",
+							want:       "\"ARRAY_CONCAT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 616, col: 29, offset: 18019},
+							pos:  position{line: 959, col: 42, offset: 30899},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 616, col: 32, offset: 18022},
+							pos:        position{line: 959, col: 45, offset: 30902},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
+						&ruleRefExpr{
+							pos:  position{line: 959, col: 49, offset: 30906},
+							name: "ws",
+						},
 						&labeledExpr{
-							pos:   position{line: 616, col: 36, offset: 18026},
-							label: "ex",
+							pos:   position{line: 959, col: 52, offset: 30909},
+							label: "arrays",
 							expr: &ruleRefExpr{
-								pos:  position{line: 616, col: 39, offset: 18029},
+								pos:  position{line: 959, col: 59, offset: 30916},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 959, col: 70, offset: 30927},
+							label: "others",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 959, col: 77, offset: 30934},
+								expr: &actionExpr{
+									pos: position{line: 959, col: 78, offset: 30935},
+									run: (*parser).callonArrayConcatExpression11,
+									expr: &seqExpr{
+										pos: position{line: 959, col: 78, offset: 30935},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 959, col: 78, offset: 30935},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 959, col: 81, offset: 30938},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 959, col: 85, offset: 30942},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 959, col: 88, offset: 30945},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 959, col: 91, offset: 30948},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 959, col: 123, offset: 30980},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 616, col: 50, offset: 18040},
+							pos:        position{line: 959, col: 126, offset: 30983},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3181,94 +6311,94 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "StringEqualsExpression",
-			pos:  position{line: 620, col: 1, offset: 18125},
+			name: "ArrayContainsExpression",
+			pos:  position{line: 963, col: 1, offset: 31113},
 			expr: &actionExpr{
-				pos: position{line: 620, col: 27, offset: 18151},
-				run: (*parser).callonStringEqualsExpression1,
+				pos: position{line: 963, col: 28, offset: 31140},
+				run: (*parser).callonArrayContainsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 620, col: 27, offset: 18151},
+					pos: position{line: 963, col: 28, offset: 31140},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 620, col: 27, offset: 18151},
-							val:        "stringequals",
+							pos:        position{line: 963, col: 28, offset: 31140},
+							val:        "array_contains",
 							ignoreCase: true,
-							want:       "\"STRINGEQUALS\"i",
+							want:       "\"ARRAY_CONTAINS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 43, offset: 18167},
+							pos:  position{line: 963, col: 46, offset: 31158},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 620, col: 46, offset: 18170},
+							pos:        position{line: 963, col: 49, offset: 31161},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 50, offset: 18174},
+							pos:  position{line: 963, col: 53, offset: 31165},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 620, col: 53, offset: 18177},
-							label: "ex1",
+							pos:   position{line: 963, col: 56, offset: 31168},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 620, col: 57, offset: 18181},
+								pos:  position{line: 963, col: 62, offset: 31174},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 68, offset: 18192},
+							pos:  position{line: 963, col: 73, offset: 31185},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 620, col: 71, offset: 18195},
+							pos:        position{line: 963, col: 76, offset: 31188},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 620, col: 75, offset: 18199},
+							pos:  position{line: 963, col: 80, offset: 31192},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 620, col: 78, offset: 18202},
-							label: "ex2",
+							pos:   position{line: 963, col: 83, offset: 31195},
+							label: "item",
 							expr: &ruleRefExpr{
-								pos:  position{line: 620, col: 82, offset: 18206},
+								pos:  position{line: 963, col: 88, offset: 31200},
 								name: "SelectItem",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 620, col: 93, offset: 18217},
-							name: "ws",
-						},
 						&labeledExpr{
-							pos:   position{line: 620, col: 96, offset: 18220},
-							label: "ignoreCase",
+							pos:   position{line: 963, col: 99, offset: 31211},
+							label: "partialMatch",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 620, col: 107, offset: 18231},
+								pos: position{line: 963, col: 112, offset: 31224},
 								expr: &actionExpr{
-									pos: position{line: 620, col: 108, offset: 18232},
-									run: (*parser).callonStringEqualsExpression17,
+									pos: position{line: 963, col: 113, offset: 31225},
+									run: (*parser).callonArrayContainsExpression16,
 									expr: &seqExpr{
-										pos: position{line: 620, col: 108, offset: 18232},
+										pos: position{line: 963, col: 113, offset: 31225},
 										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 963, col: 113, offset: 31225},
+												name: "ws",
+											},
 											&litMatcher{
-												pos:        position{line: 620, col: 108, offset: 18232},
+												pos:        position{line: 963, col: 116, offset: 31228},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 620, col: 112, offset: 18236},
+												pos:  position{line: 963, col: 120, offset: 31232},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 620, col: 115, offset: 18239},
-												label: "boolean",
+												pos:   position{line: 963, col: 123, offset: 31235},
+												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 620, col: 123, offset: 18247},
+													pos:  position{line: 963, col: 126, offset: 31238},
 													name: "SelectItem",
 												},
 											},
@@ -3277,8 +6407,12 @@ var g = &grammar{
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 963, col: 158, offset: 31270},
+							name: "ws",
+						},
 						&litMatcher{
-							pos:        position{line: 620, col: 160, offset: 18284},
+							pos:        position{line: 963, col: 161, offset: 31273},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3288,48 +6422,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ToStringExpression",
-			pos:  position{line: 624, col: 1, offset: 18394},
+			name: "ArrayContainsAnyExpression",
+			pos:  position{line: 967, col: 1, offset: 31389},
 			expr: &actionExpr{
-				pos: position{line: 624, col: 23, offset: 18416},
-				run: (*parser).callonToStringExpression1,
+				pos: position{line: 967, col: 31, offset: 31419},
+				run: (*parser).callonArrayContainsAnyExpression1,
 				expr: &seqExpr{
-					pos: position{line: 624, col: 23, offset: 18416},
+					pos: position{line: 967, col: 31, offset: 31419},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 624, col: 23, offset: 18416},
-							val:        "tostring",
+							pos:        position{line: 967, col: 31, offset: 31419},
+							val:        "array_contains_any",
 							ignoreCase: true,
-							want:       "\"TOSTRING\"i",
+							want:       "\"ARRAY_CONTAINS_ANY\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 35, offset: 18428},
+							pos:  position{line: 967, col: 53, offset: 31441},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 624, col: 38, offset: 18431},
+							pos:        position{line: 967, col: 56, offset: 31444},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 42, offset: 18435},
+							pos:  position{line: 967, col: 60, offset: 31448},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 624, col: 45, offset: 18438},
-							label: "ex",
+							pos:   position{line: 967, col: 63, offset: 31451},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 624, col: 48, offset: 18441},
+								pos:  position{line: 967, col: 69, offset: 31457},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 967, col: 80, offset: 31468},
+							label: "items",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 967, col: 86, offset: 31474},
+								expr: &actionExpr{
+									pos: position{line: 967, col: 87, offset: 31475},
+									run: (*parser).callonArrayContainsAnyExpression11,
+									expr: &seqExpr{
+										pos: position{line: 967, col: 87, offset: 31475},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 967, col: 87, offset: 31475},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 967, col: 90, offset: 31478},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 967, col: 94, offset: 31482},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 967, col: 97, offset: 31485},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 967, col: 100, offset: 31488},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 624, col: 59, offset: 18452},
+							pos:  position{line: 967, col: 132, offset: 31520},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 624, col: 62, offset: 18455},
+							pos:        position{line: 967, col: 135, offset: 31523},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3339,72 +6511,72 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ConcatExpression",
-			pos:  position{line: 628, col: 1, offset: 18543},
+			name: "ArrayContainsAllExpression",
+			pos:  position{line: 971, col: 1, offset: 31656},
 			expr: &actionExpr{
-				pos: position{line: 628, col: 21, offset: 18563},
-				run: (*parser).callonConcatExpression1,
+				pos: position{line: 971, col: 31, offset: 31686},
+				run: (*parser).callonArrayContainsAllExpression1,
 				expr: &seqExpr{
-					pos: position{line: 628, col: 21, offset: 18563},
+					pos: position{line: 971, col: 31, offset: 31686},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 628, col: 21, offset: 18563},
-							val:        "concat",
+							pos:        position{line: 971, col: 31, offset: 31686},
+							val:        "array_contains_all",
 							ignoreCase: true,
-							want:       "\"CONCAT\"i",
+							want:       "\"ARRAY_CONTAINS_ALL\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 628, col: 31, offset: 18573},
+							pos:  position{line: 971, col: 53, offset: 31708},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 628, col: 34, offset: 18576},
+							pos:        position{line: 971, col: 56, offset: 31711},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 628, col: 38, offset: 18580},
+							pos:  position{line: 971, col: 60, offset: 31715},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 628, col: 41, offset: 18583},
-							label: "ex1",
+							pos:   position{line: 971, col: 63, offset: 31718},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 628, col: 45, offset: 18587},
+								pos:  position{line: 971, col: 69, offset: 31724},
 								name: "SelectItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 628, col: 56, offset: 18598},
-							label: "others",
+							pos:   position{line: 971, col: 80, offset: 31735},
+							label: "items",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 628, col: 63, offset: 18605},
+								pos: position{line: 971, col: 86, offset: 31741},
 								expr: &actionExpr{
-									pos: position{line: 628, col: 64, offset: 18606},
-									run: (*parser).callonConcatExpression11,
+									pos: position{line: 971, col: 87, offset: 31742},
+									run: (*parser).callonArrayContainsAllExpression11,
 									expr: &seqExpr{
-										pos: position{line: 628, col: 64, offset: 18606},
+										pos: position{line: 971, col: 87, offset: 31742},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 628, col: 64, offset: 18606},
+												pos:  position{line: 971, col: 87, offset: 31742},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 628, col: 67, offset: 18609},
+												pos:        position{line: 971, col: 90, offset: 31745},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 628, col: 71, offset: 18613},
+												pos:  position{line: 971, col: 94, offset: 31749},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 628, col: 74, offset: 18616},
+												pos:   position{line: 971, col: 97, offset: 31752},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 628, col: 77, offset: 18619},
+													pos:  position{line: 971, col: 100, offset: 31755},
 													name: "SelectItem",
 												},
 											},
@@ -3414,11 +6586,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 628, col: 109, offset: 18651},
+							pos:  position{line: 971, col: 132, offset: 31787},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 628, col: 112, offset: 18654},
+							pos:        position{line: 971, col: 135, offset: 31790},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3428,70 +6600,159 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LeftExpression",
-			pos:  position{line: 633, col: 1, offset: 18803},
+			name: "ArrayLengthExpression",
+			pos:  position{line: 975, col: 1, offset: 31923},
 			expr: &actionExpr{
-				pos: position{line: 633, col: 19, offset: 18821},
-				run: (*parser).callonLeftExpression1,
+				pos: position{line: 975, col: 26, offset: 31948},
+				run: (*parser).callonArrayLengthExpression1,
 				expr: &seqExpr{
-					pos: position{line: 633, col: 19, offset: 18821},
+					pos: position{line: 975, col: 26, offset: 31948},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 633, col: 19, offset: 18821},
-							val:        "left",
+							pos:        position{line: 975, col: 26, offset: 31948},
+							val:        "array_length",
 							ignoreCase: true,
-							want:       "\"LEFT\"i",
+							want:       "\"ARRAY_LENGTH\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 27, offset: 18829},
+							pos:  position{line: 975, col: 42, offset: 31964},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 633, col: 30, offset: 18832},
+							pos:        position{line: 975, col: 45, offset: 31967},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 34, offset: 18836},
+							pos:  position{line: 975, col: 49, offset: 31971},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 633, col: 37, offset: 18839},
-							label: "ex",
+							pos:   position{line: 975, col: 52, offset: 31974},
+							label: "array",
+							expr: &ruleRefExpr{
+								pos:  position{line: 975, col: 58, offset: 31980},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 975, col: 69, offset: 31991},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 975, col: 72, offset: 31994},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ArraySliceExpression",
+			pos:  position{line: 979, col: 1, offset: 32088},
+			expr: &actionExpr{
+				pos: position{line: 979, col: 25, offset: 32112},
+				run: (*parser).callonArraySliceExpression1,
+				expr: &seqExpr{
+					pos: position{line: 979, col: 25, offset: 32112},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 979, col: 25, offset: 32112},
+							val:        "array_slice",
+							ignoreCase: true,
+							want:       "\"ARRAY_SLICE\"i",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 979, col: 40, offset: 32127},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 979, col: 43, offset: 32130},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 979, col: 47, offset: 32134},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 979, col: 50, offset: 32137},
+							label: "array",
 							expr: &ruleRefExpr{
-								pos:  position{line: 633, col: 40, offset: 18842},
+								pos:  position{line: 979, col: 56, offset: 32143},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 51, offset: 18853},
+							pos:  position{line: 979, col: 67, offset: 32154},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 633, col: 54, offset: 18856},
+							pos:        position{line: 979, col: 70, offset: 32157},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 58, offset: 18860},
+							pos:  position{line: 979, col: 74, offset: 32161},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 633, col: 61, offset: 18863},
-							label: "length",
+							pos:   position{line: 979, col: 77, offset: 32164},
+							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 633, col: 68, offset: 18870},
+								pos:  position{line: 979, col: 83, offset: 32170},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 979, col: 94, offset: 32181},
+							label: "length",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 979, col: 101, offset: 32188},
+								expr: &actionExpr{
+									pos: position{line: 979, col: 102, offset: 32189},
+									run: (*parser).callonArraySliceExpression16,
+									expr: &seqExpr{
+										pos: position{line: 979, col: 102, offset: 32189},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 979, col: 102, offset: 32189},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 979, col: 105, offset: 32192},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 979, col: 109, offset: 32196},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 979, col: 112, offset: 32199},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 979, col: 115, offset: 32202},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 633, col: 79, offset: 18881},
+							pos:  position{line: 979, col: 147, offset: 32234},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 633, col: 82, offset: 18884},
+							pos:        position{line: 979, col: 150, offset: 32237},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3501,48 +6762,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LengthExpression",
-			pos:  position{line: 637, col: 1, offset: 18976},
+			name: "ChooseExpression",
+			pos:  position{line: 983, col: 1, offset: 32345},
 			expr: &actionExpr{
-				pos: position{line: 637, col: 21, offset: 18996},
-				run: (*parser).callonLengthExpression1,
+				pos: position{line: 983, col: 21, offset: 32365},
+				run: (*parser).callonChooseExpression1,
 				expr: &seqExpr{
-					pos: position{line: 637, col: 21, offset: 18996},
+					pos: position{line: 983, col: 21, offset: 32365},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 637, col: 21, offset: 18996},
-							val:        "length",
+							pos:        position{line: 983, col: 21, offset: 32365},
+							val:        "choose",
 							ignoreCase: true,
-							want:       "\"LENGTH\"i",
+							want:       "\"CHOOSE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 637, col: 31, offset: 19006},
+							pos:  position{line: 983, col: 31, offset: 32375},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 637, col: 34, offset: 19009},
+							pos:        position{line: 983, col: 34, offset: 32378},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 637, col: 38, offset: 19013},
+							pos:  position{line: 983, col: 38, offset: 32382},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 637, col: 41, offset: 19016},
-							label: "ex",
+							pos:   position{line: 983, col: 41, offset: 32385},
+							label: "index",
 							expr: &ruleRefExpr{
-								pos:  position{line: 637, col: 44, offset: 19019},
+								pos:  position{line: 983, col: 47, offset: 32391},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 983, col: 58, offset: 32402},
+							label: "values",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 983, col: 65, offset: 32409},
+								expr: &actionExpr{
+									pos: position{line: 983, col: 66, offset: 32410},
+									run: (*parser).callonChooseExpression11,
+									expr: &seqExpr{
+										pos: position{line: 983, col: 66, offset: 32410},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 983, col: 66, offset: 32410},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 983, col: 69, offset: 32413},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 983, col: 73, offset: 32417},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 983, col: 76, offset: 32420},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 983, col: 79, offset: 32423},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 637, col: 55, offset: 19030},
+							pos:  position{line: 983, col: 111, offset: 32455},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 637, col: 58, offset: 19033},
+							pos:        position{line: 983, col: 114, offset: 32458},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3552,48 +6851,86 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LTrimExpression",
-			pos:  position{line: 641, col: 1, offset: 19119},
+			name: "ObjectToArrayExpression",
+			pos:  position{line: 987, col: 1, offset: 32582},
 			expr: &actionExpr{
-				pos: position{line: 641, col: 20, offset: 19138},
-				run: (*parser).callonLTrimExpression1,
+				pos: position{line: 987, col: 28, offset: 32609},
+				run: (*parser).callonObjectToArrayExpression1,
 				expr: &seqExpr{
-					pos: position{line: 641, col: 20, offset: 19138},
+					pos: position{line: 987, col: 28, offset: 32609},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 641, col: 20, offset: 19138},
-							val:        "ltrim",
+							pos:        position{line: 987, col: 28, offset: 32609},
+							val:        "objecttoarray",
 							ignoreCase: true,
-							want:       "\"LTRIM\"i",
+							want:       "\"ObjectToArray\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 641, col: 29, offset: 19147},
+							pos:  position{line: 987, col: 45, offset: 32626},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 641, col: 32, offset: 19150},
+							pos:        position{line: 987, col: 48, offset: 32629},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 641, col: 36, offset: 19154},
+							pos:  position{line: 987, col: 52, offset: 32633},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 641, col: 39, offset: 19157},
-							label: "ex",
+							pos:   position{line: 987, col: 55, offset: 32636},
+							label: "object",
 							expr: &ruleRefExpr{
-								pos:  position{line: 641, col: 42, offset: 19160},
+								pos:  position{line: 987, col: 62, offset: 32643},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 987, col: 73, offset: 32654},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 987, col: 80, offset: 32661},
+								expr: &actionExpr{
+									pos: position{line: 987, col: 81, offset: 32662},
+									run: (*parser).callonObjectToArrayExpression11,
+									expr: &seqExpr{
+										pos: position{line: 987, col: 81, offset: 32662},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 987, col: 81, offset: 32662},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 987, col: 84, offset: 32665},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 987, col: 88, offset: 32669},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 987, col: 91, offset: 32672},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 987, col: 94, offset: 32675},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 641, col: 53, offset: 19171},
+							pos:  position{line: 987, col: 126, offset: 32707},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 641, col: 56, offset: 19174},
+							pos:        position{line: 987, col: 129, offset: 32710},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3603,92 +6940,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ReplaceExpression",
-			pos:  position{line: 645, col: 1, offset: 19259},
+			name: "SetIntersectExpression",
+			pos:  position{line: 991, col: 1, offset: 32842},
 			expr: &actionExpr{
-				pos: position{line: 645, col: 22, offset: 19280},
-				run: (*parser).callonReplaceExpression1,
+				pos: position{line: 991, col: 27, offset: 32868},
+				run: (*parser).callonSetIntersectExpression1,
 				expr: &seqExpr{
-					pos: position{line: 645, col: 22, offset: 19280},
+					pos: position{line: 991, col: 27, offset: 32868},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 645, col: 22, offset: 19280},
-							val:        "replace",
+							pos:        position{line: 991, col: 27, offset: 32868},
+							val:        "setintersect",
 							ignoreCase: true,
-							want:       "\"REPLACE\"i",
+							want:       "\"SetIntersect\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 33, offset: 19291},
+							pos:  position{line: 991, col: 43, offset: 32884},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 645, col: 36, offset: 19294},
+							pos:        position{line: 991, col: 46, offset: 32887},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 40, offset: 19298},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 645, col: 43, offset: 19301},
-							label: "ex1",
-							expr: &ruleRefExpr{
-								pos:  position{line: 645, col: 47, offset: 19305},
-								name: "SelectItem",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 645, col: 58, offset: 19316},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 645, col: 61, offset: 19319},
-							val:        ",",
-							ignoreCase: false,
-							want:       "\",\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 645, col: 65, offset: 19323},
+							pos:  position{line: 991, col: 50, offset: 32891},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 645, col: 68, offset: 19326},
-							label: "ex2",
+							pos:   position{line: 991, col: 53, offset: 32894},
+							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 645, col: 72, offset: 19330},
+								pos:  position{line: 991, col: 58, offset: 32899},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 83, offset: 19341},
+							pos:  position{line: 991, col: 69, offset: 32910},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 645, col: 86, offset: 19344},
+							pos:        position{line: 991, col: 72, offset: 32913},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 90, offset: 19348},
+							pos:  position{line: 991, col: 76, offset: 32917},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 645, col: 93, offset: 19351},
-							label: "ex3",
+							pos:   position{line: 991, col: 79, offset: 32920},
+							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 645, col: 97, offset: 19355},
+								pos:  position{line: 991, col: 84, offset: 32925},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 645, col: 108, offset: 19366},
+							pos:  position{line: 991, col: 95, offset: 32936},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 645, col: 111, offset: 19369},
+							pos:        position{line: 991, col: 98, offset: 32939},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3698,70 +7013,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ReplicateExpression",
-			pos:  position{line: 649, col: 1, offset: 19467},
+			name: "SetUnionExpression",
+			pos:  position{line: 995, col: 1, offset: 33039},
 			expr: &actionExpr{
-				pos: position{line: 649, col: 24, offset: 19490},
-				run: (*parser).callonReplicateExpression1,
+				pos: position{line: 995, col: 23, offset: 33061},
+				run: (*parser).callonSetUnionExpression1,
 				expr: &seqExpr{
-					pos: position{line: 649, col: 24, offset: 19490},
+					pos: position{line: 995, col: 23, offset: 33061},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 649, col: 24, offset: 19490},
-							val:        "replicate",
+							pos:        position{line: 995, col: 23, offset: 33061},
+							val:        "setunion",
 							ignoreCase: true,
-							want:       "\"REPLICATE\"i",
+							want:       "\"SetUnion\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 37, offset: 19503},
+							pos:  position{line: 995, col: 35, offset: 33073},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 649, col: 40, offset: 19506},
+							pos:        position{line: 995, col: 38, offset: 33076},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 44, offset: 19510},
+							pos:  position{line: 995, col: 42, offset: 33080},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 649, col: 47, offset: 19513},
-							label: "ex1",
+							pos:   position{line: 995, col: 45, offset: 33083},
+							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 649, col: 51, offset: 19517},
+								pos:  position{line: 995, col: 50, offset: 33088},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 62, offset: 19528},
+							pos:  position{line: 995, col: 61, offset: 33099},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 649, col: 65, offset: 19531},
+							pos:        position{line: 995, col: 64, offset: 33102},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 69, offset: 19535},
+							pos:  position{line: 995, col: 68, offset: 33106},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 649, col: 72, offset: 19538},
-							label: "ex2",
+							pos:   position{line: 995, col: 71, offset: 33109},
+							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 649, col: 76, offset: 19542},
+								pos:  position{line: 995, col: 76, offset: 33114},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 649, col: 87, offset: 19553},
+							pos:  position{line: 995, col: 87, offset: 33125},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 649, col: 90, offset: 19556},
+							pos:        position{line: 995, col: 90, offset: 33128},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3771,121 +7086,92 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ReverseExpression",
-			pos:  position{line: 653, col: 1, offset: 19651},
+			name: "IifExpression",
+			pos:  position{line: 999, col: 1, offset: 33224},
 			expr: &actionExpr{
-				pos: position{line: 653, col: 22, offset: 19672},
-				run: (*parser).callonReverseExpression1,
+				pos: position{line: 999, col: 18, offset: 33241},
+				run: (*parser).callonIifExpression1,
 				expr: &seqExpr{
-					pos: position{line: 653, col: 22, offset: 19672},
+					pos: position{line: 999, col: 18, offset: 33241},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 653, col: 22, offset: 19672},
-							val:        "reverse",
+							pos:        position{line: 999, col: 18, offset: 33241},
+							val:        "iif",
 							ignoreCase: true,
-							want:       "\"REVERSE\"i",
+							want:       "\"IIF\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 653, col: 33, offset: 19683},
+							pos:  position{line: 999, col: 25, offset: 33248},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 653, col: 36, offset: 19686},
+							pos:        position{line: 999, col: 28, offset: 33251},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 653, col: 40, offset: 19690},
+							pos:  position{line: 999, col: 32, offset: 33255},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 653, col: 43, offset: 19693},
-							label: "ex",
+							pos:   position{line: 999, col: 35, offset: 33258},
+							label: "condition",
 							expr: &ruleRefExpr{
-								pos:  position{line: 653, col: 46, offset: 19696},
+								pos:  position{line: 999, col: 45, offset: 33268},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 653, col: 57, offset: 19707},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 653, col: 60, offset: 19710},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "RightExpression",
-			pos:  position{line: 657, col: 1, offset: 19797},
-			expr: &actionExpr{
-				pos: position{line: 657, col: 20, offset: 19816},
-				run: (*parser).callonRightExpression1,
-				expr: &seqExpr{
-					pos: position{line: 657, col: 20, offset: 19816},
-					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 657, col: 20, offset: 19816},
-							val:        "right",
-							ignoreCase: true,
-							want:       "\"RIGHT\"i",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 657, col: 29, offset: 19825},
+							pos:  position{line: 999, col: 56, offset: 33279},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 657, col: 32, offset: 19828},
-							val:        "(",
+							pos:        position{line: 999, col: 59, offset: 33282},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 657, col: 36, offset: 19832},
+							pos:  position{line: 999, col: 63, offset: 33286},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 39, offset: 19835},
-							label: "ex",
+							pos:   position{line: 999, col: 66, offset: 33289},
+							label: "trueValue",
 							expr: &ruleRefExpr{
-								pos:  position{line: 657, col: 42, offset: 19838},
+								pos:  position{line: 999, col: 76, offset: 33299},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 657, col: 53, offset: 19849},
+							pos:  position{line: 999, col: 87, offset: 33310},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 657, col: 56, offset: 19852},
+							pos:        position{line: 999, col: 90, offset: 33313},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 657, col: 60, offset: 19856},
+							pos:  position{line: 999, col: 94, offset: 33317},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 657, col: 63, offset: 19859},
-							label: "length",
+							pos:   position{line: 999, col: 97, offset: 33320},
+							label: "falseValue",
 							expr: &ruleRefExpr{
-								pos:  position{line: 657, col: 70, offset: 19866},
+								pos:  position{line: 999, col: 108, offset: 33331},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 657, col: 81, offset: 19877},
+							pos:  position{line: 999, col: 119, offset: 33342},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 657, col: 84, offset: 19880},
+							pos:        position{line: 999, col: 122, offset: 33345},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3895,48 +7181,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "RTrimExpression",
-			pos:  position{line: 661, col: 1, offset: 19973},
+			name: "DocumentIdExpression",
+			pos:  position{line: 1003, col: 1, offset: 33458},
 			expr: &actionExpr{
-				pos: position{line: 661, col: 20, offset: 19992},
-				run: (*parser).callonRTrimExpression1,
+				pos: position{line: 1003, col: 25, offset: 33482},
+				run: (*parser).callonDocumentIdExpression1,
 				expr: &seqExpr{
-					pos: position{line: 661, col: 20, offset: 19992},
+					pos: position{line: 1003, col: 25, offset: 33482},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 661, col: 20, offset: 19992},
-							val:        "rtrim",
+							pos:        position{line: 1003, col: 25, offset: 33482},
+							val:        "documentid",
 							ignoreCase: true,
-							want:       "\"RTRIM\"i",
+							want:       "\"DocumentId\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 661, col: 29, offset: 20001},
+							pos:  position{line: 1003, col: 39, offset: 33496},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 661, col: 32, offset: 20004},
+							pos:        position{line: 1003, col: 42, offset: 33499},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 661, col: 36, offset: 20008},
+							pos:  position{line: 1003, col: 46, offset: 33503},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 661, col: 39, offset: 20011},
+							pos:   position{line: 1003, col: 49, offset: 33506},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 661, col: 42, offset: 20014},
+								pos:  position{line: 1003, col: 52, offset: 33509},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 661, col: 53, offset: 20025},
+							pos:  position{line: 1003, col: 63, offset: 33520},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 661, col: 56, offset: 20028},
+							pos:        position{line: 1003, col: 66, offset: 33523},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -3946,92 +7232,92 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SubstringExpression",
-			pos:  position{line: 665, col: 1, offset: 20113},
+			name: "DateTimeAddExpression",
+			pos:  position{line: 1007, col: 1, offset: 33613},
 			expr: &actionExpr{
-				pos: position{line: 665, col: 24, offset: 20136},
-				run: (*parser).callonSubstringExpression1,
+				pos: position{line: 1007, col: 26, offset: 33638},
+				run: (*parser).callonDateTimeAddExpression1,
 				expr: &seqExpr{
-					pos: position{line: 665, col: 24, offset: 20136},
+					pos: position{line: 1007, col: 26, offset: 33638},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 665, col: 24, offset: 20136},
-							val:        "substring",
+							pos:        position{line: 1007, col: 26, offset: 33638},
+							val:        "datetimeadd",
 							ignoreCase: true,
-							want:       "\"SUBSTRING\"i",
+							want:       "\"DateTimeAdd\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 37, offset: 20149},
+							pos:  position{line: 1007, col: 41, offset: 33653},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 665, col: 40, offset: 20152},
+							pos:        position{line: 1007, col: 44, offset: 33656},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 44, offset: 20156},
+							pos:  position{line: 1007, col: 48, offset: 33660},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 665, col: 47, offset: 20159},
-							label: "ex",
+							pos:   position{line: 1007, col: 51, offset: 33663},
+							label: "part",
 							expr: &ruleRefExpr{
-								pos:  position{line: 665, col: 50, offset: 20162},
+								pos:  position{line: 1007, col: 56, offset: 33668},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 61, offset: 20173},
+							pos:  position{line: 1007, col: 67, offset: 33679},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 665, col: 64, offset: 20176},
+							pos:        position{line: 1007, col: 70, offset: 33682},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 68, offset: 20180},
+							pos:  position{line: 1007, col: 74, offset: 33686},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 665, col: 71, offset: 20183},
-							label: "startPos",
+							pos:   position{line: 1007, col: 77, offset: 33689},
+							label: "number",
 							expr: &ruleRefExpr{
-								pos:  position{line: 665, col: 80, offset: 20192},
+								pos:  position{line: 1007, col: 84, offset: 33696},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 91, offset: 20203},
+							pos:  position{line: 1007, col: 95, offset: 33707},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 665, col: 94, offset: 20206},
+							pos:        position{line: 1007, col: 98, offset: 33710},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 98, offset: 20210},
+							pos:  position{line: 1007, col: 102, offset: 33714},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 665, col: 101, offset: 20213},
-							label: "length",
+							pos:   position{line: 1007, col: 105, offset: 33717},
+							label: "dateTime",
 							expr: &ruleRefExpr{
-								pos:  position{line: 665, col: 108, offset: 20220},
+								pos:  position{line: 1007, col: 114, offset: 33726},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 665, col: 119, offset: 20231},
+							pos:  position{line: 1007, col: 125, offset: 33737},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 665, col: 122, offset: 20234},
+							pos:        position{line: 1007, col: 128, offset: 33740},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4041,302 +7327,203 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "TrimExpression",
-			pos:  position{line: 669, col: 1, offset: 20341},
+			name: "DateTimeBinExpression",
+			pos:  position{line: 1011, col: 1, offset: 33851},
 			expr: &actionExpr{
-				pos: position{line: 669, col: 19, offset: 20359},
-				run: (*parser).callonTrimExpression1,
+				pos: position{line: 1011, col: 26, offset: 33876},
+				run: (*parser).callonDateTimeBinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 669, col: 19, offset: 20359},
+					pos: position{line: 1011, col: 26, offset: 33876},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 669, col: 19, offset: 20359},
-							val:        "trim",
+							pos:        position{line: 1011, col: 26, offset: 33876},
+							val:        "datetimebin",
 							ignoreCase: true,
-							want:       "\"TRIM\"i",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 669, col: 27, offset: 20367},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 669, col: 30, offset: 20370},
-							val:        "(",
-							ignoreCase: false,
-							want:       "\"(\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 669, col: 34, offset: 20374},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 669, col: 37, offset: 20377},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 669, col: 40, offset: 20380},
-								name: "SelectItem",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 669, col: 51, offset: 20391},
-							name: "ws",
-						},
-						&litMatcher{
-							pos:        position{line: 669, col: 54, offset: 20394},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ThreeArgumentStringFunctionExpression",
-			pos:  position{line: 673, col: 1, offset: 20478},
-			expr: &actionExpr{
-				pos: position{line: 673, col: 42, offset: 20519},
-				run: (*parser).callonThreeArgumentStringFunctionExpression1,
-				expr: &seqExpr{
-					pos: position{line: 673, col: 42, offset: 20519},
-					exprs: []any{
-						&labeledExpr{
-							pos:   position{line: 673, col: 42, offset: 20519},
-							label: "function",
-							expr: &ruleRefExpr{
-								pos:  position{line: 673, col: 51, offset: 20528},
-								name: "ThreeArgumentStringFunction",
-							},
+							want:       "\"DateTimeBin\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 673, col: 79, offset: 20556},
+							pos:  position{line: 1011, col: 41, offset: 33891},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 673, col: 82, offset: 20559},
+							pos:        position{line: 1011, col: 44, offset: 33894},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 673, col: 86, offset: 20563},
+							pos:  position{line: 1011, col: 48, offset: 33898},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 673, col: 89, offset: 20566},
-							label: "ex1",
+							pos:   position{line: 1011, col: 51, offset: 33901},
+							label: "dateTime",
 							expr: &ruleRefExpr{
-								pos:  position{line: 673, col: 93, offset: 20570},
+								pos:  position{line: 1011, col: 60, offset: 33910},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 673, col: 104, offset: 20581},
+							pos:  position{line: 1011, col: 71, offset: 33921},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 673, col: 107, offset: 20584},
+							pos:        position{line: 1011, col: 74, offset: 33924},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 673, col: 111, offset: 20588},
+							pos:  position{line: 1011, col: 78, offset: 33928},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 673, col: 114, offset: 20591},
-							label: "ex2",
+							pos:   position{line: 1011, col: 81, offset: 33931},
+							label: "part",
 							expr: &ruleRefExpr{
-								pos:  position{line: 673, col: 118, offset: 20595},
+								pos:  position{line: 1011, col: 86, offset: 33936},
 								name: "SelectItem",
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 673, col: 129, offset: 20606},
-							name: "ws",
-						},
 						&labeledExpr{
-							pos:   position{line: 673, col: 132, offset: 20609},
-							label: "ignoreCase",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 673, col: 143, offset: 20620},
+							pos:   position{line: 1011, col: 97, offset: 33947},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1011, col: 104, offset: 33954},
 								expr: &actionExpr{
-									pos: position{line: 673, col: 144, offset: 20621},
-									run: (*parser).callonThreeArgumentStringFunctionExpression18,
+									pos: position{line: 1011, col: 105, offset: 33955},
+									run: (*parser).callonDateTimeBinExpression16,
 									expr: &seqExpr{
-										pos: position{line: 673, col: 144, offset: 20621},
+										pos: position{line: 1011, col: 105, offset: 33955},
 										exprs: []any{
-											&litMatcher{
-												pos:        position{line: 673, col: 144, offset: 20621},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
 											&ruleRefExpr{
-												pos:  position{line: 673, col: 148, offset: 20625},
+												pos:  position{line: 1011, col: 105, offset: 33955},
 												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 673, col: 151, offset: 20628},
-												label: "boolean",
-												expr: &ruleRefExpr{
-													pos:  position{line: 673, col: 159, offset: 20636},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&litMatcher{
-							pos:        position{line: 673, col: 196, offset: 20673},
-							val:        ")",
-							ignoreCase: false,
-							want:       "\")\"",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ThreeArgumentStringFunction",
-			pos:  position{line: 693, col: 1, offset: 21272},
-			expr: &actionExpr{
-				pos: position{line: 693, col: 32, offset: 21303},
-				run: (*parser).callonThreeArgumentStringFunction1,
-				expr: &choiceExpr{
-					pos: position{line: 693, col: 33, offset: 21304},
-					alternatives: []any{
-						&litMatcher{
-							pos:        position{line: 693, col: 33, offset: 21304},
-							val:        "contains",
-							ignoreCase: true,
-							want:       "\"CONTAINS\"i",
-						},
-						&litMatcher{
-							pos:        position{line: 693, col: 47, offset: 21318},
-							val:        "endswith",
-							ignoreCase: true,
-							want:       "\"ENDSWITH\"i",
-						},
-						&litMatcher{
-							pos:        position{line: 693, col: 61, offset: 21332},
-							val:        "startswith",
-							ignoreCase: true,
-							want:       "\"STARTSWITH\"i",
+											},
+											&litMatcher{
+												pos:        position{line: 1011, col: 108, offset: 33958},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1011, col: 112, offset: 33962},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 1011, col: 115, offset: 33965},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1011, col: 118, offset: 33968},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
 						},
-						&litMatcher{
-							pos:        position{line: 693, col: 77, offset: 21348},
-							val:        "regexmatch",
-							ignoreCase: true,
-							want:       "\"REGEXMATCH\"i",
+						&ruleRefExpr{
+							pos:  position{line: 1011, col: 150, offset: 34000},
+							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 693, col: 93, offset: 21364},
-							val:        "index_of",
-							ignoreCase: true,
-							want:       "\"INDEX_OF\"i",
+							pos:        position{line: 1011, col: 153, offset: 34003},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "IsDefined",
-			pos:  position{line: 697, col: 1, offset: 21413},
+			name: "DateTimeDiffExpression",
+			pos:  position{line: 1015, col: 1, offset: 34141},
 			expr: &actionExpr{
-				pos: position{line: 697, col: 14, offset: 21426},
-				run: (*parser).callonIsDefined1,
+				pos: position{line: 1015, col: 27, offset: 34167},
+				run: (*parser).callonDateTimeDiffExpression1,
 				expr: &seqExpr{
-					pos: position{line: 697, col: 14, offset: 21426},
+					pos: position{line: 1015, col: 27, offset: 34167},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 697, col: 14, offset: 21426},
-							val:        "is_defined",
+							pos:        position{line: 1015, col: 27, offset: 34167},
+							val:        "datetimediff",
 							ignoreCase: true,
-							want:       "\"IS_DEFINED\"i",
+							want:       "\"DateTimeDiff\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 697, col: 28, offset: 21440},
+							pos:  position{line: 1015, col: 43, offset: 34183},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 697, col: 31, offset: 21443},
+							pos:        position{line: 1015, col: 46, offset: 34186},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 697, col: 35, offset: 21447},
+							pos:  position{line: 1015, col: 50, offset: 34190},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 697, col: 38, offset: 21450},
-							label: "ex",
+							pos:   position{line: 1015, col: 53, offset: 34193},
+							label: "part",
 							expr: &ruleRefExpr{
-								pos:  position{line: 697, col: 41, offset: 21453},
+								pos:  position{line: 1015, col: 58, offset: 34198},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 697, col: 52, offset: 21464},
+							pos:  position{line: 1015, col: 69, offset: 34209},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 697, col: 55, offset: 21467},
-							val:        ")",
+							pos:        position{line: 1015, col: 72, offset: 34212},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\")\"",
+							want:       "\",\"",
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "IsArray",
-			pos:  position{line: 701, col: 1, offset: 21556},
-			expr: &actionExpr{
-				pos: position{line: 701, col: 12, offset: 21567},
-				run: (*parser).callonIsArray1,
-				expr: &seqExpr{
-					pos: position{line: 701, col: 12, offset: 21567},
-					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 701, col: 12, offset: 21567},
-							val:        "is_array",
-							ignoreCase: true,
-							want:       "\"IS_ARRAY\"i",
+						&ruleRefExpr{
+							pos:  position{line: 1015, col: 76, offset: 34216},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 1015, col: 79, offset: 34219},
+							label: "startDate",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1015, col: 89, offset: 34229},
+								name: "SelectItem",
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 701, col: 24, offset: 21579},
+							pos:  position{line: 1015, col: 100, offset: 34240},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 701, col: 27, offset: 21582},
-							val:        "(",
+							pos:        position{line: 1015, col: 103, offset: 34243},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 701, col: 31, offset: 21586},
+							pos:  position{line: 1015, col: 107, offset: 34247},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 701, col: 34, offset: 21589},
-							label: "ex",
+							pos:   position{line: 1015, col: 110, offset: 34250},
+							label: "endDate",
 							expr: &ruleRefExpr{
-								pos:  position{line: 701, col: 37, offset: 21592},
+								pos:  position{line: 1015, col: 118, offset: 34258},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 701, col: 48, offset: 21603},
+							pos:  position{line: 1015, col: 129, offset: 34269},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 701, col: 51, offset: 21606},
+							pos:        position{line: 1015, col: 132, offset: 34272},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4346,99 +7533,130 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsBool",
-			pos:  position{line: 705, col: 1, offset: 21693},
+			name: "DateTimeFromPartsExpression",
+			pos:  position{line: 1019, col: 1, offset: 34386},
 			expr: &actionExpr{
-				pos: position{line: 705, col: 11, offset: 21703},
-				run: (*parser).callonIsBool1,
+				pos: position{line: 1019, col: 32, offset: 34417},
+				run: (*parser).callonDateTimeFromPartsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 705, col: 11, offset: 21703},
+					pos: position{line: 1019, col: 32, offset: 34417},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 705, col: 11, offset: 21703},
-							val:        "is_bool",
+							pos:        position{line: 1019, col: 32, offset: 34417},
+							val:        "datetimefromparts",
 							ignoreCase: true,
-							want:       "\"IS_BOOL\"i",
+							want:       "\"DateTimeFromParts\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 705, col: 22, offset: 21714},
+							pos:  position{line: 1019, col: 53, offset: 34438},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 705, col: 25, offset: 21717},
+							pos:        position{line: 1019, col: 56, offset: 34441},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 705, col: 29, offset: 21721},
+							pos:  position{line: 1019, col: 60, offset: 34445},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 705, col: 32, offset: 21724},
-							label: "ex",
+							pos:   position{line: 1019, col: 63, offset: 34448},
+							label: "year",
 							expr: &ruleRefExpr{
-								pos:  position{line: 705, col: 35, offset: 21727},
+								pos:  position{line: 1019, col: 68, offset: 34453},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 705, col: 46, offset: 21738},
+							pos:  position{line: 1019, col: 79, offset: 34464},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 705, col: 49, offset: 21741},
-							val:        ")",
+							pos:        position{line: 1019, col: 82, offset: 34467},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\")\"",
+							want:       "\",\"",
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "IsFiniteNumber",
-			pos:  position{line: 709, col: 1, offset: 21827},
-			expr: &actionExpr{
-				pos: position{line: 709, col: 19, offset: 21845},
-				run: (*parser).callonIsFiniteNumber1,
-				expr: &seqExpr{
-					pos: position{line: 709, col: 19, offset: 21845},
-					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 709, col: 19, offset: 21845},
-							val:        "is_finite_number",
-							ignoreCase: true,
-							want:       "\"IS_FINITE_NUMBER\"i",
+						&ruleRefExpr{
+							pos:  position{line: 1019, col: 86, offset: 34471},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 1019, col: 89, offset: 34474},
+							label: "month",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1019, col: 95, offset: 34480},
+								name: "SelectItem",
+							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 709, col: 39, offset: 21865},
+							pos:  position{line: 1019, col: 106, offset: 34491},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 709, col: 42, offset: 21868},
-							val:        "(",
+							pos:        position{line: 1019, col: 109, offset: 34494},
+							val:        ",",
 							ignoreCase: false,
-							want:       "\"(\"",
+							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 709, col: 46, offset: 21872},
+							pos:  position{line: 1019, col: 113, offset: 34498},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 709, col: 49, offset: 21875},
-							label: "ex",
+							pos:   position{line: 1019, col: 116, offset: 34501},
+							label: "day",
 							expr: &ruleRefExpr{
-								pos:  position{line: 709, col: 52, offset: 21878},
+								pos:  position{line: 1019, col: 120, offset: 34505},
 								name: "SelectItem",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 1019, col: 131, offset: 34516},
+							label: "others",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1019, col: 138, offset: 34523},
+								expr: &actionExpr{
+									pos: position{line: 1019, col: 139, offset: 34524},
+									run: (*parser).callonDateTimeFromPartsExpression21,
+									expr: &seqExpr{
+										pos: position{line: 1019, col: 139, offset: 34524},
+										exprs: []any{
+											&ruleRefExpr{
+												pos:  position{line: 1019, col: 139, offset: 34524},
+												name: "ws",
+											},
+											&litMatcher{
+												pos:        position{line: 1019, col: 142, offset: 34527},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1019, col: 146, offset: 34531},
+												name: "ws",
+											},
+											&labeledExpr{
+												pos:   position{line: 1019, col: 149, offset: 34534},
+												label: "ex",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1019, col: 152, offset: 34537},
+													name: "SelectItem",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 709, col: 63, offset: 21889},
+							pos:  position{line: 1019, col: 184, offset: 34569},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 709, col: 66, offset: 21892},
+							pos:        position{line: 1019, col: 187, offset: 34572},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4448,48 +7666,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsInteger",
-			pos:  position{line: 713, col: 1, offset: 21986},
+			name: "DateTimePartExpression",
+			pos:  position{line: 1023, col: 1, offset: 34718},
 			expr: &actionExpr{
-				pos: position{line: 713, col: 14, offset: 21999},
-				run: (*parser).callonIsInteger1,
+				pos: position{line: 1023, col: 27, offset: 34744},
+				run: (*parser).callonDateTimePartExpression1,
 				expr: &seqExpr{
-					pos: position{line: 713, col: 14, offset: 21999},
+					pos: position{line: 1023, col: 27, offset: 34744},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 713, col: 14, offset: 21999},
-							val:        "is_integer",
+							pos:        position{line: 1023, col: 27, offset: 34744},
+							val:        "datetimepart",
 							ignoreCase: true,
-							want:       "\"IS_INTEGER\"i",
+							want:       "\"DateTimePart\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 713, col: 28, offset: 22013},
+							pos:  position{line: 1023, col: 43, offset: 34760},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 713, col: 31, offset: 22016},
+							pos:        position{line: 1023, col: 46, offset: 34763},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 713, col: 35, offset: 22020},
+							pos:  position{line: 1023, col: 50, offset: 34767},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 713, col: 38, offset: 22023},
-							label: "ex",
+							pos:   position{line: 1023, col: 53, offset: 34770},
+							label: "part",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1023, col: 58, offset: 34775},
+								name: "SelectItem",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1023, col: 69, offset: 34786},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 1023, col: 72, offset: 34789},
+							val:        ",",
+							ignoreCase: false,
+							want:       "\",\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1023, col: 76, offset: 34793},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 1023, col: 79, offset: 34796},
+							label: "dateTime",
 							expr: &ruleRefExpr{
-								pos:  position{line: 713, col: 41, offset: 22026},
+								pos:  position{line: 1023, col: 88, offset: 34805},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 713, col: 52, offset: 22037},
+							pos:  position{line: 1023, col: 99, offset: 34816},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 713, col: 55, offset: 22040},
+							pos:        position{line: 1023, col: 102, offset: 34819},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4499,48 +7739,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsNull",
-			pos:  position{line: 717, col: 1, offset: 22129},
+			name: "DateTimeToTicksExpression",
+			pos:  position{line: 1027, col: 1, offset: 34923},
 			expr: &actionExpr{
-				pos: position{line: 717, col: 11, offset: 22139},
-				run: (*parser).callonIsNull1,
+				pos: position{line: 1027, col: 30, offset: 34952},
+				run: (*parser).callonDateTimeToTicksExpression1,
 				expr: &seqExpr{
-					pos: position{line: 717, col: 11, offset: 22139},
+					pos: position{line: 1027, col: 30, offset: 34952},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 717, col: 11, offset: 22139},
-							val:        "is_null",
+							pos:        position{line: 1027, col: 30, offset: 34952},
+							val:        "datetimetoticks",
 							ignoreCase: true,
-							want:       "\"IS_NULL\"i",
+							want:       "\"DateTimeToTicks\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 717, col: 22, offset: 22150},
+							pos:  position{line: 1027, col: 49, offset: 34971},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 717, col: 25, offset: 22153},
+							pos:        position{line: 1027, col: 52, offset: 34974},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 717, col: 29, offset: 22157},
+							pos:  position{line: 1027, col: 56, offset: 34978},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 717, col: 32, offset: 22160},
-							label: "ex",
+							pos:   position{line: 1027, col: 59, offset: 34981},
+							label: "dateTime",
 							expr: &ruleRefExpr{
-								pos:  position{line: 717, col: 35, offset: 22163},
+								pos:  position{line: 1027, col: 68, offset: 34990},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 717, col: 46, offset: 22174},
+							pos:  position{line: 1027, col: 79, offset: 35001},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 717, col: 49, offset: 22177},
+							pos:        position{line: 1027, col: 82, offset: 35004},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4550,48 +7790,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsNumber",
-			pos:  position{line: 721, col: 1, offset: 22263},
+			name: "DateTimeToTimestampExpression",
+			pos:  position{line: 1031, col: 1, offset: 35105},
 			expr: &actionExpr{
-				pos: position{line: 721, col: 13, offset: 22275},
-				run: (*parser).callonIsNumber1,
+				pos: position{line: 1031, col: 34, offset: 35138},
+				run: (*parser).callonDateTimeToTimestampExpression1,
 				expr: &seqExpr{
-					pos: position{line: 721, col: 13, offset: 22275},
+					pos: position{line: 1031, col: 34, offset: 35138},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 721, col: 13, offset: 22275},
-							val:        "is_number",
+							pos:        position{line: 1031, col: 34, offset: 35138},
+							val:        "datetimetotimestamp",
 							ignoreCase: true,
-							want:       "\"IS_NUMBER\"i",
+							want:       "\"DateTimeToTimestamp\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 721, col: 26, offset: 22288},
+							pos:  position{line: 1031, col: 57, offset: 35161},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 721, col: 29, offset: 22291},
+							pos:        position{line: 1031, col: 60, offset: 35164},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 721, col: 33, offset: 22295},
+							pos:  position{line: 1031, col: 64, offset: 35168},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 721, col: 36, offset: 22298},
-							label: "ex",
+							pos:   position{line: 1031, col: 67, offset: 35171},
+							label: "dateTime",
 							expr: &ruleRefExpr{
-								pos:  position{line: 721, col: 39, offset: 22301},
+								pos:  position{line: 1031, col: 76, offset: 35180},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 721, col: 50, offset: 22312},
+							pos:  position{line: 1031, col: 87, offset: 35191},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 721, col: 53, offset: 22315},
+							pos:        position{line: 1031, col: 90, offset: 35194},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4601,48 +7841,36 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsObject",
-			pos:  position{line: 725, col: 1, offset: 22403},
+			name: "GetCurrentDateTimeStaticExpression",
+			pos:  position{line: 1035, col: 1, offset: 35299},
 			expr: &actionExpr{
-				pos: position{line: 725, col: 13, offset: 22415},
-				run: (*parser).callonIsObject1,
+				pos: position{line: 1035, col: 39, offset: 35337},
+				run: (*parser).callonGetCurrentDateTimeStaticExpression1,
 				expr: &seqExpr{
-					pos: position{line: 725, col: 13, offset: 22415},
+					pos: position{line: 1035, col: 39, offset: 35337},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 725, col: 13, offset: 22415},
-							val:        "is_object",
+							pos:        position{line: 1035, col: 39, offset: 35337},
+							val:        "getcurrentdatetimestatic",
 							ignoreCase: true,
-							want:       "\"IS_OBJECT\"i",
+							want:       "\"GetCurrentDateTimeStatic\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 725, col: 26, offset: 22428},
+							pos:  position{line: 1035, col: 67, offset: 35365},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 725, col: 29, offset: 22431},
+							pos:        position{line: 1035, col: 70, offset: 35368},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 725, col: 33, offset: 22435},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 725, col: 36, offset: 22438},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 725, col: 39, offset: 22441},
-								name: "SelectItem",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 725, col: 50, offset: 22452},
+							pos:  position{line: 1035, col: 74, offset: 35372},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 725, col: 53, offset: 22455},
+							pos:        position{line: 1035, col: 77, offset: 35375},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4652,48 +7880,36 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsPrimitive",
-			pos:  position{line: 729, col: 1, offset: 22543},
+			name: "GetCurrentDateTimeExpression",
+			pos:  position{line: 1036, col: 1, offset: 35472},
 			expr: &actionExpr{
-				pos: position{line: 729, col: 16, offset: 22558},
-				run: (*parser).callonIsPrimitive1,
+				pos: position{line: 1036, col: 33, offset: 35504},
+				run: (*parser).callonGetCurrentDateTimeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 729, col: 16, offset: 22558},
+					pos: position{line: 1036, col: 33, offset: 35504},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 729, col: 16, offset: 22558},
-							val:        "is_primitive",
+							pos:        position{line: 1036, col: 33, offset: 35504},
+							val:        "getcurrentdatetime",
 							ignoreCase: true,
-							want:       "\"IS_PRIMITIVE\"i",
+							want:       "\"GetCurrentDateTime\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 729, col: 32, offset: 22574},
+							pos:  position{line: 1036, col: 55, offset: 35526},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 729, col: 35, offset: 22577},
+							pos:        position{line: 1036, col: 58, offset: 35529},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 729, col: 39, offset: 22581},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 729, col: 42, offset: 22584},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 729, col: 45, offset: 22587},
-								name: "SelectItem",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 729, col: 56, offset: 22598},
+							pos:  position{line: 1036, col: 62, offset: 35533},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 729, col: 59, offset: 22601},
+							pos:        position{line: 1036, col: 65, offset: 35536},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4703,48 +7919,36 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IsString",
-			pos:  position{line: 733, col: 1, offset: 22692},
+			name: "GetCurrentTicksStaticExpression",
+			pos:  position{line: 1037, col: 1, offset: 35627},
 			expr: &actionExpr{
-				pos: position{line: 733, col: 13, offset: 22704},
-				run: (*parser).callonIsString1,
+				pos: position{line: 1037, col: 36, offset: 35662},
+				run: (*parser).callonGetCurrentTicksStaticExpression1,
 				expr: &seqExpr{
-					pos: position{line: 733, col: 13, offset: 22704},
+					pos: position{line: 1037, col: 36, offset: 35662},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 733, col: 13, offset: 22704},
-							val:        "is_string",
+							pos:        position{line: 1037, col: 36, offset: 35662},
+							val:        "getcurrentticksstatic",
 							ignoreCase: true,
-							want:       "\"IS_STRING\"i",
+							want:       "\"GetCurrentTicksStatic\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 733, col: 26, offset: 22717},
+							pos:  position{line: 1037, col: 61, offset: 35687},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 733, col: 29, offset: 22720},
+							pos:        position{line: 1037, col: 64, offset: 35690},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 733, col: 33, offset: 22724},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 733, col: 36, offset: 22727},
-							label: "ex",
-							expr: &ruleRefExpr{
-								pos:  position{line: 733, col: 39, offset: 22730},
-								name: "SelectItem",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 733, col: 50, offset: 22741},
+							pos:  position{line: 1037, col: 68, offset: 35694},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 733, col: 53, offset: 22744},
+							pos:        position{line: 1037, col: 71, offset: 35697},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4754,86 +7958,36 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArrayConcatExpression",
-			pos:  position{line: 737, col: 1, offset: 22832},
+			name: "GetCurrentTicksExpression",
+			pos:  position{line: 1038, col: 1, offset: 35791},
 			expr: &actionExpr{
-				pos: position{line: 737, col: 26, offset: 22857},
-				run: (*parser).callonArrayConcatExpression1,
+				pos: position{line: 1038, col: 30, offset: 35820},
+				run: (*parser).callonGetCurrentTicksExpression1,
 				expr: &seqExpr{
-					pos: position{line: 737, col: 26, offset: 22857},
+					pos: position{line: 1038, col: 30, offset: 35820},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 737, col: 26, offset: 22857},
-							val:        "array_concat",
+							pos:        position{line: 1038, col: 30, offset: 35820},
+							val:        "getcurrentticks",
 							ignoreCase: true,
-							want:       "\"ARRAY_CONCAT\"i",
+							want:       "\"GetCurrentTicks\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 737, col: 42, offset: 22873},
+							pos:  position{line: 1038, col: 49, offset: 35839},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 737, col: 45, offset: 22876},
+							pos:        position{line: 1038, col: 52, offset: 35842},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 737, col: 49, offset: 22880},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 737, col: 52, offset: 22883},
-							label: "arrays",
-							expr: &ruleRefExpr{
-								pos:  position{line: 737, col: 59, offset: 22890},
-								name: "SelectItem",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 737, col: 70, offset: 22901},
-							label: "others",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 737, col: 77, offset: 22908},
-								expr: &actionExpr{
-									pos: position{line: 737, col: 78, offset: 22909},
-									run: (*parser).callonArrayConcatExpression11,
-									expr: &seqExpr{
-										pos: position{line: 737, col: 78, offset: 22909},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 737, col: 78, offset: 22909},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 737, col: 81, offset: 22912},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 737, col: 85, offset: 22916},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 737, col: 88, offset: 22919},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 737, col: 91, offset: 22922},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 737, col: 123, offset: 22954},
+							pos:  position{line: 1038, col: 56, offset: 35846},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 737, col: 126, offset: 22957},
+							pos:        position{line: 1038, col: 59, offset: 35849},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4843,108 +7997,75 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArrayContainsExpression",
-			pos:  position{line: 741, col: 1, offset: 23087},
+			name: "GetCurrentTimestampStaticExpression",
+			pos:  position{line: 1039, col: 1, offset: 35937},
 			expr: &actionExpr{
-				pos: position{line: 741, col: 28, offset: 23114},
-				run: (*parser).callonArrayContainsExpression1,
+				pos: position{line: 1039, col: 40, offset: 35976},
+				run: (*parser).callonGetCurrentTimestampStaticExpression1,
 				expr: &seqExpr{
-					pos: position{line: 741, col: 28, offset: 23114},
+					pos: position{line: 1039, col: 40, offset: 35976},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 741, col: 28, offset: 23114},
-							val:        "array_contains",
+							pos:        position{line: 1039, col: 40, offset: 35976},
+							val:        "getcurrenttimestampstatic",
 							ignoreCase: true,
-							want:       "\"ARRAY_CONTAINS\"i",
+							want:       "\"GetCurrentTimestampStatic\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 741, col: 46, offset: 23132},
+							pos:  position{line: 1039, col: 69, offset: 36005},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 741, col: 49, offset: 23135},
+							pos:        position{line: 1039, col: 72, offset: 36008},
 							val:        "(",
-							ignoreCase: false,
-							want:       "\"(\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 741, col: 53, offset: 23139},
-							name: "ws",
-						},
-						&labeledExpr{
-							pos:   position{line: 741, col: 56, offset: 23142},
-							label: "array",
-							expr: &ruleRefExpr{
-								pos:  position{line: 741, col: 62, offset: 23148},
-								name: "SelectItem",
-							},
+							ignoreCase: false,
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 741, col: 73, offset: 23159},
+							pos:  position{line: 1039, col: 76, offset: 36012},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 741, col: 76, offset: 23162},
-							val:        ",",
+							pos:        position{line: 1039, col: 79, offset: 36015},
+							val:        ")",
 							ignoreCase: false,
-							want:       "\",\"",
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "GetCurrentTimestampExpression",
+			pos:  position{line: 1040, col: 1, offset: 36113},
+			expr: &actionExpr{
+				pos: position{line: 1040, col: 34, offset: 36146},
+				run: (*parser).callonGetCurrentTimestampExpression1,
+				expr: &seqExpr{
+					pos: position{line: 1040, col: 34, offset: 36146},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1040, col: 34, offset: 36146},
+							val:        "getcurrenttimestamp",
+							ignoreCase: true,
+							want:       "\"GetCurrentTimestamp\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 741, col: 80, offset: 23166},
+							pos:  position{line: 1040, col: 57, offset: 36169},
 							name: "ws",
 						},
-						&labeledExpr{
-							pos:   position{line: 741, col: 83, offset: 23169},
-							label: "item",
-							expr: &ruleRefExpr{
-								pos:  position{line: 741, col: 88, offset: 23174},
-								name: "SelectItem",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 741, col: 99, offset: 23185},
-							label: "partialMatch",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 741, col: 112, offset: 23198},
-								expr: &actionExpr{
-									pos: position{line: 741, col: 113, offset: 23199},
-									run: (*parser).callonArrayContainsExpression16,
-									expr: &seqExpr{
-										pos: position{line: 741, col: 113, offset: 23199},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 741, col: 113, offset: 23199},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 741, col: 116, offset: 23202},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 741, col: 120, offset: 23206},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 741, col: 123, offset: 23209},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 741, col: 126, offset: 23212},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
+						&litMatcher{
+							pos:        position{line: 1040, col: 60, offset: 36172},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 741, col: 158, offset: 23244},
+							pos:  position{line: 1040, col: 64, offset: 36176},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 741, col: 161, offset: 23247},
+							pos:        position{line: 1040, col: 67, offset: 36179},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -4954,86 +8075,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArrayContainsAnyExpression",
-			pos:  position{line: 745, col: 1, offset: 23363},
+			name: "TicksToDateTimeExpression",
+			pos:  position{line: 1042, col: 1, offset: 36272},
 			expr: &actionExpr{
-				pos: position{line: 745, col: 31, offset: 23393},
-				run: (*parser).callonArrayContainsAnyExpression1,
+				pos: position{line: 1042, col: 30, offset: 36301},
+				run: (*parser).callonTicksToDateTimeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 745, col: 31, offset: 23393},
+					pos: position{line: 1042, col: 30, offset: 36301},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 745, col: 31, offset: 23393},
-							val:        "array_contains_any",
+							pos:        position{line: 1042, col: 30, offset: 36301},
+							val:        "tickstodatetime",
 							ignoreCase: true,
-							want:       "\"ARRAY_CONTAINS_ANY\"i",
+							want:       "\"TicksToDateTime\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 745, col: 53, offset: 23415},
+							pos:  position{line: 1042, col: 49, offset: 36320},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 745, col: 56, offset: 23418},
+							pos:        position{line: 1042, col: 52, offset: 36323},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 745, col: 60, offset: 23422},
+							pos:  position{line: 1042, col: 56, offset: 36327},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 745, col: 63, offset: 23425},
-							label: "array",
+							pos:   position{line: 1042, col: 59, offset: 36330},
+							label: "ticks",
 							expr: &ruleRefExpr{
-								pos:  position{line: 745, col: 69, offset: 23431},
+								pos:  position{line: 1042, col: 65, offset: 36336},
 								name: "SelectItem",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 745, col: 80, offset: 23442},
-							label: "items",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 745, col: 86, offset: 23448},
-								expr: &actionExpr{
-									pos: position{line: 745, col: 87, offset: 23449},
-									run: (*parser).callonArrayContainsAnyExpression11,
-									expr: &seqExpr{
-										pos: position{line: 745, col: 87, offset: 23449},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 745, col: 87, offset: 23449},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 745, col: 90, offset: 23452},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 745, col: 94, offset: 23456},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 745, col: 97, offset: 23459},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 745, col: 100, offset: 23462},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
 						&ruleRefExpr{
-							pos:  position{line: 745, col: 132, offset: 23494},
+							pos:  position{line: 1042, col: 76, offset: 36347},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 745, col: 135, offset: 23497},
+							pos:        position{line: 1042, col: 79, offset: 36350},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5043,86 +8126,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArrayContainsAllExpression",
-			pos:  position{line: 749, col: 1, offset: 23630},
+			name: "TimestampToDateTimeExpression",
+			pos:  position{line: 1046, col: 1, offset: 36448},
 			expr: &actionExpr{
-				pos: position{line: 749, col: 31, offset: 23660},
-				run: (*parser).callonArrayContainsAllExpression1,
+				pos: position{line: 1046, col: 34, offset: 36481},
+				run: (*parser).callonTimestampToDateTimeExpression1,
 				expr: &seqExpr{
-					pos: position{line: 749, col: 31, offset: 23660},
+					pos: position{line: 1046, col: 34, offset: 36481},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 749, col: 31, offset: 23660},
-							val:        "array_contains_all",
+							pos:        position{line: 1046, col: 34, offset: 36481},
+							val:        "timestamptodatetime",
 							ignoreCase: true,
-							want:       "\"ARRAY_CONTAINS_ALL\"i",
+							want:       "\"TimestampToDateTime\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 749, col: 53, offset: 23682},
+							pos:  position{line: 1046, col: 57, offset: 36504},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 749, col: 56, offset: 23685},
+							pos:        position{line: 1046, col: 60, offset: 36507},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 749, col: 60, offset: 23689},
+							pos:  position{line: 1046, col: 64, offset: 36511},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 749, col: 63, offset: 23692},
-							label: "array",
+							pos:   position{line: 1046, col: 67, offset: 36514},
+							label: "timestamp",
 							expr: &ruleRefExpr{
-								pos:  position{line: 749, col: 69, offset: 23698},
+								pos:  position{line: 1046, col: 77, offset: 36524},
 								name: "SelectItem",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 749, col: 80, offset: 23709},
-							label: "items",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 749, col: 86, offset: 23715},
-								expr: &actionExpr{
-									pos: position{line: 749, col: 87, offset: 23716},
-									run: (*parser).callonArrayContainsAllExpression11,
-									expr: &seqExpr{
-										pos: position{line: 749, col: 87, offset: 23716},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 749, col: 87, offset: 23716},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 749, col: 90, offset: 23719},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 749, col: 94, offset: 23723},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 749, col: 97, offset: 23726},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 749, col: 100, offset: 23729},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
 						&ruleRefExpr{
-							pos:  position{line: 749, col: 132, offset: 23761},
+							pos:  position{line: 1046, col: 88, offset: 36535},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 749, col: 135, offset: 23764},
+							pos:        position{line: 1046, col: 91, offset: 36538},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5132,48 +8177,48 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArrayLengthExpression",
-			pos:  position{line: 753, col: 1, offset: 23897},
+			name: "StAreaExpression",
+			pos:  position{line: 1050, col: 1, offset: 36644},
 			expr: &actionExpr{
-				pos: position{line: 753, col: 26, offset: 23922},
-				run: (*parser).callonArrayLengthExpression1,
+				pos: position{line: 1050, col: 21, offset: 36664},
+				run: (*parser).callonStAreaExpression1,
 				expr: &seqExpr{
-					pos: position{line: 753, col: 26, offset: 23922},
+					pos: position{line: 1050, col: 21, offset: 36664},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 753, col: 26, offset: 23922},
-							val:        "array_length",
+							pos:        position{line: 1050, col: 21, offset: 36664},
+							val:        "st_area",
 							ignoreCase: true,
-							want:       "\"ARRAY_LENGTH\"i",
+							want:       "\"ST_AREA\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 753, col: 42, offset: 23938},
+							pos:  position{line: 1050, col: 32, offset: 36675},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 753, col: 45, offset: 23941},
+							pos:        position{line: 1050, col: 35, offset: 36678},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 753, col: 49, offset: 23945},
+							pos:  position{line: 1050, col: 39, offset: 36682},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 753, col: 52, offset: 23948},
-							label: "array",
+							pos:   position{line: 1050, col: 42, offset: 36685},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 753, col: 58, offset: 23954},
+								pos:  position{line: 1050, col: 45, offset: 36688},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 753, col: 69, offset: 23965},
+							pos:  position{line: 1050, col: 56, offset: 36699},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 753, col: 72, offset: 23968},
+							pos:        position{line: 1050, col: 59, offset: 36702},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5183,108 +8228,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ArraySliceExpression",
-			pos:  position{line: 757, col: 1, offset: 24062},
+			name: "StDistanceExpression",
+			pos:  position{line: 1054, col: 1, offset: 36788},
 			expr: &actionExpr{
-				pos: position{line: 757, col: 25, offset: 24086},
-				run: (*parser).callonArraySliceExpression1,
+				pos: position{line: 1054, col: 25, offset: 36812},
+				run: (*parser).callonStDistanceExpression1,
 				expr: &seqExpr{
-					pos: position{line: 757, col: 25, offset: 24086},
+					pos: position{line: 1054, col: 25, offset: 36812},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 757, col: 25, offset: 24086},
-							val:        "array_slice",
+							pos:        position{line: 1054, col: 25, offset: 36812},
+							val:        "st_distance",
 							ignoreCase: true,
-							want:       "\"ARRAY_SLICE\"i",
+							want:       "\"ST_DISTANCE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 757, col: 40, offset: 24101},
+							pos:  position{line: 1054, col: 40, offset: 36827},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 757, col: 43, offset: 24104},
+							pos:        position{line: 1054, col: 43, offset: 36830},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 757, col: 47, offset: 24108},
+							pos:  position{line: 1054, col: 47, offset: 36834},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 757, col: 50, offset: 24111},
-							label: "array",
+							pos:   position{line: 1054, col: 50, offset: 36837},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 757, col: 56, offset: 24117},
+								pos:  position{line: 1054, col: 54, offset: 36841},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 757, col: 67, offset: 24128},
+							pos:  position{line: 1054, col: 65, offset: 36852},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 757, col: 70, offset: 24131},
+							pos:        position{line: 1054, col: 68, offset: 36855},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 757, col: 74, offset: 24135},
+							pos:  position{line: 1054, col: 72, offset: 36859},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 757, col: 77, offset: 24138},
-							label: "start",
+							pos:   position{line: 1054, col: 75, offset: 36862},
+							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 757, col: 83, offset: 24144},
+								pos:  position{line: 1054, col: 79, offset: 36866},
 								name: "SelectItem",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 757, col: 94, offset: 24155},
-							label: "length",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 757, col: 101, offset: 24162},
-								expr: &actionExpr{
-									pos: position{line: 757, col: 102, offset: 24163},
-									run: (*parser).callonArraySliceExpression16,
-									expr: &seqExpr{
-										pos: position{line: 757, col: 102, offset: 24163},
-										exprs: []any{
-											&ruleRefExpr{
-												pos:  position{line: 757, col: 102, offset: 24163},
-												name: "ws",
-											},
-											&litMatcher{
-												pos:        position{line: 757, col: 105, offset: 24166},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 757, col: 109, offset: 24170},
-												name: "ws",
-											},
-											&labeledExpr{
-												pos:   position{line: 757, col: 112, offset: 24173},
-												label: "ex",
-												expr: &ruleRefExpr{
-													pos:  position{line: 757, col: 115, offset: 24176},
-													name: "SelectItem",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
 						&ruleRefExpr{
-							pos:  position{line: 757, col: 147, offset: 24208},
+							pos:  position{line: 1054, col: 90, offset: 36877},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 757, col: 150, offset: 24211},
+							pos:        position{line: 1054, col: 93, offset: 36880},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5294,70 +8301,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SetIntersectExpression",
-			pos:  position{line: 761, col: 1, offset: 24319},
+			name: "StWithinExpression",
+			pos:  position{line: 1058, col: 1, offset: 36976},
 			expr: &actionExpr{
-				pos: position{line: 761, col: 27, offset: 24345},
-				run: (*parser).callonSetIntersectExpression1,
+				pos: position{line: 1058, col: 23, offset: 36998},
+				run: (*parser).callonStWithinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 761, col: 27, offset: 24345},
+					pos: position{line: 1058, col: 23, offset: 36998},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 761, col: 27, offset: 24345},
-							val:        "setintersect",
+							pos:        position{line: 1058, col: 23, offset: 36998},
+							val:        "st_within",
 							ignoreCase: true,
-							want:       "\"SetIntersect\"i",
+							want:       "\"ST_WITHIN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 761, col: 43, offset: 24361},
+							pos:  position{line: 1058, col: 36, offset: 37011},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 761, col: 46, offset: 24364},
+							pos:        position{line: 1058, col: 39, offset: 37014},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 761, col: 50, offset: 24368},
+							pos:  position{line: 1058, col: 43, offset: 37018},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 761, col: 53, offset: 24371},
-							label: "set1",
+							pos:   position{line: 1058, col: 46, offset: 37021},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 761, col: 58, offset: 24376},
+								pos:  position{line: 1058, col: 50, offset: 37025},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 761, col: 69, offset: 24387},
+							pos:  position{line: 1058, col: 61, offset: 37036},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 761, col: 72, offset: 24390},
+							pos:        position{line: 1058, col: 64, offset: 37039},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 761, col: 76, offset: 24394},
+							pos:  position{line: 1058, col: 68, offset: 37043},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 761, col: 79, offset: 24397},
-							label: "set2",
+							pos:   position{line: 1058, col: 71, offset: 37046},
+							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 761, col: 84, offset: 24402},
+								pos:  position{line: 1058, col: 75, offset: 37050},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 761, col: 95, offset: 24413},
+							pos:  position{line: 1058, col: 86, offset: 37061},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 761, col: 98, offset: 24416},
+							pos:        position{line: 1058, col: 89, offset: 37064},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5367,70 +8374,70 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SetUnionExpression",
-			pos:  position{line: 765, col: 1, offset: 24516},
+			name: "StIntersectsExpression",
+			pos:  position{line: 1062, col: 1, offset: 37158},
 			expr: &actionExpr{
-				pos: position{line: 765, col: 23, offset: 24538},
-				run: (*parser).callonSetUnionExpression1,
+				pos: position{line: 1062, col: 27, offset: 37184},
+				run: (*parser).callonStIntersectsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 765, col: 23, offset: 24538},
+					pos: position{line: 1062, col: 27, offset: 37184},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 765, col: 23, offset: 24538},
-							val:        "setunion",
+							pos:        position{line: 1062, col: 27, offset: 37184},
+							val:        "st_intersects",
 							ignoreCase: true,
-							want:       "\"SetUnion\"i",
+							want:       "\"ST_INTERSECTS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 765, col: 35, offset: 24550},
+							pos:  position{line: 1062, col: 44, offset: 37201},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 765, col: 38, offset: 24553},
+							pos:        position{line: 1062, col: 47, offset: 37204},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 765, col: 42, offset: 24557},
+							pos:  position{line: 1062, col: 51, offset: 37208},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 765, col: 45, offset: 24560},
-							label: "set1",
+							pos:   position{line: 1062, col: 54, offset: 37211},
+							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 765, col: 50, offset: 24565},
+								pos:  position{line: 1062, col: 58, offset: 37215},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 765, col: 61, offset: 24576},
+							pos:  position{line: 1062, col: 69, offset: 37226},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 765, col: 64, offset: 24579},
+							pos:        position{line: 1062, col: 72, offset: 37229},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 765, col: 68, offset: 24583},
+							pos:  position{line: 1062, col: 76, offset: 37233},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 765, col: 71, offset: 24586},
-							label: "set2",
+							pos:   position{line: 1062, col: 79, offset: 37236},
+							label: "ex2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 765, col: 76, offset: 24591},
+								pos:  position{line: 1062, col: 83, offset: 37240},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 765, col: 87, offset: 24602},
+							pos:  position{line: 1062, col: 94, offset: 37251},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 765, col: 90, offset: 24605},
+							pos:        position{line: 1062, col: 97, offset: 37254},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5440,92 +8447,99 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IifExpression",
-			pos:  position{line: 769, col: 1, offset: 24701},
+			name: "StIsValidExpression",
+			pos:  position{line: 1066, col: 1, offset: 37352},
 			expr: &actionExpr{
-				pos: position{line: 769, col: 18, offset: 24718},
-				run: (*parser).callonIifExpression1,
+				pos: position{line: 1066, col: 24, offset: 37375},
+				run: (*parser).callonStIsValidExpression1,
 				expr: &seqExpr{
-					pos: position{line: 769, col: 18, offset: 24718},
+					pos: position{line: 1066, col: 24, offset: 37375},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 769, col: 18, offset: 24718},
-							val:        "iif",
+							pos:        position{line: 1066, col: 24, offset: 37375},
+							val:        "st_isvalid",
 							ignoreCase: true,
-							want:       "\"IIF\"i",
+							want:       "\"ST_ISVALID\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 769, col: 25, offset: 24725},
+							pos:  position{line: 1066, col: 38, offset: 37389},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 769, col: 28, offset: 24728},
+							pos:        position{line: 1066, col: 41, offset: 37392},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 769, col: 32, offset: 24732},
+							pos:  position{line: 1066, col: 45, offset: 37396},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 769, col: 35, offset: 24735},
-							label: "condition",
+							pos:   position{line: 1066, col: 48, offset: 37399},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 769, col: 45, offset: 24745},
+								pos:  position{line: 1066, col: 51, offset: 37402},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 769, col: 56, offset: 24756},
+							pos:  position{line: 1066, col: 62, offset: 37413},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 769, col: 59, offset: 24759},
-							val:        ",",
+							pos:        position{line: 1066, col: 65, offset: 37416},
+							val:        ")",
 							ignoreCase: false,
-							want:       "\",\"",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 769, col: 63, offset: 24763},
-							name: "ws",
+							want:       "\")\"",
 						},
-						&labeledExpr{
-							pos:   position{line: 769, col: 66, offset: 24766},
-							label: "trueValue",
-							expr: &ruleRefExpr{
-								pos:  position{line: 769, col: 76, offset: 24776},
-								name: "SelectItem",
-							},
+					},
+				},
+			},
+		},
+		{
+			name: "StIsValidDetailedExpression",
+			pos:  position{line: 1070, col: 1, offset: 37505},
+			expr: &actionExpr{
+				pos: position{line: 1070, col: 32, offset: 37536},
+				run: (*parser).callonStIsValidDetailedExpression1,
+				expr: &seqExpr{
+					pos: position{line: 1070, col: 32, offset: 37536},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1070, col: 32, offset: 37536},
+							val:        "st_isvaliddetailed",
+							ignoreCase: true,
+							want:       "\"ST_ISVALIDDETAILED\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 769, col: 87, offset: 24787},
+							pos:  position{line: 1070, col: 54, offset: 37558},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 769, col: 90, offset: 24790},
-							val:        ",",
+							pos:        position{line: 1070, col: 57, offset: 37561},
+							val:        "(",
 							ignoreCase: false,
-							want:       "\",\"",
+							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 769, col: 94, offset: 24794},
+							pos:  position{line: 1070, col: 61, offset: 37565},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 769, col: 97, offset: 24797},
-							label: "falseValue",
+							pos:   position{line: 1070, col: 64, offset: 37568},
+							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 769, col: 108, offset: 24808},
+								pos:  position{line: 1070, col: 67, offset: 37571},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 769, col: 119, offset: 24819},
+							pos:  position{line: 1070, col: 78, offset: 37582},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 769, col: 122, offset: 24822},
+							pos:        position{line: 1070, col: 81, offset: 37585},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5536,47 +8550,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAbsExpression",
-			pos:  position{line: 773, col: 1, offset: 24935},
+			pos:  position{line: 1074, col: 1, offset: 37682},
 			expr: &actionExpr{
-				pos: position{line: 773, col: 22, offset: 24956},
+				pos: position{line: 1074, col: 22, offset: 37703},
 				run: (*parser).callonMathAbsExpression1,
 				expr: &seqExpr{
-					pos: position{line: 773, col: 22, offset: 24956},
+					pos: position{line: 1074, col: 22, offset: 37703},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 773, col: 22, offset: 24956},
+							pos:        position{line: 1074, col: 22, offset: 37703},
 							val:        "abs",
 							ignoreCase: true,
 							want:       "\"ABS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 773, col: 29, offset: 24963},
+							pos:  position{line: 1074, col: 29, offset: 37710},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 773, col: 32, offset: 24966},
+							pos:        position{line: 1074, col: 32, offset: 37713},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 773, col: 36, offset: 24970},
+							pos:  position{line: 1074, col: 36, offset: 37717},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 773, col: 39, offset: 24973},
+							pos:   position{line: 1074, col: 39, offset: 37720},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 773, col: 42, offset: 24976},
+								pos:  position{line: 1074, col: 42, offset: 37723},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 773, col: 53, offset: 24987},
+							pos:  position{line: 1074, col: 53, offset: 37734},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 773, col: 56, offset: 24990},
+							pos:        position{line: 1074, col: 56, offset: 37737},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5587,47 +8601,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAcosExpression",
-			pos:  position{line: 774, col: 1, offset: 25072},
+			pos:  position{line: 1075, col: 1, offset: 37819},
 			expr: &actionExpr{
-				pos: position{line: 774, col: 23, offset: 25094},
+				pos: position{line: 1075, col: 23, offset: 37841},
 				run: (*parser).callonMathAcosExpression1,
 				expr: &seqExpr{
-					pos: position{line: 774, col: 23, offset: 25094},
+					pos: position{line: 1075, col: 23, offset: 37841},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 774, col: 23, offset: 25094},
+							pos:        position{line: 1075, col: 23, offset: 37841},
 							val:        "acos",
 							ignoreCase: true,
 							want:       "\"ACOS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 774, col: 31, offset: 25102},
+							pos:  position{line: 1075, col: 31, offset: 37849},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 774, col: 34, offset: 25105},
+							pos:        position{line: 1075, col: 34, offset: 37852},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 774, col: 38, offset: 25109},
+							pos:  position{line: 1075, col: 38, offset: 37856},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 774, col: 41, offset: 25112},
+							pos:   position{line: 1075, col: 41, offset: 37859},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 774, col: 44, offset: 25115},
+								pos:  position{line: 1075, col: 44, offset: 37862},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 774, col: 55, offset: 25126},
+							pos:  position{line: 1075, col: 55, offset: 37873},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 774, col: 58, offset: 25129},
+							pos:        position{line: 1075, col: 58, offset: 37876},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5638,47 +8652,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAsinExpression",
-			pos:  position{line: 775, col: 1, offset: 25212},
+			pos:  position{line: 1076, col: 1, offset: 37959},
 			expr: &actionExpr{
-				pos: position{line: 775, col: 23, offset: 25234},
+				pos: position{line: 1076, col: 23, offset: 37981},
 				run: (*parser).callonMathAsinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 775, col: 23, offset: 25234},
+					pos: position{line: 1076, col: 23, offset: 37981},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 775, col: 23, offset: 25234},
+							pos:        position{line: 1076, col: 23, offset: 37981},
 							val:        "asin",
 							ignoreCase: true,
 							want:       "\"ASIN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 775, col: 31, offset: 25242},
+							pos:  position{line: 1076, col: 31, offset: 37989},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 775, col: 34, offset: 25245},
+							pos:        position{line: 1076, col: 34, offset: 37992},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 775, col: 38, offset: 25249},
+							pos:  position{line: 1076, col: 38, offset: 37996},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 775, col: 41, offset: 25252},
+							pos:   position{line: 1076, col: 41, offset: 37999},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 775, col: 44, offset: 25255},
+								pos:  position{line: 1076, col: 44, offset: 38002},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 775, col: 55, offset: 25266},
+							pos:  position{line: 1076, col: 55, offset: 38013},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 775, col: 58, offset: 25269},
+							pos:        position{line: 1076, col: 58, offset: 38016},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5689,47 +8703,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathAtanExpression",
-			pos:  position{line: 776, col: 1, offset: 25352},
+			pos:  position{line: 1077, col: 1, offset: 38099},
 			expr: &actionExpr{
-				pos: position{line: 776, col: 23, offset: 25374},
+				pos: position{line: 1077, col: 23, offset: 38121},
 				run: (*parser).callonMathAtanExpression1,
 				expr: &seqExpr{
-					pos: position{line: 776, col: 23, offset: 25374},
+					pos: position{line: 1077, col: 23, offset: 38121},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 776, col: 23, offset: 25374},
+							pos:        position{line: 1077, col: 23, offset: 38121},
 							val:        "atan",
 							ignoreCase: true,
 							want:       "\"ATAN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 776, col: 31, offset: 25382},
+							pos:  position{line: 1077, col: 31, offset: 38129},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 776, col: 34, offset: 25385},
+							pos:        position{line: 1077, col: 34, offset: 38132},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 776, col: 38, offset: 25389},
+							pos:  position{line: 1077, col: 38, offset: 38136},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 776, col: 41, offset: 25392},
+							pos:   position{line: 1077, col: 41, offset: 38139},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 776, col: 44, offset: 25395},
+								pos:  position{line: 1077, col: 44, offset: 38142},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 776, col: 55, offset: 25406},
+							pos:  position{line: 1077, col: 55, offset: 38153},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 776, col: 58, offset: 25409},
+							pos:        position{line: 1077, col: 58, offset: 38156},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5740,47 +8754,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathCeilingExpression",
-			pos:  position{line: 777, col: 1, offset: 25492},
+			pos:  position{line: 1078, col: 1, offset: 38239},
 			expr: &actionExpr{
-				pos: position{line: 777, col: 26, offset: 25517},
+				pos: position{line: 1078, col: 26, offset: 38264},
 				run: (*parser).callonMathCeilingExpression1,
 				expr: &seqExpr{
-					pos: position{line: 777, col: 26, offset: 25517},
+					pos: position{line: 1078, col: 26, offset: 38264},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 777, col: 26, offset: 25517},
+							pos:        position{line: 1078, col: 26, offset: 38264},
 							val:        "ceiling",
 							ignoreCase: true,
 							want:       "\"CEILING\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 777, col: 37, offset: 25528},
+							pos:  position{line: 1078, col: 37, offset: 38275},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 777, col: 40, offset: 25531},
+							pos:        position{line: 1078, col: 40, offset: 38278},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 777, col: 44, offset: 25535},
+							pos:  position{line: 1078, col: 44, offset: 38282},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 777, col: 47, offset: 25538},
+							pos:   position{line: 1078, col: 47, offset: 38285},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 777, col: 50, offset: 25541},
+								pos:  position{line: 1078, col: 50, offset: 38288},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 777, col: 61, offset: 25552},
+							pos:  position{line: 1078, col: 61, offset: 38299},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 777, col: 64, offset: 25555},
+							pos:        position{line: 1078, col: 64, offset: 38302},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5791,47 +8805,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathCosExpression",
-			pos:  position{line: 778, col: 1, offset: 25641},
+			pos:  position{line: 1079, col: 1, offset: 38388},
 			expr: &actionExpr{
-				pos: position{line: 778, col: 22, offset: 25662},
+				pos: position{line: 1079, col: 22, offset: 38409},
 				run: (*parser).callonMathCosExpression1,
 				expr: &seqExpr{
-					pos: position{line: 778, col: 22, offset: 25662},
+					pos: position{line: 1079, col: 22, offset: 38409},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 778, col: 22, offset: 25662},
+							pos:        position{line: 1079, col: 22, offset: 38409},
 							val:        "cos",
 							ignoreCase: true,
 							want:       "\"COS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 778, col: 29, offset: 25669},
+							pos:  position{line: 1079, col: 29, offset: 38416},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 778, col: 32, offset: 25672},
+							pos:        position{line: 1079, col: 32, offset: 38419},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 778, col: 36, offset: 25676},
+							pos:  position{line: 1079, col: 36, offset: 38423},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 778, col: 39, offset: 25679},
+							pos:   position{line: 1079, col: 39, offset: 38426},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 778, col: 42, offset: 25682},
+								pos:  position{line: 1079, col: 42, offset: 38429},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 778, col: 53, offset: 25693},
+							pos:  position{line: 1079, col: 53, offset: 38440},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 778, col: 56, offset: 25696},
+							pos:        position{line: 1079, col: 56, offset: 38443},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5842,47 +8856,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathCotExpression",
-			pos:  position{line: 779, col: 1, offset: 25778},
+			pos:  position{line: 1080, col: 1, offset: 38525},
 			expr: &actionExpr{
-				pos: position{line: 779, col: 22, offset: 25799},
+				pos: position{line: 1080, col: 22, offset: 38546},
 				run: (*parser).callonMathCotExpression1,
 				expr: &seqExpr{
-					pos: position{line: 779, col: 22, offset: 25799},
+					pos: position{line: 1080, col: 22, offset: 38546},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 779, col: 22, offset: 25799},
+							pos:        position{line: 1080, col: 22, offset: 38546},
 							val:        "cot",
 							ignoreCase: true,
 							want:       "\"COT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 779, col: 29, offset: 25806},
+							pos:  position{line: 1080, col: 29, offset: 38553},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 779, col: 32, offset: 25809},
+							pos:        position{line: 1080, col: 32, offset: 38556},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 779, col: 36, offset: 25813},
+							pos:  position{line: 1080, col: 36, offset: 38560},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 779, col: 39, offset: 25816},
+							pos:   position{line: 1080, col: 39, offset: 38563},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 779, col: 42, offset: 25819},
+								pos:  position{line: 1080, col: 42, offset: 38566},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 779, col: 53, offset: 25830},
+							pos:  position{line: 1080, col: 53, offset: 38577},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 779, col: 56, offset: 25833},
+							pos:        position{line: 1080, col: 56, offset: 38580},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5893,47 +8907,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathDegreesExpression",
-			pos:  position{line: 780, col: 1, offset: 25915},
+			pos:  position{line: 1081, col: 1, offset: 38662},
 			expr: &actionExpr{
-				pos: position{line: 780, col: 26, offset: 25940},
+				pos: position{line: 1081, col: 26, offset: 38687},
 				run: (*parser).callonMathDegreesExpression1,
 				expr: &seqExpr{
-					pos: position{line: 780, col: 26, offset: 25940},
+					pos: position{line: 1081, col: 26, offset: 38687},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 780, col: 26, offset: 25940},
+							pos:        position{line: 1081, col: 26, offset: 38687},
 							val:        "degrees",
 							ignoreCase: true,
 							want:       "\"DEGREES\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 780, col: 37, offset: 25951},
+							pos:  position{line: 1081, col: 37, offset: 38698},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 780, col: 40, offset: 25954},
+							pos:        position{line: 1081, col: 40, offset: 38701},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 780, col: 44, offset: 25958},
+							pos:  position{line: 1081, col: 44, offset: 38705},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 780, col: 47, offset: 25961},
+							pos:   position{line: 1081, col: 47, offset: 38708},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 780, col: 50, offset: 25964},
+								pos:  position{line: 1081, col: 50, offset: 38711},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 780, col: 61, offset: 25975},
+							pos:  position{line: 1081, col: 61, offset: 38722},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 780, col: 64, offset: 25978},
+							pos:        position{line: 1081, col: 64, offset: 38725},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5944,47 +8958,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathExpExpression",
-			pos:  position{line: 781, col: 1, offset: 26064},
+			pos:  position{line: 1082, col: 1, offset: 38811},
 			expr: &actionExpr{
-				pos: position{line: 781, col: 22, offset: 26085},
+				pos: position{line: 1082, col: 22, offset: 38832},
 				run: (*parser).callonMathExpExpression1,
 				expr: &seqExpr{
-					pos: position{line: 781, col: 22, offset: 26085},
+					pos: position{line: 1082, col: 22, offset: 38832},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 781, col: 22, offset: 26085},
+							pos:        position{line: 1082, col: 22, offset: 38832},
 							val:        "exp",
 							ignoreCase: true,
 							want:       "\"EXP\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 781, col: 29, offset: 26092},
+							pos:  position{line: 1082, col: 29, offset: 38839},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 781, col: 32, offset: 26095},
+							pos:        position{line: 1082, col: 32, offset: 38842},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 781, col: 36, offset: 26099},
+							pos:  position{line: 1082, col: 36, offset: 38846},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 781, col: 39, offset: 26102},
+							pos:   position{line: 1082, col: 39, offset: 38849},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 781, col: 42, offset: 26105},
+								pos:  position{line: 1082, col: 42, offset: 38852},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 781, col: 53, offset: 26116},
+							pos:  position{line: 1082, col: 53, offset: 38863},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 781, col: 56, offset: 26119},
+							pos:        position{line: 1082, col: 56, offset: 38866},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -5995,47 +9009,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathFloorExpression",
-			pos:  position{line: 782, col: 1, offset: 26201},
+			pos:  position{line: 1083, col: 1, offset: 38948},
 			expr: &actionExpr{
-				pos: position{line: 782, col: 24, offset: 26224},
+				pos: position{line: 1083, col: 24, offset: 38971},
 				run: (*parser).callonMathFloorExpression1,
 				expr: &seqExpr{
-					pos: position{line: 782, col: 24, offset: 26224},
+					pos: position{line: 1083, col: 24, offset: 38971},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 782, col: 24, offset: 26224},
+							pos:        position{line: 1083, col: 24, offset: 38971},
 							val:        "floor",
 							ignoreCase: true,
 							want:       "\"FLOOR\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 782, col: 33, offset: 26233},
+							pos:  position{line: 1083, col: 33, offset: 38980},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 782, col: 36, offset: 26236},
+							pos:        position{line: 1083, col: 36, offset: 38983},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 782, col: 40, offset: 26240},
+							pos:  position{line: 1083, col: 40, offset: 38987},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 782, col: 43, offset: 26243},
+							pos:   position{line: 1083, col: 43, offset: 38990},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 782, col: 46, offset: 26246},
+								pos:  position{line: 1083, col: 46, offset: 38993},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 782, col: 57, offset: 26257},
+							pos:  position{line: 1083, col: 57, offset: 39004},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 782, col: 60, offset: 26260},
+							pos:        position{line: 1083, col: 60, offset: 39007},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6046,47 +9060,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitNotExpression",
-			pos:  position{line: 783, col: 1, offset: 26344},
+			pos:  position{line: 1084, col: 1, offset: 39091},
 			expr: &actionExpr{
-				pos: position{line: 783, col: 28, offset: 26371},
+				pos: position{line: 1084, col: 28, offset: 39118},
 				run: (*parser).callonMathIntBitNotExpression1,
 				expr: &seqExpr{
-					pos: position{line: 783, col: 28, offset: 26371},
+					pos: position{line: 1084, col: 28, offset: 39118},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 783, col: 28, offset: 26371},
+							pos:        position{line: 1084, col: 28, offset: 39118},
 							val:        "intbitnot",
 							ignoreCase: true,
 							want:       "\"IntBitNot\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 783, col: 41, offset: 26384},
+							pos:  position{line: 1084, col: 41, offset: 39131},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 783, col: 44, offset: 26387},
+							pos:        position{line: 1084, col: 44, offset: 39134},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 783, col: 48, offset: 26391},
+							pos:  position{line: 1084, col: 48, offset: 39138},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 783, col: 51, offset: 26394},
+							pos:   position{line: 1084, col: 51, offset: 39141},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 783, col: 54, offset: 26397},
+								pos:  position{line: 1084, col: 54, offset: 39144},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 783, col: 65, offset: 26408},
+							pos:  position{line: 1084, col: 65, offset: 39155},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 783, col: 68, offset: 26411},
+							pos:        position{line: 1084, col: 68, offset: 39158},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6097,47 +9111,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathLog10Expression",
-			pos:  position{line: 784, col: 1, offset: 26499},
+			pos:  position{line: 1085, col: 1, offset: 39246},
 			expr: &actionExpr{
-				pos: position{line: 784, col: 24, offset: 26522},
+				pos: position{line: 1085, col: 24, offset: 39269},
 				run: (*parser).callonMathLog10Expression1,
 				expr: &seqExpr{
-					pos: position{line: 784, col: 24, offset: 26522},
+					pos: position{line: 1085, col: 24, offset: 39269},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 784, col: 24, offset: 26522},
+							pos:        position{line: 1085, col: 24, offset: 39269},
 							val:        "log10",
 							ignoreCase: true,
 							want:       "\"LOG10\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 784, col: 33, offset: 26531},
+							pos:  position{line: 1085, col: 33, offset: 39278},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 784, col: 36, offset: 26534},
+							pos:        position{line: 1085, col: 36, offset: 39281},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 784, col: 40, offset: 26538},
+							pos:  position{line: 1085, col: 40, offset: 39285},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 784, col: 43, offset: 26541},
+							pos:   position{line: 1085, col: 43, offset: 39288},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 784, col: 46, offset: 26544},
+								pos:  position{line: 1085, col: 46, offset: 39291},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 784, col: 57, offset: 26555},
+							pos:  position{line: 1085, col: 57, offset: 39302},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 784, col: 60, offset: 26558},
+							pos:        position{line: 1085, col: 60, offset: 39305},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6148,47 +9162,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathRadiansExpression",
-			pos:  position{line: 785, col: 1, offset: 26642},
+			pos:  position{line: 1086, col: 1, offset: 39389},
 			expr: &actionExpr{
-				pos: position{line: 785, col: 26, offset: 26667},
+				pos: position{line: 1086, col: 26, offset: 39414},
 				run: (*parser).callonMathRadiansExpression1,
 				expr: &seqExpr{
-					pos: position{line: 785, col: 26, offset: 26667},
+					pos: position{line: 1086, col: 26, offset: 39414},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 785, col: 26, offset: 26667},
+							pos:        position{line: 1086, col: 26, offset: 39414},
 							val:        "radians",
 							ignoreCase: true,
 							want:       "\"RADIANS\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 785, col: 37, offset: 26678},
+							pos:  position{line: 1086, col: 37, offset: 39425},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 785, col: 40, offset: 26681},
+							pos:        position{line: 1086, col: 40, offset: 39428},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 785, col: 44, offset: 26685},
+							pos:  position{line: 1086, col: 44, offset: 39432},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 785, col: 47, offset: 26688},
+							pos:   position{line: 1086, col: 47, offset: 39435},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 785, col: 50, offset: 26691},
+								pos:  position{line: 1086, col: 50, offset: 39438},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 785, col: 61, offset: 26702},
+							pos:  position{line: 1086, col: 61, offset: 39449},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 785, col: 64, offset: 26705},
+							pos:        position{line: 1086, col: 64, offset: 39452},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6199,47 +9213,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathRoundExpression",
-			pos:  position{line: 786, col: 1, offset: 26791},
+			pos:  position{line: 1087, col: 1, offset: 39538},
 			expr: &actionExpr{
-				pos: position{line: 786, col: 24, offset: 26814},
+				pos: position{line: 1087, col: 24, offset: 39561},
 				run: (*parser).callonMathRoundExpression1,
 				expr: &seqExpr{
-					pos: position{line: 786, col: 24, offset: 26814},
+					pos: position{line: 1087, col: 24, offset: 39561},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 786, col: 24, offset: 26814},
+							pos:        position{line: 1087, col: 24, offset: 39561},
 							val:        "round",
 							ignoreCase: true,
 							want:       "\"ROUND\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 786, col: 33, offset: 26823},
+							pos:  position{line: 1087, col: 33, offset: 39570},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 786, col: 36, offset: 26826},
+							pos:        position{line: 1087, col: 36, offset: 39573},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 786, col: 40, offset: 26830},
+							pos:  position{line: 1087, col: 40, offset: 39577},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 786, col: 43, offset: 26833},
+							pos:   position{line: 1087, col: 43, offset: 39580},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 786, col: 46, offset: 26836},
+								pos:  position{line: 1087, col: 46, offset: 39583},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 786, col: 57, offset: 26847},
+							pos:  position{line: 1087, col: 57, offset: 39594},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 786, col: 60, offset: 26850},
+							pos:        position{line: 1087, col: 60, offset: 39597},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6250,47 +9264,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSignExpression",
-			pos:  position{line: 787, col: 1, offset: 26934},
+			pos:  position{line: 1088, col: 1, offset: 39681},
 			expr: &actionExpr{
-				pos: position{line: 787, col: 23, offset: 26956},
+				pos: position{line: 1088, col: 23, offset: 39703},
 				run: (*parser).callonMathSignExpression1,
 				expr: &seqExpr{
-					pos: position{line: 787, col: 23, offset: 26956},
+					pos: position{line: 1088, col: 23, offset: 39703},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 787, col: 23, offset: 26956},
+							pos:        position{line: 1088, col: 23, offset: 39703},
 							val:        "sign",
 							ignoreCase: true,
 							want:       "\"SIGN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 787, col: 31, offset: 26964},
+							pos:  position{line: 1088, col: 31, offset: 39711},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 787, col: 34, offset: 26967},
+							pos:        position{line: 1088, col: 34, offset: 39714},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 787, col: 38, offset: 26971},
+							pos:  position{line: 1088, col: 38, offset: 39718},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 787, col: 41, offset: 26974},
+							pos:   position{line: 1088, col: 41, offset: 39721},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 787, col: 44, offset: 26977},
+								pos:  position{line: 1088, col: 44, offset: 39724},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 787, col: 55, offset: 26988},
+							pos:  position{line: 1088, col: 55, offset: 39735},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 787, col: 58, offset: 26991},
+							pos:        position{line: 1088, col: 58, offset: 39738},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6301,47 +9315,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSinExpression",
-			pos:  position{line: 788, col: 1, offset: 27074},
+			pos:  position{line: 1089, col: 1, offset: 39821},
 			expr: &actionExpr{
-				pos: position{line: 788, col: 22, offset: 27095},
+				pos: position{line: 1089, col: 22, offset: 39842},
 				run: (*parser).callonMathSinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 788, col: 22, offset: 27095},
+					pos: position{line: 1089, col: 22, offset: 39842},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 788, col: 22, offset: 27095},
+							pos:        position{line: 1089, col: 22, offset: 39842},
 							val:        "sin",
 							ignoreCase: true,
 							want:       "\"SIN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 788, col: 29, offset: 27102},
+							pos:  position{line: 1089, col: 29, offset: 39849},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 788, col: 32, offset: 27105},
+							pos:        position{line: 1089, col: 32, offset: 39852},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 788, col: 36, offset: 27109},
+							pos:  position{line: 1089, col: 36, offset: 39856},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 788, col: 39, offset: 27112},
+							pos:   position{line: 1089, col: 39, offset: 39859},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 788, col: 42, offset: 27115},
+								pos:  position{line: 1089, col: 42, offset: 39862},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 788, col: 53, offset: 27126},
+							pos:  position{line: 1089, col: 53, offset: 39873},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 788, col: 56, offset: 27129},
+							pos:        position{line: 1089, col: 56, offset: 39876},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6352,47 +9366,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSqrtExpression",
-			pos:  position{line: 789, col: 1, offset: 27211},
+			pos:  position{line: 1090, col: 1, offset: 39958},
 			expr: &actionExpr{
-				pos: position{line: 789, col: 23, offset: 27233},
+				pos: position{line: 1090, col: 23, offset: 39980},
 				run: (*parser).callonMathSqrtExpression1,
 				expr: &seqExpr{
-					pos: position{line: 789, col: 23, offset: 27233},
+					pos: position{line: 1090, col: 23, offset: 39980},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 789, col: 23, offset: 27233},
+							pos:        position{line: 1090, col: 23, offset: 39980},
 							val:        "sqrt",
 							ignoreCase: true,
 							want:       "\"SQRT\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 789, col: 31, offset: 27241},
+							pos:  position{line: 1090, col: 31, offset: 39988},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 789, col: 34, offset: 27244},
+							pos:        position{line: 1090, col: 34, offset: 39991},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 789, col: 38, offset: 27248},
+							pos:  position{line: 1090, col: 38, offset: 39995},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 789, col: 41, offset: 27251},
+							pos:   position{line: 1090, col: 41, offset: 39998},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 789, col: 44, offset: 27254},
+								pos:  position{line: 1090, col: 44, offset: 40001},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 789, col: 55, offset: 27265},
+							pos:  position{line: 1090, col: 55, offset: 40012},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 789, col: 58, offset: 27268},
+							pos:        position{line: 1090, col: 58, offset: 40015},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6403,47 +9417,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathSquareExpression",
-			pos:  position{line: 790, col: 1, offset: 27351},
+			pos:  position{line: 1091, col: 1, offset: 40098},
 			expr: &actionExpr{
-				pos: position{line: 790, col: 25, offset: 27375},
+				pos: position{line: 1091, col: 25, offset: 40122},
 				run: (*parser).callonMathSquareExpression1,
 				expr: &seqExpr{
-					pos: position{line: 790, col: 25, offset: 27375},
+					pos: position{line: 1091, col: 25, offset: 40122},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 790, col: 25, offset: 27375},
+							pos:        position{line: 1091, col: 25, offset: 40122},
 							val:        "square",
 							ignoreCase: true,
 							want:       "\"SQUARE\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 790, col: 35, offset: 27385},
+							pos:  position{line: 1091, col: 35, offset: 40132},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 790, col: 38, offset: 27388},
+							pos:        position{line: 1091, col: 38, offset: 40135},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 790, col: 42, offset: 27392},
+							pos:  position{line: 1091, col: 42, offset: 40139},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 790, col: 45, offset: 27395},
+							pos:   position{line: 1091, col: 45, offset: 40142},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 790, col: 48, offset: 27398},
+								pos:  position{line: 1091, col: 48, offset: 40145},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 790, col: 59, offset: 27409},
+							pos:  position{line: 1091, col: 59, offset: 40156},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 790, col: 62, offset: 27412},
+							pos:        position{line: 1091, col: 62, offset: 40159},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6454,47 +9468,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathTanExpression",
-			pos:  position{line: 791, col: 1, offset: 27497},
+			pos:  position{line: 1092, col: 1, offset: 40244},
 			expr: &actionExpr{
-				pos: position{line: 791, col: 22, offset: 27518},
+				pos: position{line: 1092, col: 22, offset: 40265},
 				run: (*parser).callonMathTanExpression1,
 				expr: &seqExpr{
-					pos: position{line: 791, col: 22, offset: 27518},
+					pos: position{line: 1092, col: 22, offset: 40265},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 791, col: 22, offset: 27518},
+							pos:        position{line: 1092, col: 22, offset: 40265},
 							val:        "tan",
 							ignoreCase: true,
 							want:       "\"TAN\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 791, col: 29, offset: 27525},
+							pos:  position{line: 1092, col: 29, offset: 40272},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 791, col: 32, offset: 27528},
+							pos:        position{line: 1092, col: 32, offset: 40275},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 791, col: 36, offset: 27532},
+							pos:  position{line: 1092, col: 36, offset: 40279},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 791, col: 39, offset: 27535},
+							pos:   position{line: 1092, col: 39, offset: 40282},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 791, col: 42, offset: 27538},
+								pos:  position{line: 1092, col: 42, offset: 40285},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 791, col: 53, offset: 27549},
+							pos:  position{line: 1092, col: 53, offset: 40296},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 791, col: 56, offset: 27552},
+							pos:        position{line: 1092, col: 56, offset: 40299},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6505,47 +9519,47 @@ var g = &grammar{
 		},
 		{
 			name: "MathTruncExpression",
-			pos:  position{line: 792, col: 1, offset: 27634},
+			pos:  position{line: 1093, col: 1, offset: 40381},
 			expr: &actionExpr{
-				pos: position{line: 792, col: 24, offset: 27657},
+				pos: position{line: 1093, col: 24, offset: 40404},
 				run: (*parser).callonMathTruncExpression1,
 				expr: &seqExpr{
-					pos: position{line: 792, col: 24, offset: 27657},
+					pos: position{line: 1093, col: 24, offset: 40404},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 792, col: 24, offset: 27657},
+							pos:        position{line: 1093, col: 24, offset: 40404},
 							val:        "trunc",
 							ignoreCase: true,
 							want:       "\"TRUNC\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 792, col: 33, offset: 27666},
+							pos:  position{line: 1093, col: 33, offset: 40413},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 792, col: 36, offset: 27669},
+							pos:        position{line: 1093, col: 36, offset: 40416},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 792, col: 40, offset: 27673},
+							pos:  position{line: 1093, col: 40, offset: 40420},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 792, col: 43, offset: 27676},
+							pos:   position{line: 1093, col: 43, offset: 40423},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 792, col: 46, offset: 27679},
+								pos:  position{line: 1093, col: 46, offset: 40426},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 792, col: 57, offset: 27690},
+							pos:  position{line: 1093, col: 57, offset: 40437},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 792, col: 60, offset: 27693},
+							pos:        position{line: 1093, col: 60, offset: 40440},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6556,69 +9570,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathAtn2Expression",
-			pos:  position{line: 794, col: 1, offset: 27778},
+			pos:  position{line: 1095, col: 1, offset: 40525},
 			expr: &actionExpr{
-				pos: position{line: 794, col: 23, offset: 27800},
+				pos: position{line: 1095, col: 23, offset: 40547},
 				run: (*parser).callonMathAtn2Expression1,
 				expr: &seqExpr{
-					pos: position{line: 794, col: 23, offset: 27800},
+					pos: position{line: 1095, col: 23, offset: 40547},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 794, col: 23, offset: 27800},
+							pos:        position{line: 1095, col: 23, offset: 40547},
 							val:        "atn2",
 							ignoreCase: true,
 							want:       "\"ATN2\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 794, col: 31, offset: 27808},
+							pos:  position{line: 1095, col: 31, offset: 40555},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 794, col: 34, offset: 27811},
+							pos:        position{line: 1095, col: 34, offset: 40558},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 794, col: 38, offset: 27815},
+							pos:  position{line: 1095, col: 38, offset: 40562},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 794, col: 41, offset: 27818},
+							pos:   position{line: 1095, col: 41, offset: 40565},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 794, col: 46, offset: 27823},
+								pos:  position{line: 1095, col: 46, offset: 40570},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 794, col: 57, offset: 27834},
+							pos:  position{line: 1095, col: 57, offset: 40581},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 794, col: 60, offset: 27837},
+							pos:        position{line: 1095, col: 60, offset: 40584},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 794, col: 64, offset: 27841},
+							pos:  position{line: 1095, col: 64, offset: 40588},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 794, col: 67, offset: 27844},
+							pos:   position{line: 1095, col: 67, offset: 40591},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 794, col: 72, offset: 27849},
+								pos:  position{line: 1095, col: 72, offset: 40596},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 794, col: 83, offset: 27860},
+							pos:  position{line: 1095, col: 83, offset: 40607},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 794, col: 86, offset: 27863},
+							pos:        position{line: 1095, col: 86, offset: 40610},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6629,69 +9643,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntAddExpression",
-			pos:  position{line: 795, col: 1, offset: 27954},
+			pos:  position{line: 1096, col: 1, offset: 40701},
 			expr: &actionExpr{
-				pos: position{line: 795, col: 25, offset: 27978},
+				pos: position{line: 1096, col: 25, offset: 40725},
 				run: (*parser).callonMathIntAddExpression1,
 				expr: &seqExpr{
-					pos: position{line: 795, col: 25, offset: 27978},
+					pos: position{line: 1096, col: 25, offset: 40725},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 795, col: 25, offset: 27978},
+							pos:        position{line: 1096, col: 25, offset: 40725},
 							val:        "intadd",
 							ignoreCase: true,
 							want:       "\"IntAdd\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 35, offset: 27988},
+							pos:  position{line: 1096, col: 35, offset: 40735},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 795, col: 38, offset: 27991},
+							pos:        position{line: 1096, col: 38, offset: 40738},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 42, offset: 27995},
+							pos:  position{line: 1096, col: 42, offset: 40742},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 795, col: 45, offset: 27998},
+							pos:   position{line: 1096, col: 45, offset: 40745},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 795, col: 50, offset: 28003},
+								pos:  position{line: 1096, col: 50, offset: 40750},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 61, offset: 28014},
+							pos:  position{line: 1096, col: 61, offset: 40761},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 795, col: 64, offset: 28017},
+							pos:        position{line: 1096, col: 64, offset: 40764},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 68, offset: 28021},
+							pos:  position{line: 1096, col: 68, offset: 40768},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 795, col: 71, offset: 28024},
+							pos:   position{line: 1096, col: 71, offset: 40771},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 795, col: 76, offset: 28029},
+								pos:  position{line: 1096, col: 76, offset: 40776},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 795, col: 87, offset: 28040},
+							pos:  position{line: 1096, col: 87, offset: 40787},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 795, col: 90, offset: 28043},
+							pos:        position{line: 1096, col: 90, offset: 40790},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6702,69 +9716,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitAndExpression",
-			pos:  position{line: 796, col: 1, offset: 28136},
+			pos:  position{line: 1097, col: 1, offset: 40883},
 			expr: &actionExpr{
-				pos: position{line: 796, col: 28, offset: 28163},
+				pos: position{line: 1097, col: 28, offset: 40910},
 				run: (*parser).callonMathIntBitAndExpression1,
 				expr: &seqExpr{
-					pos: position{line: 796, col: 28, offset: 28163},
+					pos: position{line: 1097, col: 28, offset: 40910},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 796, col: 28, offset: 28163},
+							pos:        position{line: 1097, col: 28, offset: 40910},
 							val:        "intbitand",
 							ignoreCase: true,
 							want:       "\"IntBitAnd\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 796, col: 41, offset: 28176},
+							pos:  position{line: 1097, col: 41, offset: 40923},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 796, col: 44, offset: 28179},
+							pos:        position{line: 1097, col: 44, offset: 40926},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 796, col: 48, offset: 28183},
+							pos:  position{line: 1097, col: 48, offset: 40930},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 796, col: 51, offset: 28186},
+							pos:   position{line: 1097, col: 51, offset: 40933},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 796, col: 56, offset: 28191},
+								pos:  position{line: 1097, col: 56, offset: 40938},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 796, col: 67, offset: 28202},
+							pos:  position{line: 1097, col: 67, offset: 40949},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 796, col: 70, offset: 28205},
+							pos:        position{line: 1097, col: 70, offset: 40952},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 796, col: 74, offset: 28209},
+							pos:  position{line: 1097, col: 74, offset: 40956},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 796, col: 77, offset: 28212},
+							pos:   position{line: 1097, col: 77, offset: 40959},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 796, col: 82, offset: 28217},
+								pos:  position{line: 1097, col: 82, offset: 40964},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 796, col: 93, offset: 28228},
+							pos:  position{line: 1097, col: 93, offset: 40975},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 796, col: 96, offset: 28231},
+							pos:        position{line: 1097, col: 96, offset: 40978},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6775,69 +9789,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitLeftShiftExpression",
-			pos:  position{line: 797, col: 1, offset: 28327},
+			pos:  position{line: 1098, col: 1, offset: 41074},
 			expr: &actionExpr{
-				pos: position{line: 797, col: 34, offset: 28360},
+				pos: position{line: 1098, col: 34, offset: 41107},
 				run: (*parser).callonMathIntBitLeftShiftExpression1,
 				expr: &seqExpr{
-					pos: position{line: 797, col: 34, offset: 28360},
+					pos: position{line: 1098, col: 34, offset: 41107},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 797, col: 34, offset: 28360},
+							pos:        position{line: 1098, col: 34, offset: 41107},
 							val:        "intbitleftshift",
 							ignoreCase: true,
 							want:       "\"IntBitLeftShift\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 53, offset: 28379},
+							pos:  position{line: 1098, col: 53, offset: 41126},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 797, col: 56, offset: 28382},
+							pos:        position{line: 1098, col: 56, offset: 41129},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 60, offset: 28386},
+							pos:  position{line: 1098, col: 60, offset: 41133},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 797, col: 63, offset: 28389},
+							pos:   position{line: 1098, col: 63, offset: 41136},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 797, col: 68, offset: 28394},
+								pos:  position{line: 1098, col: 68, offset: 41141},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 79, offset: 28405},
+							pos:  position{line: 1098, col: 79, offset: 41152},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 797, col: 82, offset: 28408},
+							pos:        position{line: 1098, col: 82, offset: 41155},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 86, offset: 28412},
+							pos:  position{line: 1098, col: 86, offset: 41159},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 797, col: 89, offset: 28415},
+							pos:   position{line: 1098, col: 89, offset: 41162},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 797, col: 94, offset: 28420},
+								pos:  position{line: 1098, col: 94, offset: 41167},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 797, col: 105, offset: 28431},
+							pos:  position{line: 1098, col: 105, offset: 41178},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 797, col: 108, offset: 28434},
+							pos:        position{line: 1098, col: 108, offset: 41181},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6848,69 +9862,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitOrExpression",
-			pos:  position{line: 798, col: 1, offset: 28536},
+			pos:  position{line: 1099, col: 1, offset: 41283},
 			expr: &actionExpr{
-				pos: position{line: 798, col: 27, offset: 28562},
+				pos: position{line: 1099, col: 27, offset: 41309},
 				run: (*parser).callonMathIntBitOrExpression1,
 				expr: &seqExpr{
-					pos: position{line: 798, col: 27, offset: 28562},
+					pos: position{line: 1099, col: 27, offset: 41309},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 798, col: 27, offset: 28562},
+							pos:        position{line: 1099, col: 27, offset: 41309},
 							val:        "intbitor",
 							ignoreCase: true,
 							want:       "\"IntBitOr\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 39, offset: 28574},
+							pos:  position{line: 1099, col: 39, offset: 41321},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 798, col: 42, offset: 28577},
+							pos:        position{line: 1099, col: 42, offset: 41324},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 46, offset: 28581},
+							pos:  position{line: 1099, col: 46, offset: 41328},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 798, col: 49, offset: 28584},
+							pos:   position{line: 1099, col: 49, offset: 41331},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 798, col: 54, offset: 28589},
+								pos:  position{line: 1099, col: 54, offset: 41336},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 65, offset: 28600},
+							pos:  position{line: 1099, col: 65, offset: 41347},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 798, col: 68, offset: 28603},
+							pos:        position{line: 1099, col: 68, offset: 41350},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 72, offset: 28607},
+							pos:  position{line: 1099, col: 72, offset: 41354},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 798, col: 75, offset: 28610},
+							pos:   position{line: 1099, col: 75, offset: 41357},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 798, col: 80, offset: 28615},
+								pos:  position{line: 1099, col: 80, offset: 41362},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 798, col: 91, offset: 28626},
+							pos:  position{line: 1099, col: 91, offset: 41373},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 798, col: 94, offset: 28629},
+							pos:        position{line: 1099, col: 94, offset: 41376},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6921,69 +9935,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitRightShiftExpression",
-			pos:  position{line: 799, col: 1, offset: 28724},
+			pos:  position{line: 1100, col: 1, offset: 41471},
 			expr: &actionExpr{
-				pos: position{line: 799, col: 35, offset: 28758},
+				pos: position{line: 1100, col: 35, offset: 41505},
 				run: (*parser).callonMathIntBitRightShiftExpression1,
 				expr: &seqExpr{
-					pos: position{line: 799, col: 35, offset: 28758},
+					pos: position{line: 1100, col: 35, offset: 41505},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 799, col: 35, offset: 28758},
+							pos:        position{line: 1100, col: 35, offset: 41505},
 							val:        "intbitrightshift",
 							ignoreCase: true,
 							want:       "\"IntBitRightShift\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 55, offset: 28778},
+							pos:  position{line: 1100, col: 55, offset: 41525},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 799, col: 58, offset: 28781},
+							pos:        position{line: 1100, col: 58, offset: 41528},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 62, offset: 28785},
+							pos:  position{line: 1100, col: 62, offset: 41532},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 799, col: 65, offset: 28788},
+							pos:   position{line: 1100, col: 65, offset: 41535},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 799, col: 70, offset: 28793},
+								pos:  position{line: 1100, col: 70, offset: 41540},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 81, offset: 28804},
+							pos:  position{line: 1100, col: 81, offset: 41551},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 799, col: 84, offset: 28807},
+							pos:        position{line: 1100, col: 84, offset: 41554},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 88, offset: 28811},
+							pos:  position{line: 1100, col: 88, offset: 41558},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 799, col: 91, offset: 28814},
+							pos:   position{line: 1100, col: 91, offset: 41561},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 799, col: 96, offset: 28819},
+								pos:  position{line: 1100, col: 96, offset: 41566},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 799, col: 107, offset: 28830},
+							pos:  position{line: 1100, col: 107, offset: 41577},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 799, col: 110, offset: 28833},
+							pos:        position{line: 1100, col: 110, offset: 41580},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -6994,69 +10008,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntBitXorExpression",
-			pos:  position{line: 800, col: 1, offset: 28936},
+			pos:  position{line: 1101, col: 1, offset: 41683},
 			expr: &actionExpr{
-				pos: position{line: 800, col: 28, offset: 28963},
+				pos: position{line: 1101, col: 28, offset: 41710},
 				run: (*parser).callonMathIntBitXorExpression1,
 				expr: &seqExpr{
-					pos: position{line: 800, col: 28, offset: 28963},
+					pos: position{line: 1101, col: 28, offset: 41710},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 800, col: 28, offset: 28963},
+							pos:        position{line: 1101, col: 28, offset: 41710},
 							val:        "intbitxor",
 							ignoreCase: true,
 							want:       "\"IntBitXor\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 800, col: 41, offset: 28976},
+							pos:  position{line: 1101, col: 41, offset: 41723},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 800, col: 44, offset: 28979},
+							pos:        position{line: 1101, col: 44, offset: 41726},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 800, col: 48, offset: 28983},
+							pos:  position{line: 1101, col: 48, offset: 41730},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 800, col: 51, offset: 28986},
+							pos:   position{line: 1101, col: 51, offset: 41733},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 800, col: 56, offset: 28991},
+								pos:  position{line: 1101, col: 56, offset: 41738},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 800, col: 67, offset: 29002},
+							pos:  position{line: 1101, col: 67, offset: 41749},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 800, col: 70, offset: 29005},
+							pos:        position{line: 1101, col: 70, offset: 41752},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 800, col: 74, offset: 29009},
+							pos:  position{line: 1101, col: 74, offset: 41756},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 800, col: 77, offset: 29012},
+							pos:   position{line: 1101, col: 77, offset: 41759},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 800, col: 82, offset: 29017},
+								pos:  position{line: 1101, col: 82, offset: 41764},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 800, col: 93, offset: 29028},
+							pos:  position{line: 1101, col: 93, offset: 41775},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 800, col: 96, offset: 29031},
+							pos:        position{line: 1101, col: 96, offset: 41778},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7067,69 +10081,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntDivExpression",
-			pos:  position{line: 801, col: 1, offset: 29127},
+			pos:  position{line: 1102, col: 1, offset: 41874},
 			expr: &actionExpr{
-				pos: position{line: 801, col: 25, offset: 29151},
+				pos: position{line: 1102, col: 25, offset: 41898},
 				run: (*parser).callonMathIntDivExpression1,
 				expr: &seqExpr{
-					pos: position{line: 801, col: 25, offset: 29151},
+					pos: position{line: 1102, col: 25, offset: 41898},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 801, col: 25, offset: 29151},
+							pos:        position{line: 1102, col: 25, offset: 41898},
 							val:        "intdiv",
 							ignoreCase: true,
 							want:       "\"IntDiv\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 35, offset: 29161},
+							pos:  position{line: 1102, col: 35, offset: 41908},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 801, col: 38, offset: 29164},
+							pos:        position{line: 1102, col: 38, offset: 41911},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 42, offset: 29168},
+							pos:  position{line: 1102, col: 42, offset: 41915},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 801, col: 45, offset: 29171},
+							pos:   position{line: 1102, col: 45, offset: 41918},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 801, col: 50, offset: 29176},
+								pos:  position{line: 1102, col: 50, offset: 41923},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 61, offset: 29187},
+							pos:  position{line: 1102, col: 61, offset: 41934},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 801, col: 64, offset: 29190},
+							pos:        position{line: 1102, col: 64, offset: 41937},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 68, offset: 29194},
+							pos:  position{line: 1102, col: 68, offset: 41941},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 801, col: 71, offset: 29197},
+							pos:   position{line: 1102, col: 71, offset: 41944},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 801, col: 76, offset: 29202},
+								pos:  position{line: 1102, col: 76, offset: 41949},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 801, col: 87, offset: 29213},
+							pos:  position{line: 1102, col: 87, offset: 41960},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 801, col: 90, offset: 29216},
+							pos:        position{line: 1102, col: 90, offset: 41963},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7140,69 +10154,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntModExpression",
-			pos:  position{line: 802, col: 1, offset: 29309},
+			pos:  position{line: 1103, col: 1, offset: 42056},
 			expr: &actionExpr{
-				pos: position{line: 802, col: 25, offset: 29333},
+				pos: position{line: 1103, col: 25, offset: 42080},
 				run: (*parser).callonMathIntModExpression1,
 				expr: &seqExpr{
-					pos: position{line: 802, col: 25, offset: 29333},
+					pos: position{line: 1103, col: 25, offset: 42080},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 802, col: 25, offset: 29333},
+							pos:        position{line: 1103, col: 25, offset: 42080},
 							val:        "intmod",
 							ignoreCase: true,
 							want:       "\"IntMod\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 802, col: 35, offset: 29343},
+							pos:  position{line: 1103, col: 35, offset: 42090},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 802, col: 38, offset: 29346},
+							pos:        position{line: 1103, col: 38, offset: 42093},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 802, col: 42, offset: 29350},
+							pos:  position{line: 1103, col: 42, offset: 42097},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 802, col: 45, offset: 29353},
+							pos:   position{line: 1103, col: 45, offset: 42100},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 802, col: 50, offset: 29358},
+								pos:  position{line: 1103, col: 50, offset: 42105},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 802, col: 61, offset: 29369},
+							pos:  position{line: 1103, col: 61, offset: 42116},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 802, col: 64, offset: 29372},
+							pos:        position{line: 1103, col: 64, offset: 42119},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 802, col: 68, offset: 29376},
+							pos:  position{line: 1103, col: 68, offset: 42123},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 802, col: 71, offset: 29379},
+							pos:   position{line: 1103, col: 71, offset: 42126},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 802, col: 76, offset: 29384},
+								pos:  position{line: 1103, col: 76, offset: 42131},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 802, col: 87, offset: 29395},
+							pos:  position{line: 1103, col: 87, offset: 42142},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 802, col: 90, offset: 29398},
+							pos:        position{line: 1103, col: 90, offset: 42145},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7213,69 +10227,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntMulExpression",
-			pos:  position{line: 803, col: 1, offset: 29491},
+			pos:  position{line: 1104, col: 1, offset: 42238},
 			expr: &actionExpr{
-				pos: position{line: 803, col: 25, offset: 29515},
+				pos: position{line: 1104, col: 25, offset: 42262},
 				run: (*parser).callonMathIntMulExpression1,
 				expr: &seqExpr{
-					pos: position{line: 803, col: 25, offset: 29515},
+					pos: position{line: 1104, col: 25, offset: 42262},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 803, col: 25, offset: 29515},
+							pos:        position{line: 1104, col: 25, offset: 42262},
 							val:        "intmul",
 							ignoreCase: true,
 							want:       "\"IntMul\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 35, offset: 29525},
+							pos:  position{line: 1104, col: 35, offset: 42272},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 803, col: 38, offset: 29528},
+							pos:        position{line: 1104, col: 38, offset: 42275},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 42, offset: 29532},
+							pos:  position{line: 1104, col: 42, offset: 42279},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 803, col: 45, offset: 29535},
+							pos:   position{line: 1104, col: 45, offset: 42282},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 803, col: 50, offset: 29540},
+								pos:  position{line: 1104, col: 50, offset: 42287},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 61, offset: 29551},
+							pos:  position{line: 1104, col: 61, offset: 42298},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 803, col: 64, offset: 29554},
+							pos:        position{line: 1104, col: 64, offset: 42301},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 68, offset: 29558},
+							pos:  position{line: 1104, col: 68, offset: 42305},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 803, col: 71, offset: 29561},
+							pos:   position{line: 1104, col: 71, offset: 42308},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 803, col: 76, offset: 29566},
+								pos:  position{line: 1104, col: 76, offset: 42313},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 803, col: 87, offset: 29577},
+							pos:  position{line: 1104, col: 87, offset: 42324},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 803, col: 90, offset: 29580},
+							pos:        position{line: 1104, col: 90, offset: 42327},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7286,69 +10300,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathIntSubExpression",
-			pos:  position{line: 804, col: 1, offset: 29673},
+			pos:  position{line: 1105, col: 1, offset: 42420},
 			expr: &actionExpr{
-				pos: position{line: 804, col: 25, offset: 29697},
+				pos: position{line: 1105, col: 25, offset: 42444},
 				run: (*parser).callonMathIntSubExpression1,
 				expr: &seqExpr{
-					pos: position{line: 804, col: 25, offset: 29697},
+					pos: position{line: 1105, col: 25, offset: 42444},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 804, col: 25, offset: 29697},
+							pos:        position{line: 1105, col: 25, offset: 42444},
 							val:        "intsub",
 							ignoreCase: true,
 							want:       "\"IntSub\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 804, col: 35, offset: 29707},
+							pos:  position{line: 1105, col: 35, offset: 42454},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 804, col: 38, offset: 29710},
+							pos:        position{line: 1105, col: 38, offset: 42457},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 804, col: 42, offset: 29714},
+							pos:  position{line: 1105, col: 42, offset: 42461},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 804, col: 45, offset: 29717},
+							pos:   position{line: 1105, col: 45, offset: 42464},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 804, col: 50, offset: 29722},
+								pos:  position{line: 1105, col: 50, offset: 42469},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 804, col: 61, offset: 29733},
+							pos:  position{line: 1105, col: 61, offset: 42480},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 804, col: 64, offset: 29736},
+							pos:        position{line: 1105, col: 64, offset: 42483},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 804, col: 68, offset: 29740},
+							pos:  position{line: 1105, col: 68, offset: 42487},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 804, col: 71, offset: 29743},
+							pos:   position{line: 1105, col: 71, offset: 42490},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 804, col: 76, offset: 29748},
+								pos:  position{line: 1105, col: 76, offset: 42495},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 804, col: 87, offset: 29759},
+							pos:  position{line: 1105, col: 87, offset: 42506},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 804, col: 90, offset: 29762},
+							pos:        position{line: 1105, col: 90, offset: 42509},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7359,69 +10373,69 @@ var g = &grammar{
 		},
 		{
 			name: "MathPowerExpression",
-			pos:  position{line: 805, col: 1, offset: 29855},
+			pos:  position{line: 1106, col: 1, offset: 42602},
 			expr: &actionExpr{
-				pos: position{line: 805, col: 24, offset: 29878},
+				pos: position{line: 1106, col: 24, offset: 42625},
 				run: (*parser).callonMathPowerExpression1,
 				expr: &seqExpr{
-					pos: position{line: 805, col: 24, offset: 29878},
+					pos: position{line: 1106, col: 24, offset: 42625},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 805, col: 24, offset: 29878},
+							pos:        position{line: 1106, col: 24, offset: 42625},
 							val:        "power",
 							ignoreCase: true,
 							want:       "\"POWER\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 805, col: 33, offset: 29887},
+							pos:  position{line: 1106, col: 33, offset: 42634},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 805, col: 36, offset: 29890},
+							pos:        position{line: 1106, col: 36, offset: 42637},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 805, col: 40, offset: 29894},
+							pos:  position{line: 1106, col: 40, offset: 42641},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 805, col: 43, offset: 29897},
+							pos:   position{line: 1106, col: 43, offset: 42644},
 							label: "set1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 805, col: 48, offset: 29902},
+								pos:  position{line: 1106, col: 48, offset: 42649},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 805, col: 59, offset: 29913},
+							pos:  position{line: 1106, col: 59, offset: 42660},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 805, col: 62, offset: 29916},
+							pos:        position{line: 1106, col: 62, offset: 42663},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 805, col: 66, offset: 29920},
+							pos:  position{line: 1106, col: 66, offset: 42667},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 805, col: 69, offset: 29923},
+							pos:   position{line: 1106, col: 69, offset: 42670},
 							label: "set2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 805, col: 74, offset: 29928},
+								pos:  position{line: 1106, col: 74, offset: 42675},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 805, col: 85, offset: 29939},
+							pos:  position{line: 1106, col: 85, offset: 42686},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 805, col: 88, offset: 29942},
+							pos:        position{line: 1106, col: 88, offset: 42689},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7432,71 +10446,71 @@ var g = &grammar{
 		},
 		{
 			name: "MathLogExpression",
-			pos:  position{line: 807, col: 1, offset: 30035},
+			pos:  position{line: 1108, col: 1, offset: 42782},
 			expr: &actionExpr{
-				pos: position{line: 807, col: 22, offset: 30056},
+				pos: position{line: 1108, col: 22, offset: 42803},
 				run: (*parser).callonMathLogExpression1,
 				expr: &seqExpr{
-					pos: position{line: 807, col: 22, offset: 30056},
+					pos: position{line: 1108, col: 22, offset: 42803},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 807, col: 22, offset: 30056},
+							pos:        position{line: 1108, col: 22, offset: 42803},
 							val:        "log",
 							ignoreCase: true,
 							want:       "\"LOG\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 807, col: 29, offset: 30063},
+							pos:  position{line: 1108, col: 29, offset: 42810},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 807, col: 32, offset: 30066},
+							pos:        position{line: 1108, col: 32, offset: 42813},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 807, col: 36, offset: 30070},
+							pos:  position{line: 1108, col: 36, offset: 42817},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 807, col: 39, offset: 30073},
+							pos:   position{line: 1108, col: 39, offset: 42820},
 							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 807, col: 43, offset: 30077},
+								pos:  position{line: 1108, col: 43, offset: 42824},
 								name: "SelectItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 807, col: 54, offset: 30088},
+							pos:   position{line: 1108, col: 54, offset: 42835},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 807, col: 61, offset: 30095},
+								pos: position{line: 1108, col: 61, offset: 42842},
 								expr: &actionExpr{
-									pos: position{line: 807, col: 62, offset: 30096},
+									pos: position{line: 1108, col: 62, offset: 42843},
 									run: (*parser).callonMathLogExpression11,
 									expr: &seqExpr{
-										pos: position{line: 807, col: 62, offset: 30096},
+										pos: position{line: 1108, col: 62, offset: 42843},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 807, col: 62, offset: 30096},
+												pos:  position{line: 1108, col: 62, offset: 42843},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 807, col: 65, offset: 30099},
+												pos:        position{line: 1108, col: 65, offset: 42846},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 807, col: 69, offset: 30103},
+												pos:  position{line: 1108, col: 69, offset: 42850},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 807, col: 72, offset: 30106},
+												pos:   position{line: 1108, col: 72, offset: 42853},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 807, col: 75, offset: 30109},
+													pos:  position{line: 1108, col: 75, offset: 42856},
 													name: "SelectItem",
 												},
 											},
@@ -7506,11 +10520,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 807, col: 107, offset: 30141},
+							pos:  position{line: 1108, col: 107, offset: 42888},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 807, col: 110, offset: 30144},
+							pos:        position{line: 1108, col: 110, offset: 42891},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7521,71 +10535,71 @@ var g = &grammar{
 		},
 		{
 			name: "MathNumberBinExpression",
-			pos:  position{line: 810, col: 1, offset: 30266},
+			pos:  position{line: 1111, col: 1, offset: 43013},
 			expr: &actionExpr{
-				pos: position{line: 810, col: 28, offset: 30293},
+				pos: position{line: 1111, col: 28, offset: 43040},
 				run: (*parser).callonMathNumberBinExpression1,
 				expr: &seqExpr{
-					pos: position{line: 810, col: 28, offset: 30293},
+					pos: position{line: 1111, col: 28, offset: 43040},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 810, col: 28, offset: 30293},
+							pos:        position{line: 1111, col: 28, offset: 43040},
 							val:        "numberbin",
 							ignoreCase: true,
 							want:       "\"NumberBin\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 810, col: 41, offset: 30306},
+							pos:  position{line: 1111, col: 41, offset: 43053},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 810, col: 44, offset: 30309},
+							pos:        position{line: 1111, col: 44, offset: 43056},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 810, col: 48, offset: 30313},
+							pos:  position{line: 1111, col: 48, offset: 43060},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 810, col: 51, offset: 30316},
+							pos:   position{line: 1111, col: 51, offset: 43063},
 							label: "ex1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 810, col: 55, offset: 30320},
+								pos:  position{line: 1111, col: 55, offset: 43067},
 								name: "SelectItem",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 810, col: 66, offset: 30331},
+							pos:   position{line: 1111, col: 66, offset: 43078},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 810, col: 73, offset: 30338},
+								pos: position{line: 1111, col: 73, offset: 43085},
 								expr: &actionExpr{
-									pos: position{line: 810, col: 74, offset: 30339},
+									pos: position{line: 1111, col: 74, offset: 43086},
 									run: (*parser).callonMathNumberBinExpression11,
 									expr: &seqExpr{
-										pos: position{line: 810, col: 74, offset: 30339},
+										pos: position{line: 1111, col: 74, offset: 43086},
 										exprs: []any{
 											&ruleRefExpr{
-												pos:  position{line: 810, col: 74, offset: 30339},
+												pos:  position{line: 1111, col: 74, offset: 43086},
 												name: "ws",
 											},
 											&litMatcher{
-												pos:        position{line: 810, col: 77, offset: 30342},
+												pos:        position{line: 1111, col: 77, offset: 43089},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 810, col: 81, offset: 30346},
+												pos:  position{line: 1111, col: 81, offset: 43093},
 												name: "ws",
 											},
 											&labeledExpr{
-												pos:   position{line: 810, col: 84, offset: 30349},
+												pos:   position{line: 1111, col: 84, offset: 43096},
 												label: "ex",
 												expr: &ruleRefExpr{
-													pos:  position{line: 810, col: 87, offset: 30352},
+													pos:  position{line: 1111, col: 87, offset: 43099},
 													name: "SelectItem",
 												},
 											},
@@ -7595,11 +10609,11 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 810, col: 119, offset: 30384},
+							pos:  position{line: 1111, col: 119, offset: 43131},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 810, col: 122, offset: 30387},
+							pos:        position{line: 1111, col: 122, offset: 43134},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7610,35 +10624,35 @@ var g = &grammar{
 		},
 		{
 			name: "MathPiExpression",
-			pos:  position{line: 813, col: 1, offset: 30515},
+			pos:  position{line: 1114, col: 1, offset: 43262},
 			expr: &actionExpr{
-				pos: position{line: 813, col: 21, offset: 30535},
+				pos: position{line: 1114, col: 21, offset: 43282},
 				run: (*parser).callonMathPiExpression1,
 				expr: &seqExpr{
-					pos: position{line: 813, col: 21, offset: 30535},
+					pos: position{line: 1114, col: 21, offset: 43282},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 813, col: 21, offset: 30535},
+							pos:        position{line: 1114, col: 21, offset: 43282},
 							val:        "pi",
 							ignoreCase: true,
 							want:       "\"PI\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 813, col: 27, offset: 30541},
+							pos:  position{line: 1114, col: 27, offset: 43288},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 813, col: 30, offset: 30544},
+							pos:        position{line: 1114, col: 30, offset: 43291},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 813, col: 34, offset: 30548},
+							pos:  position{line: 1114, col: 34, offset: 43295},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 813, col: 37, offset: 30551},
+							pos:        position{line: 1114, col: 37, offset: 43298},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7649,35 +10663,35 @@ var g = &grammar{
 		},
 		{
 			name: "MathRandExpression",
-			pos:  position{line: 814, col: 1, offset: 30630},
+			pos:  position{line: 1115, col: 1, offset: 43377},
 			expr: &actionExpr{
-				pos: position{line: 814, col: 23, offset: 30652},
+				pos: position{line: 1115, col: 23, offset: 43399},
 				run: (*parser).callonMathRandExpression1,
 				expr: &seqExpr{
-					pos: position{line: 814, col: 23, offset: 30652},
+					pos: position{line: 1115, col: 23, offset: 43399},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 814, col: 23, offset: 30652},
+							pos:        position{line: 1115, col: 23, offset: 43399},
 							val:        "rand",
 							ignoreCase: true,
 							want:       "\"RAND\"i",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 814, col: 31, offset: 30660},
+							pos:  position{line: 1115, col: 31, offset: 43407},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 814, col: 34, offset: 30663},
+							pos:        position{line: 1115, col: 34, offset: 43410},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 814, col: 38, offset: 30667},
+							pos:  position{line: 1115, col: 38, offset: 43414},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 814, col: 41, offset: 30670},
+							pos:        position{line: 1115, col: 41, offset: 43417},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7688,44 +10702,44 @@ var g = &grammar{
 		},
 		{
 			name: "InFunction",
-			pos:  position{line: 816, col: 1, offset: 30752},
+			pos:  position{line: 1117, col: 1, offset: 43499},
 			expr: &choiceExpr{
-				pos: position{line: 816, col: 15, offset: 30766},
+				pos: position{line: 1117, col: 15, offset: 43513},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 816, col: 15, offset: 30766},
+						pos: position{line: 1117, col: 15, offset: 43513},
 						run: (*parser).callonInFunction2,
 						expr: &seqExpr{
-							pos: position{line: 816, col: 15, offset: 30766},
+							pos: position{line: 1117, col: 15, offset: 43513},
 							exprs: []any{
 								&labeledExpr{
-									pos:   position{line: 816, col: 15, offset: 30766},
+									pos:   position{line: 1117, col: 15, offset: 43513},
 									label: "ex1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 816, col: 19, offset: 30770},
+										pos:  position{line: 1117, col: 19, offset: 43517},
 										name: "SelectProperty",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 816, col: 34, offset: 30785},
+									pos:  position{line: 1117, col: 34, offset: 43532},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 816, col: 37, offset: 30788},
+									pos:   position{line: 1117, col: 37, offset: 43535},
 									label: "notIn",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 816, col: 43, offset: 30794},
+										pos: position{line: 1117, col: 43, offset: 43541},
 										expr: &seqExpr{
-											pos: position{line: 816, col: 44, offset: 30795},
+											pos: position{line: 1117, col: 44, offset: 43542},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 816, col: 44, offset: 30795},
+													pos:        position{line: 1117, col: 44, offset: 43542},
 													val:        "not",
 													ignoreCase: true,
 													want:       "\"NOT\"i",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 816, col: 51, offset: 30802},
+													pos:  position{line: 1117, col: 51, offset: 43549},
 													name: "ws",
 												},
 											},
@@ -7733,61 +10747,61 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 816, col: 56, offset: 30807},
+									pos:  position{line: 1117, col: 56, offset: 43554},
 									name: "In",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 816, col: 59, offset: 30810},
+									pos:  position{line: 1117, col: 59, offset: 43557},
 									name: "ws",
 								},
 								&litMatcher{
-									pos:        position{line: 816, col: 62, offset: 30813},
+									pos:        position{line: 1117, col: 62, offset: 43560},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 816, col: 66, offset: 30817},
+									pos:  position{line: 1117, col: 66, offset: 43564},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 816, col: 69, offset: 30820},
+									pos:   position{line: 1117, col: 69, offset: 43567},
 									label: "ex2",
 									expr: &ruleRefExpr{
-										pos:  position{line: 816, col: 73, offset: 30824},
+										pos:  position{line: 1117, col: 73, offset: 43571},
 										name: "SelectItem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 816, col: 84, offset: 30835},
+									pos:   position{line: 1117, col: 84, offset: 43582},
 									label: "others",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 816, col: 91, offset: 30842},
+										pos: position{line: 1117, col: 91, offset: 43589},
 										expr: &actionExpr{
-											pos: position{line: 816, col: 92, offset: 30843},
+											pos: position{line: 1117, col: 92, offset: 43590},
 											run: (*parser).callonInFunction20,
 											expr: &seqExpr{
-												pos: position{line: 816, col: 92, offset: 30843},
+												pos: position{line: 1117, col: 92, offset: 43590},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 816, col: 92, offset: 30843},
+														pos:  position{line: 1117, col: 92, offset: 43590},
 														name: "ws",
 													},
 													&litMatcher{
-														pos:        position{line: 816, col: 95, offset: 30846},
+														pos:        position{line: 1117, col: 95, offset: 43593},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 816, col: 99, offset: 30850},
+														pos:  position{line: 1117, col: 99, offset: 43597},
 														name: "ws",
 													},
 													&labeledExpr{
-														pos:   position{line: 816, col: 102, offset: 30853},
+														pos:   position{line: 1117, col: 102, offset: 43600},
 														label: "ex",
 														expr: &ruleRefExpr{
-															pos:  position{line: 816, col: 105, offset: 30856},
+															pos:  position{line: 1117, col: 105, offset: 43603},
 															name: "SelectItem",
 														},
 													},
@@ -7797,11 +10811,11 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 816, col: 137, offset: 30888},
+									pos:  position{line: 1117, col: 137, offset: 43635},
 									name: "ws",
 								},
 								&litMatcher{
-									pos:        position{line: 816, col: 140, offset: 30891},
+									pos:        position{line: 1117, col: 140, offset: 43638},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7810,49 +10824,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 830, col: 3, offset: 31284},
+						pos: position{line: 1131, col: 3, offset: 44031},
 						run: (*parser).callonInFunction29,
 						expr: &seqExpr{
-							pos: position{line: 830, col: 3, offset: 31284},
+							pos: position{line: 1131, col: 3, offset: 44031},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 830, col: 3, offset: 31284},
+									pos:        position{line: 1131, col: 3, offset: 44031},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 830, col: 7, offset: 31288},
+									pos:  position{line: 1131, col: 7, offset: 44035},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 830, col: 10, offset: 31291},
+									pos:   position{line: 1131, col: 10, offset: 44038},
 									label: "ex1",
 									expr: &ruleRefExpr{
-										pos:  position{line: 830, col: 14, offset: 31295},
+										pos:  position{line: 1131, col: 14, offset: 44042},
 										name: "SelectItem",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 830, col: 25, offset: 31306},
+									pos:  position{line: 1131, col: 25, offset: 44053},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 830, col: 28, offset: 31309},
+									pos:   position{line: 1131, col: 28, offset: 44056},
 									label: "notIn",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 830, col: 34, offset: 31315},
+										pos: position{line: 1131, col: 34, offset: 44062},
 										expr: &seqExpr{
-											pos: position{line: 830, col: 35, offset: 31316},
+											pos: position{line: 1131, col: 35, offset: 44063},
 											exprs: []any{
 												&litMatcher{
-													pos:        position{line: 830, col: 35, offset: 31316},
+													pos:        position{line: 1131, col: 35, offset: 44063},
 													val:        "not",
 													ignoreCase: true,
 													want:       "\"NOT\"i",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 830, col: 42, offset: 31323},
+													pos:  position{line: 1131, col: 42, offset: 44070},
 													name: "ws",
 												},
 											},
@@ -7860,61 +10874,61 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 830, col: 47, offset: 31328},
+									pos:  position{line: 1131, col: 47, offset: 44075},
 									name: "In",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 830, col: 50, offset: 31331},
+									pos:  position{line: 1131, col: 50, offset: 44078},
 									name: "ws",
 								},
 								&litMatcher{
-									pos:        position{line: 830, col: 53, offset: 31334},
+									pos:        position{line: 1131, col: 53, offset: 44081},
 									val:        "(",
 									ignoreCase: false,
 									want:       "\"(\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 830, col: 57, offset: 31338},
+									pos:  position{line: 1131, col: 57, offset: 44085},
 									name: "ws",
 								},
 								&labeledExpr{
-									pos:   position{line: 830, col: 60, offset: 31341},
+									pos:   position{line: 1131, col: 60, offset: 44088},
 									label: "ex2",
 									expr: &ruleRefExpr{
-										pos:  position{line: 830, col: 64, offset: 31345},
+										pos:  position{line: 1131, col: 64, offset: 44092},
 										name: "SelectItem",
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 830, col: 75, offset: 31356},
+									pos:   position{line: 1131, col: 75, offset: 44103},
 									label: "others",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 830, col: 82, offset: 31363},
+										pos: position{line: 1131, col: 82, offset: 44110},
 										expr: &actionExpr{
-											pos: position{line: 830, col: 83, offset: 31364},
+											pos: position{line: 1131, col: 83, offset: 44111},
 											run: (*parser).callonInFunction49,
 											expr: &seqExpr{
-												pos: position{line: 830, col: 83, offset: 31364},
+												pos: position{line: 1131, col: 83, offset: 44111},
 												exprs: []any{
 													&ruleRefExpr{
-														pos:  position{line: 830, col: 83, offset: 31364},
+														pos:  position{line: 1131, col: 83, offset: 44111},
 														name: "ws",
 													},
 													&litMatcher{
-														pos:        position{line: 830, col: 86, offset: 31367},
+														pos:        position{line: 1131, col: 86, offset: 44114},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 													&ruleRefExpr{
-														pos:  position{line: 830, col: 90, offset: 31371},
+														pos:  position{line: 1131, col: 90, offset: 44118},
 														name: "ws",
 													},
 													&labeledExpr{
-														pos:   position{line: 830, col: 93, offset: 31374},
+														pos:   position{line: 1131, col: 93, offset: 44121},
 														label: "ex",
 														expr: &ruleRefExpr{
-															pos:  position{line: 830, col: 96, offset: 31377},
+															pos:  position{line: 1131, col: 96, offset: 44124},
 															name: "SelectItem",
 														},
 													},
@@ -7924,21 +10938,21 @@ var g = &grammar{
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 830, col: 128, offset: 31409},
+									pos:  position{line: 1131, col: 128, offset: 44156},
 									name: "ws",
 								},
 								&litMatcher{
-									pos:        position{line: 830, col: 131, offset: 31412},
+									pos:        position{line: 1131, col: 131, offset: 44159},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 830, col: 135, offset: 31416},
+									pos:  position{line: 1131, col: 135, offset: 44163},
 									name: "ws",
 								},
 								&litMatcher{
-									pos:        position{line: 830, col: 138, offset: 31419},
+									pos:        position{line: 1131, col: 138, offset: 44166},
 									val:        ")",
 									ignoreCase: false,
 									want:       "\")\"",
@@ -7949,45 +10963,158 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "UserDefinedFunctionCall",
+			pos:  position{line: 1146, col: 1, offset: 44554},
+			expr: &actionExpr{
+				pos: position{line: 1146, col: 28, offset: 44581},
+				run: (*parser).callonUserDefinedFunctionCall1,
+				expr: &seqExpr{
+					pos: position{line: 1146, col: 28, offset: 44581},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 1146, col: 28, offset: 44581},
+							val:        "udf.",
+							ignoreCase: true,
+							want:       "\"udf.\"i",
+						},
+						&labeledExpr{
+							pos:   position{line: 1146, col: 36, offset: 44589},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1146, col: 41, offset: 44594},
+								name: "Identifier",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1146, col: 52, offset: 44605},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 1146, col: 55, offset: 44608},
+							val:        "(",
+							ignoreCase: false,
+							want:       "\"(\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1146, col: 59, offset: 44612},
+							name: "ws",
+						},
+						&labeledExpr{
+							pos:   position{line: 1146, col: 62, offset: 44615},
+							label: "args",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 1146, col: 67, offset: 44620},
+								expr: &actionExpr{
+									pos: position{line: 1146, col: 68, offset: 44621},
+									run: (*parser).callonUserDefinedFunctionCall11,
+									expr: &seqExpr{
+										pos: position{line: 1146, col: 68, offset: 44621},
+										exprs: []any{
+											&labeledExpr{
+												pos:   position{line: 1146, col: 68, offset: 44621},
+												label: "first",
+												expr: &ruleRefExpr{
+													pos:  position{line: 1146, col: 74, offset: 44627},
+													name: "SelectItem",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 1146, col: 85, offset: 44638},
+												label: "others",
+												expr: &zeroOrMoreExpr{
+													pos: position{line: 1146, col: 92, offset: 44645},
+													expr: &actionExpr{
+														pos: position{line: 1146, col: 93, offset: 44646},
+														run: (*parser).callonUserDefinedFunctionCall17,
+														expr: &seqExpr{
+															pos: position{line: 1146, col: 93, offset: 44646},
+															exprs: []any{
+																&ruleRefExpr{
+																	pos:  position{line: 1146, col: 93, offset: 44646},
+																	name: "ws",
+																},
+																&litMatcher{
+																	pos:        position{line: 1146, col: 96, offset: 44649},
+																	val:        ",",
+																	ignoreCase: false,
+																	want:       "\",\"",
+																},
+																&ruleRefExpr{
+																	pos:  position{line: 1146, col: 100, offset: 44653},
+																	name: "ws",
+																},
+																&labeledExpr{
+																	pos:   position{line: 1146, col: 103, offset: 44656},
+																	label: "ex",
+																	expr: &ruleRefExpr{
+																		pos:  position{line: 1146, col: 106, offset: 44659},
+																		name: "SelectItem",
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1148, col: 5, offset: 44769},
+							name: "ws",
+						},
+						&litMatcher{
+							pos:        position{line: 1148, col: 8, offset: 44772},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "AvgAggregateExpression",
-			pos:  position{line: 845, col: 1, offset: 31807},
+			pos:  position{line: 1161, col: 1, offset: 45036},
 			expr: &actionExpr{
-				pos: position{line: 845, col: 29, offset: 31835},
+				pos: position{line: 1161, col: 29, offset: 45064},
 				run: (*parser).callonAvgAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 845, col: 29, offset: 31835},
+					pos: position{line: 1161, col: 29, offset: 45064},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 845, col: 29, offset: 31835},
+							pos:        position{line: 1161, col: 29, offset: 45064},
 							val:        "avg",
 							ignoreCase: true,
 							want:       "\"AVG\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 845, col: 36, offset: 31842},
+							pos:        position{line: 1161, col: 36, offset: 45071},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 845, col: 40, offset: 31846},
+							pos:  position{line: 1161, col: 40, offset: 45075},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 845, col: 43, offset: 31849},
+							pos:   position{line: 1161, col: 43, offset: 45078},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 845, col: 46, offset: 31852},
+								pos:  position{line: 1161, col: 46, offset: 45081},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 845, col: 58, offset: 31864},
+							pos:  position{line: 1161, col: 58, offset: 45093},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 845, col: 61, offset: 31867},
+							pos:        position{line: 1161, col: 61, offset: 45096},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -7998,43 +11125,43 @@ var g = &grammar{
 		},
 		{
 			name: "CountAggregateExpression",
-			pos:  position{line: 849, col: 1, offset: 31959},
+			pos:  position{line: 1165, col: 1, offset: 45188},
 			expr: &actionExpr{
-				pos: position{line: 849, col: 29, offset: 31987},
+				pos: position{line: 1165, col: 29, offset: 45216},
 				run: (*parser).callonCountAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 849, col: 29, offset: 31987},
+					pos: position{line: 1165, col: 29, offset: 45216},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 849, col: 29, offset: 31987},
+							pos:        position{line: 1165, col: 29, offset: 45216},
 							val:        "count",
 							ignoreCase: true,
 							want:       "\"COUNT\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 849, col: 38, offset: 31996},
+							pos:        position{line: 1165, col: 38, offset: 45225},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 849, col: 42, offset: 32000},
+							pos:  position{line: 1165, col: 42, offset: 45229},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 849, col: 45, offset: 32003},
+							pos:   position{line: 1165, col: 45, offset: 45232},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 849, col: 48, offset: 32006},
+								pos:  position{line: 1165, col: 48, offset: 45235},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 849, col: 59, offset: 32017},
+							pos:  position{line: 1165, col: 59, offset: 45246},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 849, col: 62, offset: 32020},
+							pos:        position{line: 1165, col: 62, offset: 45249},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -8045,43 +11172,43 @@ var g = &grammar{
 		},
 		{
 			name: "MaxAggregateExpression",
-			pos:  position{line: 853, col: 1, offset: 32114},
+			pos:  position{line: 1169, col: 1, offset: 45343},
 			expr: &actionExpr{
-				pos: position{line: 853, col: 29, offset: 32142},
+				pos: position{line: 1169, col: 29, offset: 45371},
 				run: (*parser).callonMaxAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 853, col: 29, offset: 32142},
+					pos: position{line: 1169, col: 29, offset: 45371},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 853, col: 29, offset: 32142},
+							pos:        position{line: 1169, col: 29, offset: 45371},
 							val:        "max",
 							ignoreCase: true,
 							want:       "\"MAX\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 853, col: 36, offset: 32149},
+							pos:        position{line: 1169, col: 36, offset: 45378},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 853, col: 40, offset: 32153},
+							pos:  position{line: 1169, col: 40, offset: 45382},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 853, col: 43, offset: 32156},
+							pos:   position{line: 1169, col: 43, offset: 45385},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 853, col: 46, offset: 32159},
+								pos:  position{line: 1169, col: 46, offset: 45388},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 853, col: 57, offset: 32170},
+							pos:  position{line: 1169, col: 57, offset: 45399},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 853, col: 60, offset: 32173},
+							pos:        position{line: 1169, col: 60, offset: 45402},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -8092,43 +11219,43 @@ var g = &grammar{
 		},
 		{
 			name: "MinAggregateExpression",
-			pos:  position{line: 857, col: 1, offset: 32265},
+			pos:  position{line: 1173, col: 1, offset: 45494},
 			expr: &actionExpr{
-				pos: position{line: 857, col: 29, offset: 32293},
+				pos: position{line: 1173, col: 29, offset: 45522},
 				run: (*parser).callonMinAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 857, col: 29, offset: 32293},
+					pos: position{line: 1173, col: 29, offset: 45522},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 857, col: 29, offset: 32293},
+							pos:        position{line: 1173, col: 29, offset: 45522},
 							val:        "min",
 							ignoreCase: true,
 							want:       "\"MIN\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 857, col: 36, offset: 32300},
+							pos:        position{line: 1173, col: 36, offset: 45529},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 857, col: 40, offset: 32304},
+							pos:  position{line: 1173, col: 40, offset: 45533},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 857, col: 43, offset: 32307},
+							pos:   position{line: 1173, col: 43, offset: 45536},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 857, col: 46, offset: 32310},
+								pos:  position{line: 1173, col: 46, offset: 45539},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 857, col: 57, offset: 32321},
+							pos:  position{line: 1173, col: 57, offset: 45550},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 857, col: 60, offset: 32324},
+							pos:        position{line: 1173, col: 60, offset: 45553},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -8139,43 +11266,43 @@ var g = &grammar{
 		},
 		{
 			name: "SumAggregateExpression",
-			pos:  position{line: 861, col: 1, offset: 32416},
+			pos:  position{line: 1177, col: 1, offset: 45645},
 			expr: &actionExpr{
-				pos: position{line: 861, col: 29, offset: 32444},
+				pos: position{line: 1177, col: 29, offset: 45673},
 				run: (*parser).callonSumAggregateExpression1,
 				expr: &seqExpr{
-					pos: position{line: 861, col: 29, offset: 32444},
+					pos: position{line: 1177, col: 29, offset: 45673},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 861, col: 29, offset: 32444},
+							pos:        position{line: 1177, col: 29, offset: 45673},
 							val:        "sum",
 							ignoreCase: true,
 							want:       "\"SUM\"i",
 						},
 						&litMatcher{
-							pos:        position{line: 861, col: 36, offset: 32451},
+							pos:        position{line: 1177, col: 36, offset: 45680},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 861, col: 40, offset: 32455},
+							pos:  position{line: 1177, col: 40, offset: 45684},
 							name: "ws",
 						},
 						&labeledExpr{
-							pos:   position{line: 861, col: 43, offset: 32458},
+							pos:   position{line: 1177, col: 43, offset: 45687},
 							label: "ex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 861, col: 46, offset: 32461},
+								pos:  position{line: 1177, col: 46, offset: 45690},
 								name: "SelectItem",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 861, col: 57, offset: 32472},
+							pos:  position{line: 1177, col: 57, offset: 45701},
 							name: "ws",
 						},
 						&litMatcher{
-							pos:        position{line: 861, col: 60, offset: 32475},
+							pos:        position{line: 1177, col: 60, offset: 45704},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
@@ -8186,14 +11313,14 @@ var g = &grammar{
 		},
 		{
 			name: "Integer",
-			pos:  position{line: 865, col: 1, offset: 32567},
+			pos:  position{line: 1181, col: 1, offset: 45796},
 			expr: &actionExpr{
-				pos: position{line: 865, col: 12, offset: 32578},
+				pos: position{line: 1181, col: 12, offset: 45807},
 				run: (*parser).callonInteger1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 865, col: 12, offset: 32578},
+					pos: position{line: 1181, col: 12, offset: 45807},
 					expr: &charClassMatcher{
-						pos:        position{line: 865, col: 12, offset: 32578},
+						pos:        position{line: 1181, col: 12, offset: 45807},
 						val:        "[0-9]",
 						ranges:     []rune{'0', '9'},
 						ignoreCase: false,
@@ -8204,29 +11331,29 @@ var g = &grammar{
 		},
 		{
 			name: "StringCharacter",
-			pos:  position{line: 869, col: 1, offset: 32630},
+			pos:  position{line: 1185, col: 1, offset: 45859},
 			expr: &choiceExpr{
-				pos: position{line: 869, col: 20, offset: 32649},
+				pos: position{line: 1185, col: 20, offset: 45878},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 869, col: 20, offset: 32649},
+						pos: position{line: 1185, col: 20, offset: 45878},
 						run: (*parser).callonStringCharacter2,
 						expr: &seqExpr{
-							pos: position{line: 869, col: 20, offset: 32649},
+							pos: position{line: 1185, col: 20, offset: 45878},
 							exprs: []any{
 								&notExpr{
-									pos: position{line: 869, col: 20, offset: 32649},
+									pos: position{line: 1185, col: 20, offset: 45878},
 									expr: &choiceExpr{
-										pos: position{line: 869, col: 22, offset: 32651},
+										pos: position{line: 1185, col: 22, offset: 45880},
 										alternatives: []any{
 											&litMatcher{
-												pos:        position{line: 869, col: 22, offset: 32651},
+												pos:        position{line: 1185, col: 22, offset: 45880},
 												val:        "\"",
 												ignoreCase: false,
 												want:       "\"\\\"\"",
 											},
 											&litMatcher{
-												pos:        position{line: 869, col: 28, offset: 32657},
+												pos:        position{line: 1185, col: 28, offset: 45886},
 												val:        "\\",
 												ignoreCase: false,
 												want:       "\"\\\\\"",
@@ -8235,28 +11362,92 @@ var g = &grammar{
 									},
 								},
 								&anyMatcher{
-									line: 869, col: 34, offset: 32663,
+									line: 1185, col: 34, offset: 45892,
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 870, col: 5, offset: 32700},
+						pos: position{line: 1186, col: 5, offset: 45929},
 						run: (*parser).callonStringCharacter9,
 						expr: &seqExpr{
-							pos: position{line: 870, col: 5, offset: 32700},
+							pos: position{line: 1186, col: 5, offset: 45929},
+							exprs: []any{
+								&litMatcher{
+									pos:        position{line: 1186, col: 5, offset: 45929},
+									val:        "\\",
+									ignoreCase: false,
+									want:       "\"\\\\\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 1186, col: 10, offset: 45934},
+									label: "seq",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1186, col: 14, offset: 45938},
+										name: "EscapeSequenceCharacter",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleQuotedStringCharacter",
+			pos:  position{line: 1188, col: 1, offset: 45983},
+			expr: &choiceExpr{
+				pos: position{line: 1188, col: 32, offset: 46014},
+				alternatives: []any{
+					&actionExpr{
+						pos: position{line: 1188, col: 32, offset: 46014},
+						run: (*parser).callonSingleQuotedStringCharacter2,
+						expr: &seqExpr{
+							pos: position{line: 1188, col: 32, offset: 46014},
+							exprs: []any{
+								&notExpr{
+									pos: position{line: 1188, col: 32, offset: 46014},
+									expr: &choiceExpr{
+										pos: position{line: 1188, col: 34, offset: 46016},
+										alternatives: []any{
+											&litMatcher{
+												pos:        position{line: 1188, col: 34, offset: 46016},
+												val:        "'",
+												ignoreCase: false,
+												want:       "\"'\"",
+											},
+											&litMatcher{
+												pos:        position{line: 1188, col: 40, offset: 46022},
+												val:        "\\",
+												ignoreCase: false,
+												want:       "\"\\\\\"",
+											},
+										},
+									},
+								},
+								&anyMatcher{
+									line: 1188, col: 46, offset: 46028,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1189, col: 5, offset: 46065},
+						run: (*parser).callonSingleQuotedStringCharacter9,
+						expr: &seqExpr{
+							pos: position{line: 1189, col: 5, offset: 46065},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 870, col: 5, offset: 32700},
+									pos:        position{line: 1189, col: 5, offset: 46065},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 870, col: 10, offset: 32705},
+									pos:   position{line: 1189, col: 10, offset: 46070},
 									label: "seq",
 									expr: &ruleRefExpr{
-										pos:  position{line: 870, col: 14, offset: 32709},
+										pos:  position{line: 1189, col: 14, offset: 46074},
 										name: "EscapeSequenceCharacter",
 									},
 								},
@@ -8268,85 +11459,85 @@ var g = &grammar{
 		},
 		{
 			name: "EscapeSequenceCharacter",
-			pos:  position{line: 872, col: 1, offset: 32754},
+			pos:  position{line: 1191, col: 1, offset: 46119},
 			expr: &labeledExpr{
-				pos:   position{line: 872, col: 28, offset: 32781},
+				pos:   position{line: 1191, col: 28, offset: 46146},
 				label: "char",
 				expr: &ruleRefExpr{
-					pos:  position{line: 872, col: 33, offset: 32786},
+					pos:  position{line: 1191, col: 33, offset: 46151},
 					name: "EscapeCharacter",
 				},
 			},
 		},
 		{
 			name: "EscapeCharacter",
-			pos:  position{line: 874, col: 1, offset: 32803},
+			pos:  position{line: 1193, col: 1, offset: 46168},
 			expr: &choiceExpr{
-				pos: position{line: 874, col: 20, offset: 32822},
+				pos: position{line: 1193, col: 20, offset: 46187},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 874, col: 20, offset: 32822},
+						pos:        position{line: 1193, col: 20, offset: 46187},
 						val:        "'",
 						ignoreCase: false,
 						want:       "\"'\"",
 					},
 					&litMatcher{
-						pos:        position{line: 875, col: 5, offset: 32830},
+						pos:        position{line: 1194, col: 5, offset: 46195},
 						val:        "\"",
 						ignoreCase: false,
 						want:       "\"\\\"\"",
 					},
 					&litMatcher{
-						pos:        position{line: 876, col: 5, offset: 32838},
+						pos:        position{line: 1195, col: 5, offset: 46203},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
 					},
 					&actionExpr{
-						pos: position{line: 877, col: 5, offset: 32847},
+						pos: position{line: 1196, col: 5, offset: 46212},
 						run: (*parser).callonEscapeCharacter5,
 						expr: &litMatcher{
-							pos:        position{line: 877, col: 5, offset: 32847},
+							pos:        position{line: 1196, col: 5, offset: 46212},
 							val:        "b",
 							ignoreCase: false,
 							want:       "\"b\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 878, col: 5, offset: 32876},
+						pos: position{line: 1197, col: 5, offset: 46241},
 						run: (*parser).callonEscapeCharacter7,
 						expr: &litMatcher{
-							pos:        position{line: 878, col: 5, offset: 32876},
+							pos:        position{line: 1197, col: 5, offset: 46241},
 							val:        "f",
 							ignoreCase: false,
 							want:       "\"f\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 879, col: 5, offset: 32905},
+						pos: position{line: 1198, col: 5, offset: 46270},
 						run: (*parser).callonEscapeCharacter9,
 						expr: &litMatcher{
-							pos:        position{line: 879, col: 5, offset: 32905},
+							pos:        position{line: 1198, col: 5, offset: 46270},
 							val:        "n",
 							ignoreCase: false,
 							want:       "\"n\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 880, col: 5, offset: 32934},
+						pos: position{line: 1199, col: 5, offset: 46299},
 						run: (*parser).callonEscapeCharacter11,
 						expr: &litMatcher{
-							pos:        position{line: 880, col: 5, offset: 32934},
+							pos:        position{line: 1199, col: 5, offset: 46299},
 							val:        "r",
 							ignoreCase: false,
 							want:       "\"r\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 881, col: 5, offset: 32963},
+						pos: position{line: 1200, col: 5, offset: 46328},
 						run: (*parser).callonEscapeCharacter13,
 						expr: &litMatcher{
-							pos:        position{line: 881, col: 5, offset: 32963},
+							pos:        position{line: 1200, col: 5, offset: 46328},
 							val:        "t",
 							ignoreCase: false,
 							want:       "\"t\"",
@@ -8357,25 +11548,25 @@ var g = &grammar{
 		},
 		{
 			name: "non_escape_character",
-			pos:  position{line: 883, col: 1, offset: 32989},
+			pos:  position{line: 1202, col: 1, offset: 46354},
 			expr: &actionExpr{
-				pos: position{line: 883, col: 25, offset: 33013},
+				pos: position{line: 1202, col: 25, offset: 46378},
 				run: (*parser).callonnon_escape_character1,
 				expr: &seqExpr{
-					pos: position{line: 883, col: 25, offset: 33013},
+					pos: position{line: 1202, col: 25, offset: 46378},
 					exprs: []any{
 						&notExpr{
-							pos: position{line: 883, col: 25, offset: 33013},
+							pos: position{line: 1202, col: 25, offset: 46378},
 							expr: &ruleRefExpr{
-								pos:  position{line: 883, col: 27, offset: 33015},
+								pos:  position{line: 1202, col: 27, offset: 46380},
 								name: "escape_character",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 883, col: 45, offset: 33033},
+							pos:   position{line: 1202, col: 45, offset: 46398},
 							label: "char",
 							expr: &anyMatcher{
-								line: 883, col: 50, offset: 33038,
+								line: 1202, col: 50, offset: 46403,
 							},
 						},
 					},
@@ -8384,11 +11575,11 @@ var g = &grammar{
 		},
 		{
 			name: "ws",
-			pos:  position{line: 886, col: 1, offset: 33077},
+			pos:  position{line: 1205, col: 1, offset: 46442},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 886, col: 7, offset: 33083},
+				pos: position{line: 1205, col: 7, offset: 46448},
 				expr: &charClassMatcher{
-					pos:        position{line: 886, col: 7, offset: 33083},
+					pos:        position{line: 1205, col: 7, offset: 46448},
 					val:        "[ \\t\\n\\r]",
 					chars:      []rune{' ', '\t', '\n', '\r'},
 					ignoreCase: false,
@@ -8398,11 +11589,11 @@ var g = &grammar{
 		},
 		{
 			name: "wss",
-			pos:  position{line: 888, col: 1, offset: 33095},
+			pos:  position{line: 1207, col: 1, offset: 46460},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 888, col: 8, offset: 33102},
+				pos: position{line: 1207, col: 8, offset: 46467},
 				expr: &charClassMatcher{
-					pos:        position{line: 888, col: 8, offset: 33102},
+					pos:        position{line: 1207, col: 8, offset: 46467},
 					val:        "[ \\t\\n\\r]",
 					chars:      []rune{' ', '\t', '\n', '\r'},
 					ignoreCase: false,
@@ -8412,11 +11603,11 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 890, col: 1, offset: 33114},
+			pos:  position{line: 1209, col: 1, offset: 46479},
 			expr: &notExpr{
-				pos: position{line: 890, col: 8, offset: 33121},
+				pos: position{line: 1209, col: 8, offset: 46486},
 				expr: &anyMatcher{
-					line: 890, col: 9, offset: 33122,
+					line: 1209, col: 9, offset: 46487,
 				},
 			},
 		},
@@ -8679,7 +11870,7 @@ func (p *parser) callonColumnList1() (any, error) {
 
 func (c *current) onExpressionOrSelectItem2(expression, asClause any) (any, error) {
 	switch typedValue := expression.(type) {
-	case parsers.ComparisonExpression, parsers.LogicalExpression:
+	case parsers.ComparisonExpression, parsers.LogicalExpression, parsers.BetweenExpression:
 		selectItem := parsers.SelectItem{
 			Type:  parsers.SelectItemTypeExpression,
 			Value: typedValue,
@@ -8738,14 +11929,27 @@ func (p *parser) callonTableName1() (any, error) {
 	return p.cur.onTableName1(stack["key"])
 }
 
-func (c *current) onSelectArray1(columns any) (any, error) {
+func (c *current) onSelectArray2(columns any) (any, error) {
 	return makeSelectArray(columns)
 }
 
-func (p *parser) callonSelectArray1() (any, error) {
+func (p *parser) callonSelectArray2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectArray2(stack["columns"])
+}
+
+func (c *current) onSelectArray10() (any, error) {
+	return parsers.SelectItem{
+		SelectItems: []parsers.SelectItem{},
+		Type:        parsers.SelectItemTypeArray,
+	}, nil
+}
+
+func (p *parser) callonSelectArray10() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSelectArray1(stack["columns"])
+	return p.cur.onSelectArray10()
 }
 
 func (c *current) onSelectObject11(coll any) (any, error) {
@@ -8791,8 +11995,18 @@ func (p *parser) callonSelectObjectField6() (any, error) {
 	return p.cur.onSelectObjectField6(stack["key"])
 }
 
+func (c *current) onSelectObjectField12(key any) (any, error) {
+	return key.(parsers.Constant).Value, nil
+}
+
+func (p *parser) callonSelectObjectField12() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSelectObjectField12(stack["key"])
+}
+
 func (c *current) onSelectObjectField1(name, selectItem any) (any, error) {
-	item := selectItem.(parsers.SelectItem)
+	item := makeExpressionSelectItem(selectItem)
 	item.Alias = name.(string)
 	return item, nil
 }
@@ -8923,6 +12137,49 @@ func (p *parser) callonCondition1() (any, error) {
 	return p.cur.onCondition1(stack["expression"])
 }
 
+func (c *current) onTernaryExpression7(trueValue, falseValue any) (any, error) {
+	return []interface{}{trueValue, falseValue}, nil
+}
+
+func (p *parser) callonTernaryExpression7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTernaryExpression7(stack["trueValue"], stack["falseValue"])
+}
+
+func (c *current) onTernaryExpression1(condition, branches any) (any, error) {
+	return makeTernaryExpression(condition, branches)
+}
+
+func (p *parser) callonTernaryExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTernaryExpression1(stack["condition"], stack["branches"])
+}
+
+func (c *current) onCoalesceExpression7(op, right any) (any, error) {
+	return []interface{}{op, makeExpressionSelectItem(right)}, nil
+}
+
+func (p *parser) callonCoalesceExpression7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCoalesceExpression7(stack["op"], stack["right"])
+}
+
+func (c *current) onCoalesceExpression1(left, operations any) (any, error) {
+	if operations == nil || len(operations.([]interface{})) == 0 {
+		return left, nil
+	}
+	return makeMathExpression(makeExpressionSelectItem(left), operations)
+}
+
+func (p *parser) callonCoalesceExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCoalesceExpression1(stack["left"], stack["operations"])
+}
+
 func (c *current) onOrExpression7(ex any) (any, error) {
 	return ex, nil
 }
@@ -8963,24 +12220,144 @@ func (p *parser) callonAndExpression1() (any, error) {
 	return p.cur.onAndExpression1(stack["ex1"], stack["ex2"])
 }
 
-func (c *current) onComparisonExpression2(left, op, right any) (any, error) {
-	return parsers.ComparisonExpression{Left: left, Right: right, Operation: op.(string)}, nil
+func (c *current) onComparisonExpression1(left, comparison any) (any, error) {
+	return makeComparisonExpression(left, comparison)
+}
+
+func (p *parser) callonComparisonExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonExpression1(stack["left"], stack["comparison"])
+}
+
+func (c *current) onComparisonOperatorTail1(op, right any) (any, error) {
+	return parsers.ComparisonExpression{Right: right, Operation: op.(string)}, nil
 }
 
-func (p *parser) callonComparisonExpression2() (any, error) {
+func (p *parser) callonComparisonOperatorTail1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComparisonExpression2(stack["left"], stack["op"], stack["right"])
+	return p.cur.onComparisonOperatorTail1(stack["op"], stack["right"])
 }
 
-func (c *current) onComparisonExpression12(ex any) (any, error) {
+func (c *current) onLikeTail15(ex any) (any, error) {
 	return ex, nil
 }
 
-func (p *parser) callonComparisonExpression12() (any, error) {
+func (p *parser) callonLikeTail15() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLikeTail15(stack["ex"])
+}
+
+func (c *current) onLikeTail1(inv, right, escape any) (any, error) {
+	return makeLikeExpression(right, inv != nil, escape)
+}
+
+func (p *parser) callonLikeTail1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLikeTail1(stack["inv"], stack["right"], stack["escape"])
+}
+
+func (c *current) onBetweenTail1(inv, low, high any) (any, error) {
+	return parsers.BetweenExpression{Low: low, High: high, Invert: inv != nil}, nil
+}
+
+func (p *parser) callonBetweenTail1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBetweenTail1(stack["inv"], stack["low"], stack["high"])
+}
+
+func (c *current) onBetweenExpression1(ex, between any) (any, error) {
+	return makeComparisonExpression(ex, between)
+}
+
+func (p *parser) callonBetweenExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBetweenExpression1(stack["ex"], stack["between"])
+}
+
+func (c *current) onBitwiseOrExpression7(op, right any) (any, error) {
+	return []interface{}{op, right}, nil
+}
+
+func (p *parser) callonBitwiseOrExpression7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseOrExpression7(stack["op"], stack["right"])
+}
+
+func (c *current) onBitwiseOrExpression1(left, operations any) (any, error) {
+	return makeMathExpression(left, operations)
+}
+
+func (p *parser) callonBitwiseOrExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseOrExpression1(stack["left"], stack["operations"])
+}
+
+func (c *current) onBitwiseXorExpression7(op, right any) (any, error) {
+	return []interface{}{op, right}, nil
+}
+
+func (p *parser) callonBitwiseXorExpression7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseXorExpression7(stack["op"], stack["right"])
+}
+
+func (c *current) onBitwiseXorExpression1(left, operations any) (any, error) {
+	return makeMathExpression(left, operations)
+}
+
+func (p *parser) callonBitwiseXorExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseXorExpression1(stack["left"], stack["operations"])
+}
+
+func (c *current) onBitwiseAndExpression7(op, right any) (any, error) {
+	return []interface{}{op, right}, nil
+}
+
+func (p *parser) callonBitwiseAndExpression7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseAndExpression7(stack["op"], stack["right"])
+}
+
+func (c *current) onBitwiseAndExpression1(left, operations any) (any, error) {
+	return makeMathExpression(left, operations)
+}
+
+func (p *parser) callonBitwiseAndExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseAndExpression1(stack["left"], stack["operations"])
+}
+
+func (c *current) onShiftExpression7(op, right any) (any, error) {
+	return []interface{}{op, right}, nil
+}
+
+func (p *parser) callonShiftExpression7() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onShiftExpression7(stack["op"], stack["right"])
+}
+
+func (c *current) onShiftExpression1(left, operations any) (any, error) {
+	return makeMathExpression(left, operations)
+}
+
+func (p *parser) callonShiftExpression1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComparisonExpression12(stack["ex"])
+	return p.cur.onShiftExpression1(stack["left"], stack["operations"])
 }
 
 func (c *current) onAddSubExpression7(op, right any) (any, error) {
@@ -9023,6 +12400,26 @@ func (p *parser) callonMulDivExpression1() (any, error) {
 	return p.cur.onMulDivExpression1(stack["left"], stack["operations"])
 }
 
+func (c *current) onUnaryExpression2(op, ex any) (any, error) {
+	return makeUnaryExpression(op.(string), ex)
+}
+
+func (p *parser) callonUnaryExpression2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnaryExpression2(stack["op"], stack["ex"])
+}
+
+func (c *current) onUnaryExpression9(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonUnaryExpression9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUnaryExpression9(stack["ex"])
+}
+
 func (c *current) onSelectItemWithParentheses2(inv, ex any) (any, error) {
 	if inv != nil {
 		if ex1, ok := ex.(parsers.SelectItem); ok {
@@ -9094,6 +12491,16 @@ func (p *parser) callonOrderExpression1() (any, error) {
 	return p.cur.onOrderExpression1(stack["field"], stack["order"])
 }
 
+func (c *current) onOrderBySelectItem2(ex any) (any, error) {
+	return parsers.SelectItem{Type: parsers.SelectItemTypeExpression, Value: ex}, nil
+}
+
+func (p *parser) callonOrderBySelectItem2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOrderBySelectItem2(stack["ex"])
+}
+
 func (c *current) onOrderDirection1() (any, error) {
 	if strings.EqualFold(string(c.text), "DESC") {
 		return parsers.OrderDirectionDesc, nil
@@ -9105,37 +12512,97 @@ func (c *current) onOrderDirection1() (any, error) {
 func (p *parser) callonOrderDirection1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onOrderDirection1()
+	return p.cur.onOrderDirection1()
+}
+
+func (c *current) onComparisonOperator1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonComparisonOperator1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onComparisonOperator1()
+}
+
+func (c *current) onAddOrSubtractOperation1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonAddOrSubtractOperation1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAddOrSubtractOperation1()
+}
+
+func (c *current) onMultiplyOrDivideOperation1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonMultiplyOrDivideOperation1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMultiplyOrDivideOperation1()
+}
+
+func (c *current) onShiftOperation1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonShiftOperation1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onShiftOperation1()
+}
+
+func (c *current) onBitwiseAndOperation1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonBitwiseAndOperation1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseAndOperation1()
+}
+
+func (c *current) onBitwiseXorOperation1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonBitwiseXorOperation1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBitwiseXorOperation1()
 }
 
-func (c *current) onComparisonOperator1() (any, error) {
-	return string(c.text), nil
+func (c *current) onBitwiseOrOperation1() (any, error) {
+	return "|", nil
 }
 
-func (p *parser) callonComparisonOperator1() (any, error) {
+func (p *parser) callonBitwiseOrOperation1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onComparisonOperator1()
+	return p.cur.onBitwiseOrOperation1()
 }
 
-func (c *current) onAddOrSubtractOperation1() (any, error) {
+func (c *current) onUnaryOperation1() (any, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonAddOrSubtractOperation1() (any, error) {
+func (p *parser) callonUnaryOperation1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAddOrSubtractOperation1()
+	return p.cur.onUnaryOperation1()
 }
 
-func (c *current) onMultiplyOrDivideOperation1() (any, error) {
+func (c *current) onCoalesceOperation1() (any, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonMultiplyOrDivideOperation1() (any, error) {
+func (p *parser) callonCoalesceOperation1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMultiplyOrDivideOperation1()
+	return p.cur.onCoalesceOperation1()
 }
 
 func (c *current) onParameterConstant1() (any, error) {
@@ -9168,14 +12635,24 @@ func (p *parser) callonIntegerLiteral1() (any, error) {
 	return p.cur.onIntegerLiteral1(stack["number"])
 }
 
-func (c *current) onStringLiteral1(chars any) (any, error) {
+func (c *current) onStringLiteral2(chars any) (any, error) {
+	return parsers.Constant{Type: parsers.ConstantTypeString, Value: joinStrings(chars.([]interface{}))}, nil
+}
+
+func (p *parser) callonStringLiteral2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringLiteral2(stack["chars"])
+}
+
+func (c *current) onStringLiteral9(chars any) (any, error) {
 	return parsers.Constant{Type: parsers.ConstantTypeString, Value: joinStrings(chars.([]interface{}))}, nil
 }
 
-func (p *parser) callonStringLiteral1() (any, error) {
+func (p *parser) callonStringLiteral9() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onStringLiteral1(stack["chars"])
+	return p.cur.onStringLiteral9(stack["chars"])
 }
 
 func (c *current) onFloatLiteral1() (any, error) {
@@ -9371,6 +12848,56 @@ func (p *parser) callonTrimExpression1() (any, error) {
 	return p.cur.onTrimExpression1(stack["ex"])
 }
 
+func (c *current) onStringToArrayExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStringToArray, []interface{}{ex})
+}
+
+func (p *parser) callonStringToArrayExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringToArrayExpression1(stack["ex"])
+}
+
+func (c *current) onStringToBooleanExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStringToBoolean, []interface{}{ex})
+}
+
+func (p *parser) callonStringToBooleanExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringToBooleanExpression1(stack["ex"])
+}
+
+func (c *current) onStringToNullExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStringToNull, []interface{}{ex})
+}
+
+func (p *parser) callonStringToNullExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringToNullExpression1(stack["ex"])
+}
+
+func (c *current) onStringToNumberExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStringToNumber, []interface{}{ex})
+}
+
+func (p *parser) callonStringToNumberExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringToNumberExpression1(stack["ex"])
+}
+
+func (c *current) onStringToObjectExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStringToObject, []interface{}{ex})
+}
+
+func (p *parser) callonStringToObjectExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringToObjectExpression1(stack["ex"])
+}
+
 func (c *current) onThreeArgumentStringFunctionExpression18(boolean any) (any, error) {
 	return boolean, nil
 }
@@ -9627,6 +13154,46 @@ func (p *parser) callonArraySliceExpression1() (any, error) {
 	return p.cur.onArraySliceExpression1(stack["array"], stack["start"], stack["length"])
 }
 
+func (c *current) onChooseExpression11(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonChooseExpression11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onChooseExpression11(stack["ex"])
+}
+
+func (c *current) onChooseExpression1(index, values any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallChoose, append([]interface{}{index}, values.([]interface{})...))
+}
+
+func (p *parser) callonChooseExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onChooseExpression1(stack["index"], stack["values"])
+}
+
+func (c *current) onObjectToArrayExpression11(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonObjectToArrayExpression11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onObjectToArrayExpression11(stack["ex"])
+}
+
+func (c *current) onObjectToArrayExpression1(object, others any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallObjectToArray, append([]interface{}{object}, others.([]interface{})...))
+}
+
+func (p *parser) callonObjectToArrayExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onObjectToArrayExpression1(stack["object"], stack["others"])
+}
+
 func (c *current) onSetIntersectExpression1(set1, set2 any) (any, error) {
 	return createFunctionCall(parsers.FunctionCallSetIntersect, []interface{}{set1, set2})
 }
@@ -9657,6 +13224,246 @@ func (p *parser) callonIifExpression1() (any, error) {
 	return p.cur.onIifExpression1(stack["condition"], stack["trueValue"], stack["falseValue"])
 }
 
+func (c *current) onDocumentIdExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDocumentId, []interface{}{ex})
+}
+
+func (p *parser) callonDocumentIdExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDocumentIdExpression1(stack["ex"])
+}
+
+func (c *current) onDateTimeAddExpression1(part, number, dateTime any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeAdd, []interface{}{part, number, dateTime})
+}
+
+func (p *parser) callonDateTimeAddExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeAddExpression1(stack["part"], stack["number"], stack["dateTime"])
+}
+
+func (c *current) onDateTimeBinExpression16(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonDateTimeBinExpression16() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeBinExpression16(stack["ex"])
+}
+
+func (c *current) onDateTimeBinExpression1(dateTime, part, others any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeBin, append([]interface{}{dateTime, part}, others.([]interface{})...))
+}
+
+func (p *parser) callonDateTimeBinExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeBinExpression1(stack["dateTime"], stack["part"], stack["others"])
+}
+
+func (c *current) onDateTimeDiffExpression1(part, startDate, endDate any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeDiff, []interface{}{part, startDate, endDate})
+}
+
+func (p *parser) callonDateTimeDiffExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeDiffExpression1(stack["part"], stack["startDate"], stack["endDate"])
+}
+
+func (c *current) onDateTimeFromPartsExpression21(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonDateTimeFromPartsExpression21() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeFromPartsExpression21(stack["ex"])
+}
+
+func (c *current) onDateTimeFromPartsExpression1(year, month, day, others any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeFromParts, append([]interface{}{year, month, day}, others.([]interface{})...))
+}
+
+func (p *parser) callonDateTimeFromPartsExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeFromPartsExpression1(stack["year"], stack["month"], stack["day"], stack["others"])
+}
+
+func (c *current) onDateTimePartExpression1(part, dateTime any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimePart, []interface{}{part, dateTime})
+}
+
+func (p *parser) callonDateTimePartExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimePartExpression1(stack["part"], stack["dateTime"])
+}
+
+func (c *current) onDateTimeToTicksExpression1(dateTime any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeToTicks, []interface{}{dateTime})
+}
+
+func (p *parser) callonDateTimeToTicksExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeToTicksExpression1(stack["dateTime"])
+}
+
+func (c *current) onDateTimeToTimestampExpression1(dateTime any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallDateTimeToTimestamp, []interface{}{dateTime})
+}
+
+func (p *parser) callonDateTimeToTimestampExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDateTimeToTimestampExpression1(stack["dateTime"])
+}
+
+func (c *current) onGetCurrentDateTimeStaticExpression1() (any, error) {
+	return createFunctionCall(parsers.FunctionCallGetCurrentDateTimeStatic, []interface{}{})
+}
+
+func (p *parser) callonGetCurrentDateTimeStaticExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGetCurrentDateTimeStaticExpression1()
+}
+
+func (c *current) onGetCurrentDateTimeExpression1() (any, error) {
+	return createFunctionCall(parsers.FunctionCallGetCurrentDateTime, []interface{}{})
+}
+
+func (p *parser) callonGetCurrentDateTimeExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGetCurrentDateTimeExpression1()
+}
+
+func (c *current) onGetCurrentTicksStaticExpression1() (any, error) {
+	return createFunctionCall(parsers.FunctionCallGetCurrentTicksStatic, []interface{}{})
+}
+
+func (p *parser) callonGetCurrentTicksStaticExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGetCurrentTicksStaticExpression1()
+}
+
+func (c *current) onGetCurrentTicksExpression1() (any, error) {
+	return createFunctionCall(parsers.FunctionCallGetCurrentTicks, []interface{}{})
+}
+
+func (p *parser) callonGetCurrentTicksExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGetCurrentTicksExpression1()
+}
+
+func (c *current) onGetCurrentTimestampStaticExpression1() (any, error) {
+	return createFunctionCall(parsers.FunctionCallGetCurrentTimestampStatic, []interface{}{})
+}
+
+func (p *parser) callonGetCurrentTimestampStaticExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGetCurrentTimestampStaticExpression1()
+}
+
+func (c *current) onGetCurrentTimestampExpression1() (any, error) {
+	return createFunctionCall(parsers.FunctionCallGetCurrentTimestamp, []interface{}{})
+}
+
+func (p *parser) callonGetCurrentTimestampExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGetCurrentTimestampExpression1()
+}
+
+func (c *current) onTicksToDateTimeExpression1(ticks any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallTicksToDateTime, []interface{}{ticks})
+}
+
+func (p *parser) callonTicksToDateTimeExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTicksToDateTimeExpression1(stack["ticks"])
+}
+
+func (c *current) onTimestampToDateTimeExpression1(timestamp any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallTimestampToDateTime, []interface{}{timestamp})
+}
+
+func (p *parser) callonTimestampToDateTimeExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTimestampToDateTimeExpression1(stack["timestamp"])
+}
+
+func (c *current) onStAreaExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStArea, []interface{}{ex})
+}
+
+func (p *parser) callonStAreaExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStAreaExpression1(stack["ex"])
+}
+
+func (c *current) onStDistanceExpression1(ex1, ex2 any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStDistance, []interface{}{ex1, ex2})
+}
+
+func (p *parser) callonStDistanceExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStDistanceExpression1(stack["ex1"], stack["ex2"])
+}
+
+func (c *current) onStWithinExpression1(ex1, ex2 any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStWithin, []interface{}{ex1, ex2})
+}
+
+func (p *parser) callonStWithinExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStWithinExpression1(stack["ex1"], stack["ex2"])
+}
+
+func (c *current) onStIntersectsExpression1(ex1, ex2 any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStIntersects, []interface{}{ex1, ex2})
+}
+
+func (p *parser) callonStIntersectsExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStIntersectsExpression1(stack["ex1"], stack["ex2"])
+}
+
+func (c *current) onStIsValidExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStIsValid, []interface{}{ex})
+}
+
+func (p *parser) callonStIsValidExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStIsValidExpression1(stack["ex"])
+}
+
+func (c *current) onStIsValidDetailedExpression1(ex any) (any, error) {
+	return createFunctionCall(parsers.FunctionCallStIsValidDetailed, []interface{}{ex})
+}
+
+func (p *parser) callonStIsValidDetailedExpression1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStIsValidDetailedExpression1(stack["ex"])
+}
+
 func (c *current) onMathAbsExpression1(ex any) (any, error) {
 	return createFunctionCall(parsers.FunctionCallMathAbs, []interface{}{ex})
 }
@@ -10099,6 +13906,45 @@ func (p *parser) callonInFunction29() (any, error) {
 	return p.cur.onInFunction29(stack["ex1"], stack["notIn"], stack["ex2"], stack["others"])
 }
 
+func (c *current) onUserDefinedFunctionCall17(ex any) (any, error) {
+	return ex, nil
+}
+
+func (p *parser) callonUserDefinedFunctionCall17() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUserDefinedFunctionCall17(stack["ex"])
+}
+
+func (c *current) onUserDefinedFunctionCall11(first, others any) (any, error) {
+	return append([]interface{}{first}, others.([]interface{})...), nil
+}
+
+func (p *parser) callonUserDefinedFunctionCall11() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUserDefinedFunctionCall11(stack["first"], stack["others"])
+}
+
+func (c *current) onUserDefinedFunctionCall1(name, args any) (any, error) {
+	arguments := []interface{}{}
+	if args != nil {
+		arguments = args.([]interface{})
+	}
+
+	return parsers.FunctionCall{
+		Type:      parsers.FunctionCallUserDefined,
+		UdfName:   name.(string),
+		Arguments: arguments,
+	}, nil
+}
+
+func (p *parser) callonUserDefinedFunctionCall1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onUserDefinedFunctionCall1(stack["name"], stack["args"])
+}
+
 func (c *current) onAvgAggregateExpression1(ex any) (any, error) {
 	return createFunctionCall(parsers.FunctionCallAggregateAvg, []interface{}{ex})
 }
@@ -10179,6 +14025,26 @@ func (p *parser) callonStringCharacter9() (any, error) {
 	return p.cur.onStringCharacter9(stack["seq"])
 }
 
+func (c *current) onSingleQuotedStringCharacter2() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonSingleQuotedStringCharacter2() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuotedStringCharacter2()
+}
+
+func (c *current) onSingleQuotedStringCharacter9(seq any) (any, error) {
+	return seq, nil
+}
+
+func (p *parser) callonSingleQuotedStringCharacter9() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuotedStringCharacter9(stack["seq"])
+}
+
 func (c *current) onEscapeCharacter5() (any, error) {
 	return "\b", nil
 }
@@ -196,6 +196,51 @@ func Test_Parse_Select(t *testing.T) {
 		)
 	})
 
+	t.Run("Should parse SELECT object with quoted keys and expressions", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT { "$1": c.id, "isAdult": c.age >= 18 } AS obj FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias: "obj",
+						Type:  parsers.SelectItemTypeObject,
+						SelectItems: []parsers.SelectItem{
+							{Alias: "$1", Path: []string{"c", "id"}},
+							{
+								Alias: "isAdult",
+								Type:  parsers.SelectItemTypeExpression,
+								Value: parsers.ComparisonExpression{
+									Operation: ">=",
+									Left:      testutils.SelectItem_Path("c", "age"),
+									Right:     testutils.SelectItem_Constant_Int(18),
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse SELECT empty array", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT [] AS arr FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias:       "arr",
+						Type:        parsers.SelectItemTypeArray,
+						SelectItems: []parsers.SelectItem{},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
 	t.Run("Should parse comparison expressions in SELECT", func(t *testing.T) {
 		testQueryParse(
 			t,
@@ -0,0 +1,126 @@
+package nosql_test
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+)
+
+func Test_Parse_SpatialFunctions(t *testing.T) {
+
+	t.Run("Should parse function ST_AREA(ex)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ST_AREA(c.area) FROM c`,
+			parsers.FunctionCallStArea,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "area"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function ST_DISTANCE(ex1, ex2)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ST_DISTANCE(c.location, {"type": "Point", "coordinates": [1, 2]}) FROM c`,
+			parsers.FunctionCallStDistance,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "location"},
+					Type: parsers.SelectItemTypeField,
+				},
+				parsers.SelectItem{
+					Type: parsers.SelectItemTypeObject,
+					SelectItems: []parsers.SelectItem{
+						{
+							Alias: "type",
+							Type:  parsers.SelectItemTypeConstant,
+							Value: parsers.Constant{Type: parsers.ConstantTypeString, Value: "Point"},
+						},
+						{
+							Alias: "coordinates",
+							Type:  parsers.SelectItemTypeArray,
+							SelectItems: []parsers.SelectItem{
+								{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 1}},
+								{Type: parsers.SelectItemTypeConstant, Value: parsers.Constant{Type: parsers.ConstantTypeInteger, Value: 2}},
+							},
+						},
+					},
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function ST_WITHIN(ex1, ex2)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ST_WITHIN(c.location, c.area) FROM c`,
+			parsers.FunctionCallStWithin,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "location"},
+					Type: parsers.SelectItemTypeField,
+				},
+				parsers.SelectItem{
+					Path: []string{"c", "area"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function ST_INTERSECTS(ex1, ex2)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ST_INTERSECTS(c.location, c.area) FROM c`,
+			parsers.FunctionCallStIntersects,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "location"},
+					Type: parsers.SelectItemTypeField,
+				},
+				parsers.SelectItem{
+					Path: []string{"c", "area"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function ST_ISVALID(ex)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ST_ISVALID(c.location) FROM c`,
+			parsers.FunctionCallStIsValid,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "location"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+
+	t.Run("Should parse function ST_ISVALIDDETAILED(ex)", func(t *testing.T) {
+		testMathFunctionParse(
+			t,
+			`SELECT ST_ISVALIDDETAILED(c.location) FROM c`,
+			parsers.FunctionCallStIsValidDetailed,
+			[]interface{}{
+				parsers.SelectItem{
+					Path: []string{"c", "location"},
+					Type: parsers.SelectItemTypeField,
+				},
+			},
+			"c",
+		)
+	})
+}
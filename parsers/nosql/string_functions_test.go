@@ -538,4 +538,124 @@ func Test_Execute_StringFunctions(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should parse function STRINGTOARRAY()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT STRINGTOARRAY(c.value) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallStringToArray,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "value"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse function STRINGTOBOOLEAN()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT STRINGTOBOOLEAN(c.value) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallStringToBoolean,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "value"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse function STRINGTONULL()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT STRINGTONULL(c.value) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallStringToNull,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "value"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse function STRINGTONUMBER()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT STRINGTONUMBER(c.value) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallStringToNumber,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "value"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse function STRINGTOOBJECT()", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT STRINGTOOBJECT(c.value) FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Type: parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallStringToObject,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Path: []string{"c", "value"},
+									Type: parsers.SelectItemTypeField,
+								},
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
 }
@@ -165,4 +165,139 @@ func Test_Parse_Were(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should parse SELECT with LIKE condition", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE c.id LIKE "12%"`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.ComparisonExpression{
+					Operation: "LIKE",
+					Left:      parsers.SelectItem{Path: []string{"c", "id"}},
+					Right:     testutils.SelectItem_Constant_String("12%"),
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse SELECT with NOT LIKE condition and ESCAPE clause", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE c.id NOT LIKE '%20!%%' ESCAPE '!'`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.ComparisonExpression{
+					Operation: "NOT LIKE",
+					Left:      parsers.SelectItem{Path: []string{"c", "id"}},
+					Right:     testutils.SelectItem_Constant_String("%20!%%"),
+					Escape:    "!",
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse LIKE expression in SELECT", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id LIKE "1%" AS startsWithOne FROM c`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias: "startsWithOne",
+						Type:  parsers.SelectItemTypeExpression,
+						Value: parsers.ComparisonExpression{
+							Operation: "LIKE",
+							Left:      parsers.SelectItem{Path: []string{"c", "id"}},
+							Right:     testutils.SelectItem_Constant_String("1%"),
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+		)
+	})
+
+	t.Run("Should parse SELECT with BETWEEN condition", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE c.price BETWEEN @lo AND @hi AND c.isCool`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.LogicalExpression{
+					Operation: parsers.LogicalExpressionTypeAnd,
+					Expressions: []interface{}{
+						parsers.BetweenExpression{
+							Value: parsers.SelectItem{Path: []string{"c", "price"}},
+							Low:   testutils.SelectItem_Constant_Parameter("@lo"),
+							High:  testutils.SelectItem_Constant_Parameter("@hi"),
+						},
+						parsers.SelectItem{Path: []string{"c", "isCool"}},
+					},
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse SELECT with NOT BETWEEN condition", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.id FROM c WHERE c.price NOT BETWEEN 1 AND 5`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.BetweenExpression{
+					Value:  parsers.SelectItem{Path: []string{"c", "price"}},
+					Low:    testutils.SelectItem_Constant_Int(1),
+					High:   testutils.SelectItem_Constant_Int(5),
+					Invert: true,
+				},
+			},
+		)
+	})
+
+	t.Run("Should parse BETWEEN expression in SELECT and ORDER BY", func(t *testing.T) {
+		testQueryParse(
+			t,
+			`SELECT c.price BETWEEN 1 AND 5 AS priceInRange FROM c ORDER BY c.price BETWEEN 1 AND 5 DESC`,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{
+						Alias: "priceInRange",
+						Type:  parsers.SelectItemTypeExpression,
+						Value: parsers.BetweenExpression{
+							Value: parsers.SelectItem{Path: []string{"c", "price"}},
+							Low:   testutils.SelectItem_Constant_Int(1),
+							High:  testutils.SelectItem_Constant_Int(5),
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				OrderExpressions: []parsers.OrderExpression{
+					{
+						SelectItem: parsers.SelectItem{
+							Type: parsers.SelectItemTypeExpression,
+							Value: parsers.BetweenExpression{
+								Value: parsers.SelectItem{Path: []string{"c", "price"}},
+								Low:   testutils.SelectItem_Constant_Int(1),
+								High:  testutils.SelectItem_Constant_Int(5),
+							},
+						},
+						Direction: parsers.OrderDirectionDesc,
+					},
+				},
+			},
+		)
+	})
 }
@@ -88,4 +88,115 @@ func Test_Execute_Arithmetics(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should execute coalesce and ternary operators", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					testutils.SelectItem_Path("c", "id"),
+					{
+						Alias: "value",
+						Type:  parsers.SelectItemTypeBinaryExpression,
+						Value: parsers.BinaryExpression{
+							Operation: "??",
+							Left:      testutils.SelectItem_Path("c", "a"),
+							Right:     testutils.SelectItem_Constant_String("none"),
+						},
+					},
+					{
+						Alias: "size",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type: parsers.FunctionCallIif,
+							Arguments: []interface{}{
+								parsers.SelectItem{
+									Type: parsers.SelectItemTypeExpression,
+									Value: parsers.ComparisonExpression{
+										Operation: ">",
+										Left:      testutils.SelectItem_Path("c", "a"),
+										Right:     testutils.SelectItem_Constant_Int(10),
+									},
+								},
+								testutils.SelectItem_Constant_String("big"),
+								testutils.SelectItem_Constant_String("small"),
+							},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": 1, "value": 420, "size": "big"},
+				map[string]interface{}{"id": 2, "value": 6.9, "size": "small"},
+				map[string]interface{}{"id": 3, "value": "none", "size": "small"},
+			},
+		)
+	})
+
+	t.Run("Should execute bitwise and string concatenation operators", func(t *testing.T) {
+		binaryExpression := func(operation string, left parsers.SelectItem, right parsers.SelectItem) parsers.SelectItem {
+			return parsers.SelectItem{
+				Type: parsers.SelectItemTypeBinaryExpression,
+				Value: parsers.BinaryExpression{
+					Operation: operation,
+					Left:      left,
+					Right:     right,
+				},
+			}
+		}
+		withAlias := func(alias string, selectItem parsers.SelectItem) parsers.SelectItem {
+			selectItem.Alias = alias
+			return selectItem
+		}
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					withAlias("or", binaryExpression("|", testutils.SelectItem_Constant_Int(5), testutils.SelectItem_Constant_Float(2.9))),
+					withAlias("and", binaryExpression("&", testutils.SelectItem_Constant_Int(6), testutils.SelectItem_Constant_Int(3))),
+					withAlias("xor", binaryExpression("^", testutils.SelectItem_Constant_Int(6), testutils.SelectItem_Constant_Int(3))),
+					withAlias("shl", binaryExpression("<<", testutils.SelectItem_Constant_Int(1), testutils.SelectItem_Constant_Int(33))),
+					withAlias("shr", binaryExpression(">>", testutils.SelectItem_Constant_Int(-16), testutils.SelectItem_Constant_Int(2))),
+					withAlias("ushr", binaryExpression(">>>", testutils.SelectItem_Constant_Int(-1), testutils.SelectItem_Constant_Int(28))),
+					withAlias("mod", binaryExpression("%", testutils.SelectItem_Constant_Int(-7), testutils.SelectItem_Constant_Int(3))),
+					withAlias("concat", binaryExpression("||", testutils.SelectItem_Constant_String("a"), testutils.SelectItem_Constant_String("b"))),
+					withAlias("badConcat", binaryExpression("||", testutils.SelectItem_Constant_String("a"), testutils.SelectItem_Constant_Int(1))),
+					withAlias("not", parsers.SelectItem{
+						Type: parsers.SelectItemTypeUnaryExpression,
+						Value: parsers.UnaryExpression{
+							Operation: "~",
+							Value:     testutils.SelectItem_Constant_Int(5),
+						},
+					}),
+					withAlias("negate", parsers.SelectItem{
+						Type: parsers.SelectItemTypeUnaryExpression,
+						Value: parsers.UnaryExpression{
+							Operation: "-",
+							Value:     testutils.SelectItem_Path("c", "a"),
+						},
+					}),
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+			mockData[:1],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{
+					"or":        7,
+					"and":       2,
+					"xor":       5,
+					"shl":       2,
+					"shr":       -4,
+					"ushr":      15,
+					"mod":       -1.0,
+					"concat":    "ab",
+					"badConcat": nil,
+					"not":       -6,
+					"negate":    -420,
+				},
+			},
+		)
+	})
 }
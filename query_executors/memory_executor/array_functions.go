@@ -1,7 +1,9 @@
 package memoryexecutor
 
 import (
+	"math"
 	"reflect"
+	"sort"
 
 	"github.com/pikami/cosmium/internal/logger"
 	"github.com/pikami/cosmium/parsers"
@@ -145,6 +147,66 @@ func (r rowContext) array_Slice(arguments []interface{}) []interface{} {
 	return array[start:end]
 }
 
+func (r rowContext) array_Choose(arguments []interface{}) interface{} {
+	indexEx := r.resolveSelectItem(arguments[0].(parsers.SelectItem))
+	if floatIndex, isFloat := indexEx.(float64); isFloat && floatIndex != math.Trunc(floatIndex) {
+		logger.ErrorLn("array_Choose - got index parameter of wrong type")
+		return nil
+	}
+
+	index, ok := numToInt(indexEx)
+	if !ok {
+		logger.ErrorLn("array_Choose - got index parameter of wrong type")
+		return nil
+	}
+
+	// CHOOSE uses 1-based indexes, out of range indexes are undefined
+	if index < 1 || index >= len(arguments) {
+		return nil
+	}
+
+	return r.resolveSelectItem(arguments[index].(parsers.SelectItem))
+}
+
+func (r rowContext) array_ObjectToArray(arguments []interface{}) interface{} {
+	object, ok := r.resolveSelectItem(arguments[0].(parsers.SelectItem)).(map[string]interface{})
+	if !ok {
+		logger.ErrorLn("array_ObjectToArray - got object parameter of wrong type")
+		return nil
+	}
+
+	keyName := "k"
+	valueName := "v"
+	if len(arguments) > 1 {
+		if keyName, ok = r.resolveSelectItem(arguments[1].(parsers.SelectItem)).(string); !ok {
+			logger.ErrorLn("array_ObjectToArray - got keyName parameter of wrong type")
+			return nil
+		}
+	}
+	if len(arguments) > 2 {
+		if valueName, ok = r.resolveSelectItem(arguments[2].(parsers.SelectItem)).(string); !ok {
+			logger.ErrorLn("array_ObjectToArray - got valueName parameter of wrong type")
+			return nil
+		}
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]interface{}, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, map[string]interface{}{
+			keyName:   key,
+			valueName: object[key],
+		})
+	}
+
+	return result
+}
+
 func (r rowContext) set_Intersect(arguments []interface{}) []interface{} {
 	set1 := r.parseArray(arguments[0])
 	set2 := r.parseArray(arguments[1])
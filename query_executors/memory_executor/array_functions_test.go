@@ -492,4 +492,73 @@ func Test_Execute_ArrayFunctions(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should execute function CHOOSE()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallChoose,
+				[]interface{}{
+					testutils.SelectItem_Path("c", "index"),
+					testutils.SelectItem_Constant_String("first"),
+					testutils.SelectItem_Path("c", "arr1"),
+				},
+			),
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123", "index": 1, "arr1": []int{1, 2, 3}},
+				map[string]interface{}{"id": "456", "index": 2.0, "arr1": []int{4, 5, 6}},
+				map[string]interface{}{"id": "789", "index": 3, "arr1": []int{7, 8, 9}},
+				map[string]interface{}{"id": "999", "index": "1", "arr1": []int{7, 8, 9}},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123", "result": "first"},
+				map[string]interface{}{"id": "456", "result": []int{4, 5, 6}},
+				map[string]interface{}{"id": "789", "result": nil},
+				map[string]interface{}{"id": "999", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function ObjectToArray()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallObjectToArray,
+				[]interface{}{testutils.SelectItem_Path("c", "obj")},
+			),
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123", "obj": map[string]interface{}{"b": 2, "a": "one"}},
+				map[string]interface{}{"id": "456", "obj": []int{1, 2}},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123", "result": []interface{}{
+					map[string]interface{}{"k": "a", "v": "one"},
+					map[string]interface{}{"k": "b", "v": 2},
+				}},
+				map[string]interface{}{"id": "456", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function ObjectToArray() with key and value names", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallObjectToArray,
+				[]interface{}{
+					testutils.SelectItem_Path("c", "obj"),
+					testutils.SelectItem_Constant_String("name"),
+					testutils.SelectItem_Constant_String("value"),
+				},
+			),
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123", "obj": map[string]interface{}{"a": true}},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123", "result": []interface{}{
+					map[string]interface{}{"name": "a", "value": true},
+				}},
+			},
+		)
+	})
 }
@@ -2,9 +2,12 @@ package memoryexecutor
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pikami/cosmium/internal/datastore"
 	"github.com/pikami/cosmium/internal/logger"
@@ -16,6 +19,35 @@ type rowContext struct {
 	tables       map[string]RowType
 	parameters   map[string]interface{}
 	grouppedRows []rowContext
+	queryContext *queryContext
+}
+
+// queryContext holds the state that is shared between all rows of a single query execution
+type queryContext struct {
+	startTime          time.Time
+	err                error
+	udfs               *udfRuntime
+	rootTable          string
+	computedProperties map[string]parsers.SelectStmt
+	likePatterns       map[likePattern]*regexp.Regexp
+}
+
+// likePattern identifies a compiled LIKE pattern, the escape character changes its meaning
+type likePattern struct {
+	pattern string
+	escape  string
+}
+
+// maxLikePatterns limits the compiled LIKE patterns kept for a query,
+// patterns that are read from the documents could otherwise be different for every row
+const maxLikePatterns = 64
+
+// setQueryError records an error that makes the whole query invalid;
+// only the first reported error is kept
+func (r rowContext) setQueryError(err error) {
+	if r.queryContext != nil && r.queryContext.err == nil {
+		r.queryContext.err = err
+	}
 }
 
 type rowIterator interface {
@@ -78,6 +110,10 @@ func (r rowContext) resolveSelectItem(selectItem parsers.SelectItem) interface{}
 			return r.filters_LogicalExpression(typedExpression)
 		}
 
+		if typedExpression, ok := selectItem.Value.(parsers.BetweenExpression); ok {
+			return r.resolveBetweenExpression(typedExpression)
+		}
+
 		logger.ErrorLn("parsers.SelectItem has incorrect Value type (expected parsers.ComparisonExpression)")
 		return nil
 	}
@@ -91,6 +127,15 @@ func (r rowContext) resolveSelectItem(selectItem parsers.SelectItem) interface{}
 		return nil
 	}
 
+	if selectItem.Type == parsers.SelectItemTypeUnaryExpression {
+		if typedSelectItem, ok := selectItem.Value.(parsers.UnaryExpression); ok {
+			return r.selectItem_SelectItemTypeUnaryExpression(typedSelectItem)
+		}
+
+		logger.ErrorLn("parsers.SelectItem has incorrect Value type (expected parsers.UnaryExpression)")
+		return nil
+	}
+
 	return r.selectItem_SelectItemTypeField(selectItem)
 }
 
@@ -194,6 +239,16 @@ func (r rowContext) selectItem_SelectItemTypeFunctionCall(functionCall parsers.F
 		return r.strings_Substring(functionCall.Arguments)
 	case parsers.FunctionCallTrim:
 		return r.strings_Trim(functionCall.Arguments)
+	case parsers.FunctionCallStringToArray:
+		return r.strings_StringToArray(functionCall.Arguments)
+	case parsers.FunctionCallStringToBoolean:
+		return r.strings_StringToBoolean(functionCall.Arguments)
+	case parsers.FunctionCallStringToNull:
+		return r.strings_StringToNull(functionCall.Arguments)
+	case parsers.FunctionCallStringToNumber:
+		return r.strings_StringToNumber(functionCall.Arguments)
+	case parsers.FunctionCallStringToObject:
+		return r.strings_StringToObject(functionCall.Arguments)
 
 	case parsers.FunctionCallIsDefined:
 		return r.typeChecking_IsDefined(functionCall.Arguments)
@@ -228,6 +283,10 @@ func (r rowContext) selectItem_SelectItemTypeFunctionCall(functionCall parsers.F
 		return r.array_Length(functionCall.Arguments)
 	case parsers.FunctionCallArraySlice:
 		return r.array_Slice(functionCall.Arguments)
+	case parsers.FunctionCallChoose:
+		return r.array_Choose(functionCall.Arguments)
+	case parsers.FunctionCallObjectToArray:
+		return r.array_ObjectToArray(functionCall.Arguments)
 	case parsers.FunctionCallSetIntersect:
 		return r.set_Intersect(functionCall.Arguments)
 	case parsers.FunctionCallSetUnion:
@@ -236,6 +295,56 @@ func (r rowContext) selectItem_SelectItemTypeFunctionCall(functionCall parsers.F
 	case parsers.FunctionCallIif:
 		return r.misc_Iif(functionCall.Arguments)
 
+	case parsers.FunctionCallDocumentId:
+		return r.misc_DocumentId(functionCall.Arguments)
+
+	case parsers.FunctionCallUserDefined:
+		return r.udf_Call(functionCall.UdfName, functionCall.Arguments)
+
+	case parsers.FunctionCallDateTimeAdd:
+		return r.dateTime_Add(functionCall.Arguments)
+	case parsers.FunctionCallDateTimeBin:
+		return r.dateTime_Bin(functionCall.Arguments)
+	case parsers.FunctionCallDateTimeDiff:
+		return r.dateTime_Diff(functionCall.Arguments)
+	case parsers.FunctionCallDateTimeFromParts:
+		return r.dateTime_FromParts(functionCall.Arguments)
+	case parsers.FunctionCallDateTimePart:
+		return r.dateTime_Part(functionCall.Arguments)
+	case parsers.FunctionCallDateTimeToTicks:
+		return r.dateTime_ToTicks(functionCall.Arguments)
+	case parsers.FunctionCallDateTimeToTimestamp:
+		return r.dateTime_ToTimestamp(functionCall.Arguments)
+	case parsers.FunctionCallGetCurrentDateTime:
+		return r.dateTime_GetCurrentDateTime()
+	case parsers.FunctionCallGetCurrentDateTimeStatic:
+		return r.dateTime_GetCurrentDateTimeStatic()
+	case parsers.FunctionCallGetCurrentTicks:
+		return r.dateTime_GetCurrentTicks()
+	case parsers.FunctionCallGetCurrentTicksStatic:
+		return r.dateTime_GetCurrentTicksStatic()
+	case parsers.FunctionCallGetCurrentTimestamp:
+		return r.dateTime_GetCurrentTimestamp()
+	case parsers.FunctionCallGetCurrentTimestampStatic:
+		return r.dateTime_GetCurrentTimestampStatic()
+	case parsers.FunctionCallTicksToDateTime:
+		return r.dateTime_TicksToDateTime(functionCall.Arguments)
+	case parsers.FunctionCallTimestampToDateTime:
+		return r.dateTime_TimestampToDateTime(functionCall.Arguments)
+
+	case parsers.FunctionCallStArea:
+		return r.spatial_Area(functionCall.Arguments)
+	case parsers.FunctionCallStDistance:
+		return r.spatial_Distance(functionCall.Arguments)
+	case parsers.FunctionCallStWithin:
+		return r.spatial_Within(functionCall.Arguments)
+	case parsers.FunctionCallStIntersects:
+		return r.spatial_Intersects(functionCall.Arguments)
+	case parsers.FunctionCallStIsValid:
+		return r.spatial_IsValid(functionCall.Arguments)
+	case parsers.FunctionCallStIsValidDetailed:
+		return r.spatial_IsValidDetailed(functionCall.Arguments)
+
 	case parsers.FunctionCallMathAbs:
 		return r.math_Abs(functionCall.Arguments)
 	case parsers.FunctionCallMathAcos:
@@ -335,12 +444,31 @@ func (r rowContext) selectItem_SelectItemTypeBinaryExpression(binaryExpression p
 	}
 
 	leftValue := r.resolveSelectItem(binaryExpression.Left.(parsers.SelectItem))
+
+	// The right operand of ?? is only evaluated when the left one is undefined
+	if binaryExpression.Operation == "??" {
+		if leftValue == nil {
+			return r.resolveSelectItem(binaryExpression.Right.(parsers.SelectItem))
+		}
+		return leftValue
+	}
+
 	rightValue := r.resolveSelectItem(binaryExpression.Right.(parsers.SelectItem))
 
 	if leftValue == nil || rightValue == nil {
 		return nil
 	}
 
+	if binaryExpression.Operation == "||" {
+		leftString, leftIsString := leftValue.(string)
+		rightString, rightIsString := rightValue.(string)
+		if !leftIsString || !rightIsString {
+			logger.Debug("String concatenation operands are not strings, returning nil")
+			return nil
+		}
+		return leftString + rightString
+	}
+
 	leftNumber, leftIsNumber := numToFloat64(leftValue)
 	rightNumber, rightIsNumber := numToFloat64(rightValue)
 
@@ -362,16 +490,71 @@ func (r rowContext) selectItem_SelectItemTypeBinaryExpression(binaryExpression p
 			return nil
 		}
 		return leftNumber / rightNumber
+	case "%":
+		if rightNumber == 0 {
+			logger.Debug("Modulo by zero in binary expression")
+			return nil
+		}
+		return math.Mod(leftNumber, rightNumber)
+	case "|":
+		return int(toInt32(leftNumber) | toInt32(rightNumber))
+	case "&":
+		return int(toInt32(leftNumber) & toInt32(rightNumber))
+	case "^":
+		return int(toInt32(leftNumber) ^ toInt32(rightNumber))
+	case "<<":
+		return int(toInt32(leftNumber) << (uint32(toInt32(rightNumber)) & 31))
+	case ">>":
+		return int(toInt32(leftNumber) >> (uint32(toInt32(rightNumber)) & 31))
+	case ">>>":
+		return int(uint32(toInt32(leftNumber)) >> (uint32(toInt32(rightNumber)) & 31))
 	default:
 		return nil
 	}
 }
 
+func (r rowContext) selectItem_SelectItemTypeUnaryExpression(unaryExpression parsers.UnaryExpression) interface{} {
+	value := r.resolveSelectItem(unaryExpression.Value.(parsers.SelectItem))
+
+	switch unaryExpression.Operation {
+	case "-":
+		switch typedValue := value.(type) {
+		case int:
+			return -typedValue
+		case float64:
+			return -typedValue
+		}
+	case "~":
+		if number, ok := numToFloat64(value); ok {
+			return int(^toInt32(number))
+		}
+	}
+
+	logger.Debug("Unary expression operand is not a number, returning nil")
+	return nil
+}
+
+// toInt32 converts a number to a 32-bit integer the same way as JavaScript
+// bitwise operators do: truncating the fraction and wrapping around on overflow
+func toInt32(number float64) int32 {
+	if math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0
+	}
+
+	return int32(uint32(int64(math.Mod(math.Trunc(number), 1<<32))))
+}
+
 func (r rowContext) selectItem_SelectItemTypeField(selectItem parsers.SelectItem) interface{} {
 	value := r.tables[selectItem.Path[0]]
+	path := selectItem.Path[1:]
+
+	if computedValue, ok := r.resolveComputedProperty(selectItem.Path); ok {
+		value = computedValue
+		path = selectItem.Path[2:]
+	}
 
-	if len(selectItem.Path) > 1 {
-		for _, pathSegment := range selectItem.Path[1:] {
+	if len(path) > 0 {
+		for _, pathSegment := range path {
 			if pathSegment[0] == '@' {
 				pathSegment = r.parameters[pathSegment].(string)
 			}
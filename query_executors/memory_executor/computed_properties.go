@@ -0,0 +1,47 @@
+package memoryexecutor
+
+import (
+	"github.com/pikami/cosmium/internal/datastore"
+)
+
+// resolveComputedProperty evaluates a computed property of the collection when
+// the path references it on the root document (e.g. c.cp_lowerName)
+func (r rowContext) resolveComputedProperty(path []string) (interface{}, bool) {
+	if r.queryContext == nil || len(r.queryContext.computedProperties) == 0 {
+		return nil, false
+	}
+
+	if len(path) < 2 || path[0] != r.queryContext.rootTable {
+		return nil, false
+	}
+
+	computedProperty, ok := r.queryContext.computedProperties[path[1]]
+	if !ok || len(computedProperty.SelectItems) != 1 {
+		return nil, false
+	}
+
+	var document map[string]interface{}
+	switch typedValue := r.tables[path[0]].(type) {
+	case map[string]interface{}:
+		document = typedValue
+	case datastore.Document:
+		document = typedValue
+	default:
+		return nil, false
+	}
+
+	// Persisted properties are not shadowed
+	if _, ok := document[path[1]]; ok {
+		return nil, false
+	}
+
+	computedRow := rowContext{
+		tables: map[string]RowType{
+			rootTableName(computedProperty): document,
+			"$root":                         document,
+		},
+		queryContext: r.queryContext,
+	}
+
+	return computedRow.resolveSelectItem(computedProperty.SelectItems[0]), true
+}
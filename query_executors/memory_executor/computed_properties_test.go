@@ -0,0 +1,86 @@
+package memoryexecutor_test
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+	testutils "github.com/pikami/cosmium/test_utils"
+)
+
+func Test_Execute_ComputedProperties(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "1", "name": "Bob"},
+		map[string]interface{}{"id": "2", "name": "alice"},
+		map[string]interface{}{"id": "3", "name": "Carol", "cp_lowerName": "persisted"},
+	}
+
+	// SELECT VALUE LOWER(i.name) FROM i
+	computedProperties := map[string]parsers.SelectStmt{
+		"cp_lowerName": {
+			SelectItems: []parsers.SelectItem{
+				{
+					Type: parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type:      parsers.FunctionCallLower,
+						Arguments: []interface{}{testutils.SelectItem_Path("i", "name")},
+					},
+					IsTopLevel: true,
+				},
+			},
+			Table: parsers.Table{Value: "i"},
+		},
+	}
+
+	t.Run("Should project, filter and order by computed property", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					testutils.SelectItem_Path("c", "id"),
+					testutils.SelectItem_Path("c", "cp_lowerName"),
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: "!=",
+					Left:      testutils.SelectItem_Path("c", "cp_lowerName"),
+					Right:     testutils.SelectItem_Constant_String("persisted"),
+				},
+				OrderExpressions: []parsers.OrderExpression{
+					{
+						SelectItem: testutils.SelectItem_Path("c", "cp_lowerName"),
+						Direction:  parsers.OrderDirectionAsc,
+					},
+				},
+				ComputedProperties: computedProperties,
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "2", "cp_lowerName": "alice"},
+				map[string]interface{}{"id": "1", "cp_lowerName": "bob"},
+			},
+		)
+	})
+
+	t.Run("Should not include computed properties in SELECT *", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c"}, IsTopLevel: true},
+				},
+				Table: parsers.Table{Value: "c"},
+				Filters: parsers.ComparisonExpression{
+					Operation: "=",
+					Left:      testutils.SelectItem_Path("c", "cp_lowerName"),
+					Right:     testutils.SelectItem_Constant_String("bob"),
+				},
+				ComputedProperties: computedProperties,
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "name": "Bob"},
+			},
+		)
+	})
+}
@@ -0,0 +1,417 @@
+package memoryexecutor
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/parsers"
+)
+
+// Cosmos DB represents date and time values as ISO 8601 UTC strings with
+// 7 fractional digits (the resolution of .NET ticks).
+const dateTimeFormat = "2006-01-02T15:04:05.0000000Z"
+
+const (
+	ticksPerMicrosecond int64 = 10
+	ticksPerMillisecond int64 = 10_000
+	ticksPerSecond      int64 = 10_000_000
+	ticksPerMinute      int64 = 60 * ticksPerSecond
+	ticksPerHour        int64 = 60 * ticksPerMinute
+	ticksPerDay         int64 = 24 * ticksPerHour
+)
+
+type dateTimePart int
+
+const (
+	dateTimePartYear dateTimePart = iota
+	dateTimePartMonth
+	dateTimePartDay
+	dateTimePartHour
+	dateTimePartMinute
+	dateTimePartSecond
+	dateTimePartMillisecond
+	dateTimePartMicrosecond
+	dateTimePartNanosecond
+)
+
+var dateTimePartNames = map[string]dateTimePart{
+	"year":        dateTimePartYear,
+	"yyyy":        dateTimePartYear,
+	"yy":          dateTimePartYear,
+	"month":       dateTimePartMonth,
+	"mm":          dateTimePartMonth,
+	"m":           dateTimePartMonth,
+	"day":         dateTimePartDay,
+	"dd":          dateTimePartDay,
+	"d":           dateTimePartDay,
+	"hour":        dateTimePartHour,
+	"hh":          dateTimePartHour,
+	"minute":      dateTimePartMinute,
+	"mi":          dateTimePartMinute,
+	"n":           dateTimePartMinute,
+	"second":      dateTimePartSecond,
+	"ss":          dateTimePartSecond,
+	"s":           dateTimePartSecond,
+	"millisecond": dateTimePartMillisecond,
+	"ms":          dateTimePartMillisecond,
+	"microsecond": dateTimePartMicrosecond,
+	"mcs":         dateTimePartMicrosecond,
+	"nanosecond":  dateTimePartNanosecond,
+	"ns":          dateTimePartNanosecond,
+}
+
+var dateTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+var unixEpoch = time.Unix(0, 0).UTC()
+
+func (r rowContext) dateTime_Add(arguments []interface{}) interface{} {
+	part, partOk := r.parseDateTimePart(arguments[0])
+	number, numberOk := r.parseInt64(arguments[1])
+	dateTime, dateTimeOk := r.parseDateTime(arguments[2])
+
+	if !partOk || !numberOk || !dateTimeOk {
+		logger.DebugLn("dateTime_Add - got parameters of wrong type")
+		return nil
+	}
+
+	var result time.Time
+	switch part {
+	case dateTimePartYear:
+		result = addMonths(dateTime, number*12)
+	case dateTimePartMonth:
+		result = addMonths(dateTime, number)
+	case dateTimePartNanosecond:
+		result = ticksToTime(timeToTicks(dateTime) + number/100)
+	default:
+		result = ticksToTime(timeToTicks(dateTime) + number*dateTimePartTicks(part))
+	}
+
+	return formatDateTime(result)
+}
+
+func (r rowContext) dateTime_Bin(arguments []interface{}) interface{} {
+	dateTime, dateTimeOk := r.parseDateTime(arguments[0])
+	part, partOk := r.parseDateTimePart(arguments[1])
+	if !dateTimeOk || !partOk {
+		logger.DebugLn("dateTime_Bin - got parameters of wrong type")
+		return nil
+	}
+
+	binSize := int64(1)
+	if len(arguments) > 2 {
+		var binSizeOk bool
+		if binSize, binSizeOk = r.parseInt64(arguments[2]); !binSizeOk || binSize <= 0 {
+			logger.DebugLn("dateTime_Bin - bin size must be a positive integer")
+			return nil
+		}
+	}
+
+	origin := unixEpoch
+	if len(arguments) > 3 {
+		var originOk bool
+		if origin, originOk = r.parseDateTime(arguments[3]); !originOk {
+			logger.DebugLn("dateTime_Bin - got origin of wrong type")
+			return nil
+		}
+	}
+
+	switch part {
+	case dateTimePartYear, dateTimePartMonth:
+		binMonths := binSize
+		if part == dateTimePartYear {
+			binMonths *= 12
+		}
+
+		months := monthsBetween(origin, dateTime)
+		if addMonths(origin, months).After(dateTime) {
+			months--
+		}
+
+		return formatDateTime(addMonths(origin, floorDiv(months, binMonths)*binMonths))
+	default:
+		binTicks := binSize * dateTimePartTicks(part)
+		if part == dateTimePartNanosecond {
+			binTicks = int64(math.Max(1, float64(binSize/100)))
+		}
+
+		originTicks := timeToTicks(origin)
+		elapsedTicks := timeToTicks(dateTime) - originTicks
+
+		return formatDateTime(ticksToTime(originTicks + floorDiv(elapsedTicks, binTicks)*binTicks))
+	}
+}
+
+func (r rowContext) dateTime_Diff(arguments []interface{}) interface{} {
+	part, partOk := r.parseDateTimePart(arguments[0])
+	startDate, startDateOk := r.parseDateTime(arguments[1])
+	endDate, endDateOk := r.parseDateTime(arguments[2])
+
+	if !partOk || !startDateOk || !endDateOk {
+		logger.DebugLn("dateTime_Diff - got parameters of wrong type")
+		return nil
+	}
+
+	switch part {
+	case dateTimePartYear:
+		return endDate.Year() - startDate.Year()
+	case dateTimePartMonth:
+		return int(monthsBetween(startDate, endDate))
+	case dateTimePartNanosecond:
+		return int((timeToTicks(endDate) - timeToTicks(startDate)) * 100)
+	default:
+		// Count the number of part boundaries crossed between the two dates
+		unitTicks := dateTimePartTicks(part)
+		return int(floorDiv(timeToTicks(endDate), unitTicks) - floorDiv(timeToTicks(startDate), unitTicks))
+	}
+}
+
+func (r rowContext) dateTime_FromParts(arguments []interface{}) interface{} {
+	// year, month, day, hour, minute, second, 100-nanosecond increments
+	minValues := []int64{1, 1, 1, 0, 0, 0, 0}
+	maxValues := []int64{9999, 12, 31, 23, 59, 59, 9_999_999}
+	parts := []int64{0, 0, 0, 0, 0, 0, 0}
+
+	for i, argument := range arguments {
+		if i >= len(parts) {
+			logger.DebugLn("dateTime_FromParts - too many arguments")
+			return nil
+		}
+
+		value, ok := r.parseInt64(argument)
+		if !ok || value < minValues[i] || value > maxValues[i] {
+			logger.DebugLn("dateTime_FromParts - got parameters of wrong type or out of range")
+			return nil
+		}
+		parts[i] = value
+	}
+
+	if parts[2] > int64(daysInMonth(int(parts[0]), time.Month(parts[1]))) {
+		logger.DebugLn("dateTime_FromParts - day is out of range for month")
+		return nil
+	}
+
+	result := time.Date(
+		int(parts[0]), time.Month(parts[1]), int(parts[2]),
+		int(parts[3]), int(parts[4]), int(parts[5]), int(parts[6]*100),
+		time.UTC,
+	)
+
+	return formatDateTime(result)
+}
+
+func (r rowContext) dateTime_Part(arguments []interface{}) interface{} {
+	part, partOk := r.parseDateTimePart(arguments[0])
+	dateTime, dateTimeOk := r.parseDateTime(arguments[1])
+
+	if !partOk || !dateTimeOk {
+		logger.DebugLn("dateTime_Part - got parameters of wrong type")
+		return nil
+	}
+
+	switch part {
+	case dateTimePartYear:
+		return dateTime.Year()
+	case dateTimePartMonth:
+		return int(dateTime.Month())
+	case dateTimePartDay:
+		return dateTime.Day()
+	case dateTimePartHour:
+		return dateTime.Hour()
+	case dateTimePartMinute:
+		return dateTime.Minute()
+	case dateTimePartSecond:
+		return dateTime.Second()
+	case dateTimePartMillisecond:
+		return dateTime.Nanosecond() / 1_000_000
+	case dateTimePartMicrosecond:
+		return dateTime.Nanosecond() / 1_000
+	case dateTimePartNanosecond:
+		return dateTime.Nanosecond() / 100 * 100
+	}
+
+	return nil
+}
+
+func (r rowContext) dateTime_ToTicks(arguments []interface{}) interface{} {
+	dateTime, ok := r.parseDateTime(arguments[0])
+	if !ok {
+		logger.DebugLn("dateTime_ToTicks - got parameters of wrong type")
+		return nil
+	}
+
+	return int(timeToTicks(dateTime))
+}
+
+func (r rowContext) dateTime_ToTimestamp(arguments []interface{}) interface{} {
+	dateTime, ok := r.parseDateTime(arguments[0])
+	if !ok {
+		logger.DebugLn("dateTime_ToTimestamp - got parameters of wrong type")
+		return nil
+	}
+
+	return int(floorDiv(timeToTicks(dateTime), ticksPerMillisecond))
+}
+
+func (r rowContext) dateTime_GetCurrentDateTime() interface{} {
+	return formatDateTime(time.Now().UTC())
+}
+
+func (r rowContext) dateTime_GetCurrentDateTimeStatic() interface{} {
+	return formatDateTime(r.staticTime())
+}
+
+func (r rowContext) dateTime_GetCurrentTicks() interface{} {
+	return int(timeToTicks(time.Now().UTC()))
+}
+
+func (r rowContext) dateTime_GetCurrentTicksStatic() interface{} {
+	return int(timeToTicks(r.staticTime()))
+}
+
+func (r rowContext) dateTime_GetCurrentTimestamp() interface{} {
+	return int(time.Now().UnixMilli())
+}
+
+func (r rowContext) dateTime_GetCurrentTimestampStatic() interface{} {
+	return int(r.staticTime().UnixMilli())
+}
+
+func (r rowContext) dateTime_TicksToDateTime(arguments []interface{}) interface{} {
+	ticks, ok := r.parseInt64(arguments[0])
+	if !ok {
+		logger.DebugLn("dateTime_TicksToDateTime - got parameters of wrong type")
+		return nil
+	}
+
+	return formatDateTime(ticksToTime(ticks))
+}
+
+func (r rowContext) dateTime_TimestampToDateTime(arguments []interface{}) interface{} {
+	timestamp, ok := r.parseInt64(arguments[0])
+	if !ok {
+		logger.DebugLn("dateTime_TimestampToDateTime - got parameters of wrong type")
+		return nil
+	}
+
+	return formatDateTime(ticksToTime(timestamp * ticksPerMillisecond))
+}
+
+// staticTime returns the time captured when the query started executing,
+// so that *Static functions return the same value for every row
+func (r rowContext) staticTime() time.Time {
+	if r.queryContext == nil {
+		return time.Now().UTC()
+	}
+
+	return r.queryContext.startTime
+}
+
+func (r rowContext) parseDateTimePart(argument interface{}) (dateTimePart, bool) {
+	value, ok := r.resolveSelectItem(argument.(parsers.SelectItem)).(string)
+	if !ok {
+		return 0, false
+	}
+
+	part, ok := dateTimePartNames[strings.ToLower(value)]
+	return part, ok
+}
+
+func (r rowContext) parseDateTime(argument interface{}) (time.Time, bool) {
+	value, ok := r.resolveSelectItem(argument.(parsers.SelectItem)).(string)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	for _, layout := range dateTimeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed.UTC(), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (r rowContext) parseInt64(argument interface{}) (int64, bool) {
+	value := r.resolveSelectItem(argument.(parsers.SelectItem))
+	if floatValue, isFloat := value.(float64); isFloat && (math.IsNaN(floatValue) || math.IsInf(floatValue, 0)) {
+		return 0, false
+	}
+
+	intValue, ok := numToInt(value)
+	return int64(intValue), ok
+}
+
+func formatDateTime(value time.Time) interface{} {
+	if value.Year() < 1 || value.Year() > 9999 {
+		return nil
+	}
+
+	return value.UTC().Format(dateTimeFormat)
+}
+
+func dateTimePartTicks(part dateTimePart) int64 {
+	switch part {
+	case dateTimePartDay:
+		return ticksPerDay
+	case dateTimePartHour:
+		return ticksPerHour
+	case dateTimePartMinute:
+		return ticksPerMinute
+	case dateTimePartSecond:
+		return ticksPerSecond
+	case dateTimePartMillisecond:
+		return ticksPerMillisecond
+	case dateTimePartMicrosecond:
+		return ticksPerMicrosecond
+	}
+
+	return 1
+}
+
+// timeToTicks returns the number of 100-nanosecond ticks since the unix epoch
+func timeToTicks(value time.Time) int64 {
+	return value.Unix()*ticksPerSecond + int64(value.Nanosecond())/100
+}
+
+func ticksToTime(ticks int64) time.Time {
+	return time.Unix(floorDiv(ticks, ticksPerSecond), (ticks-floorDiv(ticks, ticksPerSecond)*ticksPerSecond)*100).UTC()
+}
+
+// addMonths adds months the same way .NET does, clamping the day to the
+// last day of the resulting month instead of overflowing into the next one
+func addMonths(value time.Time, months int64) time.Time {
+	totalMonths := int64(value.Year())*12 + int64(value.Month()-1) + months
+	year := int(floorDiv(totalMonths, 12))
+	month := time.Month(totalMonths-floorDiv(totalMonths, 12)*12) + 1
+
+	day := value.Day()
+	if maxDay := daysInMonth(year, month); day > maxDay {
+		day = maxDay
+	}
+
+	return time.Date(year, month, day, value.Hour(), value.Minute(), value.Second(), value.Nanosecond(), time.UTC)
+}
+
+func monthsBetween(start time.Time, end time.Time) int64 {
+	return int64(end.Year()-start.Year())*12 + int64(end.Month()-start.Month())
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func floorDiv(a int64, b int64) int64 {
+	result := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		result--
+	}
+	return result
+}
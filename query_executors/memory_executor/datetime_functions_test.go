@@ -0,0 +1,269 @@
+package memoryexecutor_test
+
+import (
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+	testutils "github.com/pikami/cosmium/test_utils"
+)
+
+func Test_Execute_DateTimeFunctions(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "1", "date": "2020-07-09T23:20:13.4575530Z"},
+		map[string]interface{}{"id": "2", "date": "2024-01-31T00:00:00Z"},
+		map[string]interface{}{"id": "3", "date": "not a date"},
+	}
+
+	t.Run("Should execute function DateTimeAdd(part, number, dateTime)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeAdd,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("mm"),
+				testutils.SelectItem_Constant_Int(1),
+				testutils.SelectItem_Path("c", "date"),
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": "2020-08-09T23:20:13.4575530Z"},
+				map[string]interface{}{"id": "2", "result": "2024-02-29T00:00:00.0000000Z"},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeAdd(part, number, dateTime) with negative hours", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeAdd,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("hh"),
+				testutils.SelectItem_Constant_Int(-24),
+				testutils.SelectItem_Path("c", "date"),
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": "2020-07-08T23:20:13.4575530Z"},
+				map[string]interface{}{"id": "2", "result": "2024-01-30T00:00:00.0000000Z"},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeBin(dateTime, part, binSize)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeBin,
+			[]interface{}{
+				testutils.SelectItem_Path("c", "date"),
+				testutils.SelectItem_Constant_String("hh"),
+				testutils.SelectItem_Constant_Int(6),
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": "2020-07-09T18:00:00.0000000Z"},
+				map[string]interface{}{"id": "2", "result": "2024-01-31T00:00:00.0000000Z"},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeBin(dateTime, part, binSize, origin)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeBin,
+			[]interface{}{
+				testutils.SelectItem_Path("c", "date"),
+				testutils.SelectItem_Constant_String("mm"),
+				testutils.SelectItem_Constant_Int(3),
+				testutils.SelectItem_Constant_String("2020-02-01T00:00:00Z"),
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": "2020-05-01T00:00:00.0000000Z"},
+				map[string]interface{}{"id": "2", "result": "2023-11-01T00:00:00.0000000Z"},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeDiff(part, startDate, endDate)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeDiff,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("day"),
+				testutils.SelectItem_Constant_String("2020-01-01T23:59:59Z"),
+				testutils.SelectItem_Path("c", "date"),
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": 190},
+				map[string]interface{}{"id": "2", "result": 1491},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeDiff(part, startDate, endDate) with months", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeDiff,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("month"),
+				testutils.SelectItem_Path("c", "date"),
+				testutils.SelectItem_Constant_String("2020-01-01T00:00:00Z"),
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": -6},
+				map[string]interface{}{"id": "2", "result": -48},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeFromParts(year, month, day, hour, minute, second, ticks)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeFromParts,
+			[]interface{}{
+				testutils.SelectItem_Constant_Int(2017),
+				testutils.SelectItem_Constant_Int(4),
+				testutils.SelectItem_Constant_Int(20),
+				testutils.SelectItem_Constant_Int(13),
+				testutils.SelectItem_Constant_Int(15),
+				testutils.SelectItem_Constant_Int(20),
+				testutils.SelectItem_Constant_Int(3456789),
+			},
+			mockData[:1],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": "2017-04-20T13:15:20.3456789Z"},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeFromParts(year, month, day) with invalid day", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeFromParts,
+			[]interface{}{
+				testutils.SelectItem_Constant_Int(2023),
+				testutils.SelectItem_Constant_Int(2),
+				testutils.SelectItem_Constant_Int(29),
+			},
+			mockData[:1],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimePart(part, dateTime)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimePart,
+			[]interface{}{
+				testutils.SelectItem_Constant_String("ms"),
+				testutils.SelectItem_Path("c", "date"),
+			},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": 457},
+				map[string]interface{}{"id": "2", "result": 0},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeToTicks(dateTime)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeToTicks,
+			[]interface{}{testutils.SelectItem_Path("c", "date")},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": 15943368134575530},
+				map[string]interface{}{"id": "2", "result": 17066592000000000},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function DateTimeToTimestamp(dateTime)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallDateTimeToTimestamp,
+			[]interface{}{testutils.SelectItem_Path("c", "date")},
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": 1594336813457},
+				map[string]interface{}{"id": "2", "result": 1706659200000},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function TicksToDateTime(ticks)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallTicksToDateTime,
+			[]interface{}{testutils.SelectItem_Constant_Int(15943368134575530)},
+			mockData[:1],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": "2020-07-09T23:20:13.4575530Z"},
+			},
+		)
+	})
+
+	t.Run("Should execute function TimestampToDateTime(timestamp)", func(t *testing.T) {
+		testDateTimeFunctionExecute(
+			t,
+			parsers.FunctionCallTimestampToDateTime,
+			[]interface{}{testutils.SelectItem_Constant_Int(1594336813457)},
+			mockData[:1],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": "2020-07-09T23:20:13.4570000Z"},
+			},
+		)
+	})
+
+	t.Run("Should execute function GetCurrentDateTimeStatic() with the same value for every row", func(t *testing.T) {
+		for _, functionType := range []parsers.FunctionCallType{
+			parsers.FunctionCallGetCurrentDateTimeStatic,
+			parsers.FunctionCallGetCurrentTicksStatic,
+			parsers.FunctionCallGetCurrentTimestampStatic,
+		} {
+			result := executeFunctionCall(functionType, []interface{}{}, mockData)
+			if len(result) != len(mockData) {
+				t.Fatalf("%s: expected %d rows, got %d", functionType, len(mockData), len(result))
+			}
+
+			first := result[0].(map[string]interface{})["result"]
+			if first == nil {
+				t.Errorf("%s: expected a value, got undefined", functionType)
+			}
+			for _, row := range result[1:] {
+				if value := row.(map[string]interface{})["result"]; value != first {
+					t.Errorf("%s: expected %v for every row, got %v", functionType, first, value)
+				}
+			}
+		}
+	})
+}
+
+func testDateTimeFunctionExecute(
+	t *testing.T,
+	functionType parsers.FunctionCallType,
+	arguments []interface{},
+	data []memoryexecutor.RowType,
+	expectedData []memoryexecutor.RowType,
+) {
+	testQueryExecute(
+		t,
+		functionCallQuery(functionType, arguments),
+		data,
+		expectedData,
+	)
+}
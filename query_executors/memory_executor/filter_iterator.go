@@ -1,6 +1,9 @@
 package memoryexecutor
 
 import (
+	"regexp"
+	"strings"
+
 	"github.com/pikami/cosmium/internal/datastore"
 	"github.com/pikami/cosmium/internal/logger"
 	"github.com/pikami/cosmium/parsers"
@@ -39,6 +42,8 @@ func (fi *filterIterator) evaluateFilters(row rowContext) bool {
 		return row.filters_ComparisonExpression(typedFilters)
 	case parsers.LogicalExpression:
 		return row.filters_LogicalExpression(typedFilters)
+	case parsers.BetweenExpression:
+		return row.filters_BetweenExpression(typedFilters)
 	case parsers.Constant:
 		if value, ok := typedFilters.Value.(bool); ok {
 			return value
@@ -68,6 +73,8 @@ func (r rowContext) applyFilters(filters interface{}) bool {
 		return r.filters_ComparisonExpression(typedFilters)
 	case parsers.LogicalExpression:
 		return r.filters_LogicalExpression(typedFilters)
+	case parsers.BetweenExpression:
+		return r.filters_BetweenExpression(typedFilters)
 	case parsers.Constant:
 		if value, ok := typedFilters.Value.(bool); ok {
 			return value
@@ -99,6 +106,15 @@ func (r rowContext) filters_ComparisonExpression(expression parsers.ComparisonEx
 	leftValue := r.resolveSelectItem(leftExpression)
 	rightValue := r.resolveSelectItem(rightExpression)
 
+	if expression.Operation == "LIKE" || expression.Operation == "NOT LIKE" {
+		matches, ok := r.likeMatches(leftValue, rightValue, expression.Escape)
+		if !ok {
+			return false
+		}
+
+		return matches == (expression.Operation == "LIKE")
+	}
+
 	cmp := compareValues(leftValue, rightValue)
 	switch expression.Operation {
 	case "=":
@@ -118,6 +134,39 @@ func (r rowContext) filters_ComparisonExpression(expression parsers.ComparisonEx
 	return false
 }
 
+func (r rowContext) filters_BetweenExpression(expression parsers.BetweenExpression) bool {
+	result, ok := r.resolveBetweenExpression(expression).(bool)
+	return ok && result
+}
+
+// resolveBetweenExpression returns nil (undefined) when the value and
+// the range bounds are not all of the same type
+func (r rowContext) resolveBetweenExpression(expression parsers.BetweenExpression) interface{} {
+	valueExpression, valueOk := expression.Value.(parsers.SelectItem)
+	lowExpression, lowOk := expression.Low.(parsers.SelectItem)
+	highExpression, highOk := expression.High.(parsers.SelectItem)
+
+	if !valueOk || !lowOk || !highOk {
+		logger.ErrorLn("BetweenExpression has incorrect Value, Low or High type")
+		return nil
+	}
+
+	value := r.resolveSelectItem(valueExpression)
+	low := r.resolveSelectItem(lowExpression)
+	high := r.resolveSelectItem(highExpression)
+
+	if !isComparableType(value, low) || !isComparableType(value, high) {
+		return nil
+	}
+
+	result := compareValues(value, low) >= 0 && compareValues(value, high) <= 0
+	if expression.Invert {
+		return !result
+	}
+
+	return result
+}
+
 func (r rowContext) filters_LogicalExpression(expression parsers.LogicalExpression) bool {
 	var result bool
 	for i, subExpression := range expression.Expressions {
@@ -141,3 +190,119 @@ func (r rowContext) filters_LogicalExpression(expression parsers.LogicalExpressi
 	}
 	return result
 }
+
+// likeMatches evaluates the LIKE operator, the second return value is false
+// when the result is undefined because either operand is not a string
+func (r rowContext) likeMatches(value interface{}, pattern interface{}, escape string) (bool, bool) {
+	valueStr, valueOk := value.(string)
+	patternStr, patternOk := pattern.(string)
+	if !valueOk || !patternOk {
+		return false, false
+	}
+
+	regex, err := r.compileLikePattern(likePattern{pattern: patternStr, escape: escape})
+	if err != nil {
+		logger.ErrorLn("Failed to compile LIKE pattern:", err)
+		return false, false
+	}
+
+	return regex.MatchString(valueStr), true
+}
+
+// compileLikePattern compiles the pattern once per query instead of for every row
+func (r rowContext) compileLikePattern(pattern likePattern) (*regexp.Regexp, error) {
+	if r.queryContext != nil {
+		if regex, ok := r.queryContext.likePatterns[pattern]; ok {
+			return regex, nil
+		}
+	}
+
+	regex, err := regexp.Compile(likePatternToRegex(pattern.pattern, pattern.escape))
+	if err != nil {
+		return nil, err
+	}
+
+	if r.queryContext != nil {
+		if r.queryContext.likePatterns == nil {
+			r.queryContext.likePatterns = make(map[likePattern]*regexp.Regexp)
+		}
+		if len(r.queryContext.likePatterns) < maxLikePatterns {
+			r.queryContext.likePatterns[pattern] = regex
+		}
+	}
+
+	return regex, nil
+}
+
+func likePatternToRegex(pattern string, escape string) string {
+	var sb strings.Builder
+	sb.WriteString("(?s)^")
+
+	escapeRune := []rune(escape)
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		ch := runes[i]
+
+		if len(escapeRune) == 1 && ch == escapeRune[0] && i+1 < len(runes) {
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(runes[i])))
+			continue
+		}
+
+		switch ch {
+		case '%':
+			sb.WriteString(".*")
+		case '_':
+			sb.WriteString(".")
+		case '[':
+			end := i + 1
+			for end < len(runes) && runes[end] != ']' {
+				end++
+			}
+			if end >= len(runes) || end == i+1 {
+				sb.WriteString(regexp.QuoteMeta(string(ch)))
+				continue
+			}
+
+			sb.WriteString("[")
+			class := runes[i+1 : end]
+			if class[0] == '^' {
+				sb.WriteString("^")
+				class = class[1:]
+			}
+			for _, classCh := range class {
+				if classCh == '-' {
+					sb.WriteRune(classCh)
+				} else {
+					sb.WriteString(regexp.QuoteMeta(string(classCh)))
+				}
+			}
+			sb.WriteString("]")
+			i = end
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+
+	sb.WriteString("$")
+	return sb.String()
+}
+
+func isComparableType(val1 interface{}, val2 interface{}) bool {
+	_, val1IsNumber := numToFloat64(val1)
+	_, val2IsNumber := numToFloat64(val2)
+	if val1IsNumber || val2IsNumber {
+		return val1IsNumber && val2IsNumber
+	}
+
+	switch val1.(type) {
+	case string:
+		_, ok := val2.(string)
+		return ok
+	case bool:
+		_, ok := val2.(bool)
+		return ok
+	}
+
+	return false
+}
@@ -46,6 +46,7 @@ func (fi *fromIterator) Next() (rowContext, datastore.DataStoreStatus) {
 			rowContexts := make([]rowContext, len(selectValue))
 			for i, newRowData := range selectValue {
 				rowContexts[i].parameters = row.parameters
+				rowContexts[i].queryContext = row.queryContext
 				rowContexts[i].tables = copyMap(row.tables)
 				rowContexts[i].tables[destinationTableName] = newRowData
 			}
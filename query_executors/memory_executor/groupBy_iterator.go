@@ -52,6 +52,7 @@ func (gi *groupByIterator) Next() (rowContext, datastore.DataStoreStatus) {
 			tables:       groupedRows[key][0].tables,
 			parameters:   groupedRows[key][0].parameters,
 			grouppedRows: groupedRows[key],
+			queryContext: groupedRows[key][0].queryContext,
 		})
 	}
 
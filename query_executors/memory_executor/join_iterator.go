@@ -37,8 +37,9 @@ func (ji *joinIterator) Next() (rowContext, datastore.DataStoreStatus) {
 				tablesCopy := copyMap(row.tables)
 				tablesCopy[joinItem.Table.Value] = joinedItem
 				nextDocuments = append(nextDocuments, rowContext{
-					parameters: row.parameters,
-					tables:     tablesCopy,
+					parameters:   row.parameters,
+					tables:       tablesCopy,
+					queryContext: row.queryContext,
 				})
 			}
 		}
@@ -1,6 +1,8 @@
 package memoryexecutor
 
 import (
+	"time"
+
 	"github.com/pikami/cosmium/internal/datastore"
 	"github.com/pikami/cosmium/parsers"
 )
@@ -8,6 +10,7 @@ import (
 type ExecuteQueryResult struct {
 	Rows         []RowType
 	HasMorePages bool
+	Error        error
 }
 
 func ExecuteQuery(
@@ -16,7 +19,21 @@ func ExecuteQuery(
 	offset int,
 	limit int,
 ) ExecuteQueryResult {
-	resultIter := executeQuery(query, &rowTypeToRowContextIterator{documents: documents, query: query})
+	queryContext := &queryContext{
+		startTime: time.Now().UTC(),
+		udfs:      newUdfRuntime(query.Udfs),
+	}
+
+	if len(query.ComputedProperties) > 0 {
+		queryContext.rootTable = rootTableName(query)
+		queryContext.computedProperties = query.ComputedProperties
+	}
+
+	resultIter := executeQuery(query, &rowTypeToRowContextIterator{
+		documents:    documents,
+		query:        query,
+		queryContext: queryContext,
+	})
 
 	result := &ExecuteQueryResult{
 		Rows:         make([]RowType, 0),
@@ -44,6 +61,13 @@ func ExecuteQuery(
 		result.HasMorePages = true
 	}
 
+	if queryContext.err != nil {
+		return ExecuteQueryResult{
+			Rows:  make([]RowType, 0),
+			Error: queryContext.err,
+		}
+	}
+
 	return *result
 }
 
@@ -1,6 +1,8 @@
 package memoryexecutor
 
 import (
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/internal/resourceid"
 	"github.com/pikami/cosmium/parsers"
 )
 
@@ -29,3 +31,23 @@ func (r rowContext) misc_Iif(arguments []interface{}) interface{} {
 
 	return r.resolveSelectItem(arguments[2].(parsers.SelectItem))
 }
+
+func (r rowContext) misc_DocumentId(arguments []interface{}) interface{} {
+	document, ok := r.resolveSelectItem(arguments[0].(parsers.SelectItem)).(map[string]interface{})
+	if !ok {
+		logger.ErrorLn("misc_DocumentId - got parameters of wrong type")
+		return nil
+	}
+
+	rid, ok := document["_rid"].(string)
+	if !ok {
+		return nil
+	}
+
+	documentId, ok := resourceid.DocumentNumericId(rid)
+	if !ok {
+		return nil
+	}
+
+	return int(documentId)
+}
@@ -40,11 +40,45 @@ func testQueryExecute(
 	iter := NewTestDocumentIterator(data)
 	result := memoryexecutor.ExecuteQuery(query, iter, 0, 1000)
 
+	if result.Error != nil {
+		t.Errorf("execution failed with error: %v", result.Error)
+	}
+
 	if !reflect.DeepEqual(result.Rows, expectedData) {
 		t.Errorf("execution result does not match expected data.\nExpected: %+v\nGot: %+v", expectedData, result.Rows)
 	}
 }
 
+func executeFunctionCall(
+	functionType parsers.FunctionCallType,
+	arguments []interface{},
+	data []memoryexecutor.RowType,
+) []memoryexecutor.RowType {
+	iter := NewTestDocumentIterator(data)
+	result := memoryexecutor.ExecuteQuery(functionCallQuery(functionType, arguments), iter, 0, 1000)
+	return result.Rows
+}
+
+func functionCallQuery(functionType parsers.FunctionCallType, arguments []interface{}) parsers.SelectStmt {
+	return parsers.SelectStmt{
+		SelectItems: []parsers.SelectItem{
+			{
+				Path: []string{"c", "id"},
+				Type: parsers.SelectItemTypeField,
+			},
+			{
+				Alias: "result",
+				Type:  parsers.SelectItemTypeFunctionCall,
+				Value: parsers.FunctionCall{
+					Type:      functionType,
+					Arguments: arguments,
+				},
+			},
+		},
+		Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+	}
+}
+
 func Test_Execute(t *testing.T) {
 	mockData := []memoryexecutor.RowType{
 		map[string]interface{}{"id": "12345", "pk": 123, "_self": "self1", "_rid": "rid1", "_ts": 123456, "isCool": false},
@@ -276,4 +310,21 @@ func Test_Execute(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should execute function DocumentId()", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(parsers.FunctionCallDocumentId, []interface{}{testutils.SelectItem_Path("c")}),
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "_rid": "Sl8fALN4sw4CAAAAAAAAAA=="},
+				map[string]interface{}{"id": "2", "_rid": "Sl8fALN4sw45MAAAAAAAAA=="},
+				map[string]interface{}{"id": "3", "_rid": "rid2"},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": 2},
+				map[string]interface{}{"id": "2", "result": 12345},
+				map[string]interface{}{"id": "3", "result": nil},
+			},
+		)
+	})
 }
@@ -44,6 +44,7 @@ func (pi *projectIterator) Next() (RowType, datastore.DataStoreStatus) {
 			tables:       row.tables,
 			parameters:   row.parameters,
 			grouppedRows: allDocuments,
+			queryContext: row.queryContext,
 		}
 
 		return aggRow.applyProjection(pi.selectItems), datastore.StatusOk
@@ -6,8 +6,9 @@ import (
 )
 
 type rowTypeToRowContextIterator struct {
-	documents rowTypeIterator
-	query     parsers.SelectStmt
+	documents    rowTypeIterator
+	query        parsers.SelectStmt
+	queryContext *queryContext
 }
 
 func (di *rowTypeToRowContextIterator) Next() (rowContext, datastore.DataStoreStatus) {
@@ -21,24 +22,30 @@ func (di *rowTypeToRowContextIterator) Next() (rowContext, datastore.DataStoreSt
 		return rowContext{}, status
 	}
 
+	return rowContext{
+		parameters: di.query.Parameters,
+		tables: map[string]RowType{
+			rootTableName(di.query): doc,
+			"$root":                 doc,
+		},
+		queryContext: di.queryContext,
+	}, status
+}
+
+// rootTableName returns the name under which the documents are available in the query
+func rootTableName(query parsers.SelectStmt) string {
 	var initialTableName string
-	if di.query.Table.SelectItem.Type == parsers.SelectItemTypeSubQuery {
-		initialTableName = di.query.Table.SelectItem.Value.(parsers.SelectStmt).Table.Value
+	if query.Table.SelectItem.Type == parsers.SelectItemTypeSubQuery {
+		initialTableName = query.Table.SelectItem.Value.(parsers.SelectStmt).Table.Value
 	}
 
 	if initialTableName == "" {
-		initialTableName = di.query.Table.Value
+		initialTableName = query.Table.Value
 	}
 
 	if initialTableName == "" {
-		initialTableName = resolveDestinationColumnName(di.query.Table.SelectItem, 0, di.query.Parameters)
+		initialTableName = resolveDestinationColumnName(query.Table.SelectItem, 0, query.Parameters)
 	}
 
-	return rowContext{
-		parameters: di.query.Parameters,
-		tables: map[string]RowType{
-			initialTableName: doc,
-			"$root":          doc,
-		},
-	}, status
+	return initialTableName
 }
@@ -0,0 +1,592 @@
+package memoryexecutor
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/parsers"
+)
+
+// Cosmos DB uses the WGS-84 reference ellipsoid for geography calculations
+const (
+	wgs84SemiMajorAxis  = 6378137.0
+	wgs84Flattening     = 1 / 298.257223563
+	wgs84SemiMinorAxis  = wgs84SemiMajorAxis * (1 - wgs84Flattening)
+	earthAuthalicRadius = 6371007.180918475
+)
+
+type geoPoint struct {
+	lon float64
+	lat float64
+}
+
+type geoSegment struct {
+	start geoPoint
+	end   geoPoint
+}
+
+type geoShape struct {
+	points   []geoPoint
+	lines    [][]geoPoint
+	polygons [][][]geoPoint
+}
+
+func (r rowContext) spatial_Area(arguments []interface{}) interface{} {
+	shape, ok := r.resolveGeoShape(arguments[0])
+	if !ok {
+		logger.DebugLn("spatial_Area - got parameters of wrong type")
+		return nil
+	}
+
+	area := 0.0
+	for _, polygon := range shape.polygons {
+		for i, ring := range polygon {
+			if i == 0 {
+				area += geoRingArea(ring)
+			} else {
+				area -= geoRingArea(ring)
+			}
+		}
+	}
+
+	return area
+}
+
+func (r rowContext) spatial_Distance(arguments []interface{}) interface{} {
+	shape1, ok1 := r.resolveGeoShape(arguments[0])
+	shape2, ok2 := r.resolveGeoShape(arguments[1])
+	if !ok1 || !ok2 {
+		logger.DebugLn("spatial_Distance - got parameters of wrong type")
+		return nil
+	}
+
+	if geoIntersects(shape1, shape2) {
+		return 0.0
+	}
+
+	distance := math.Inf(1)
+	for _, point := range shape1.vertices() {
+		for _, segment := range shape2.segments() {
+			distance = math.Min(distance, geoPointToSegmentDistance(point, segment))
+		}
+	}
+	for _, point := range shape2.vertices() {
+		for _, segment := range shape1.segments() {
+			distance = math.Min(distance, geoPointToSegmentDistance(point, segment))
+		}
+	}
+
+	return distance
+}
+
+func (r rowContext) spatial_Within(arguments []interface{}) interface{} {
+	shape1, ok1 := r.resolveGeoShape(arguments[0])
+	shape2, ok2 := r.resolveGeoShape(arguments[1])
+	if !ok1 || !ok2 {
+		logger.DebugLn("spatial_Within - got parameters of wrong type")
+		return nil
+	}
+
+	if len(shape2.polygons) == 0 {
+		return false
+	}
+
+	for _, point := range shape1.vertices() {
+		if !shape2.containsPoint(point) {
+			return false
+		}
+	}
+
+	// All vertices may be inside a concave polygon while an edge still leaves it
+	for _, segment1 := range shape1.segments() {
+		for _, segment2 := range shape2.segments() {
+			if geoSegmentsCross(segment1, segment2) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (r rowContext) spatial_Intersects(arguments []interface{}) interface{} {
+	shape1, ok1 := r.resolveGeoShape(arguments[0])
+	shape2, ok2 := r.resolveGeoShape(arguments[1])
+	if !ok1 || !ok2 {
+		logger.DebugLn("spatial_Intersects - got parameters of wrong type")
+		return nil
+	}
+
+	return geoIntersects(shape1, shape2)
+}
+
+func (r rowContext) spatial_IsValid(arguments []interface{}) bool {
+	exItem := arguments[0].(parsers.SelectItem)
+	ex := r.resolveSelectItem(exItem)
+
+	_, reason := parseGeoShape(ex)
+	return reason == ""
+}
+
+func (r rowContext) spatial_IsValidDetailed(arguments []interface{}) map[string]interface{} {
+	exItem := arguments[0].(parsers.SelectItem)
+	ex := r.resolveSelectItem(exItem)
+
+	_, reason := parseGeoShape(ex)
+	if reason != "" {
+		return map[string]interface{}{
+			"valid":  false,
+			"reason": reason,
+		}
+	}
+
+	return map[string]interface{}{
+		"valid": true,
+	}
+}
+
+func (r rowContext) resolveGeoShape(argument interface{}) (geoShape, bool) {
+	exItem := argument.(parsers.SelectItem)
+	ex := r.resolveSelectItem(exItem)
+
+	shape, reason := parseGeoShape(ex)
+	return shape, reason == ""
+}
+
+// parseGeoShape parses a GeoJSON geometry, returning the reason
+// why the geometry is invalid or an empty string if it is valid
+func parseGeoShape(value interface{}) (geoShape, string) {
+	var shape geoShape
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return shape, "The input is not a valid GeoJSON object."
+	}
+
+	geoType, ok := object["type"].(string)
+	if !ok {
+		return shape, "The GeoJSON object must have a 'type' property."
+	}
+
+	coordinates, ok := object["coordinates"].([]interface{})
+	if !ok {
+		return shape, "The GeoJSON object must have a 'coordinates' property that is an array."
+	}
+
+	switch geoType {
+	case "Point":
+		point, reason := parseGeoPoint(coordinates)
+		if reason != "" {
+			return shape, reason
+		}
+		shape.points = append(shape.points, point)
+	case "MultiPoint":
+		points, reason := parseGeoPoints(coordinates)
+		if reason != "" {
+			return shape, reason
+		}
+		shape.points = points
+	case "LineString":
+		line, reason := parseGeoLineString(coordinates)
+		if reason != "" {
+			return shape, reason
+		}
+		shape.lines = append(shape.lines, line)
+	case "MultiLineString":
+		for _, lineCoordinates := range coordinates {
+			lineArray, ok := lineCoordinates.([]interface{})
+			if !ok {
+				return shape, "The MultiLineString input is not valid because it must contain an array of LineStrings."
+			}
+			line, reason := parseGeoLineString(lineArray)
+			if reason != "" {
+				return shape, reason
+			}
+			shape.lines = append(shape.lines, line)
+		}
+	case "Polygon":
+		polygon, reason := parseGeoPolygon(coordinates)
+		if reason != "" {
+			return shape, reason
+		}
+		shape.polygons = append(shape.polygons, polygon)
+	case "MultiPolygon":
+		for _, polygonCoordinates := range coordinates {
+			polygonArray, ok := polygonCoordinates.([]interface{})
+			if !ok {
+				return shape, "The MultiPolygon input is not valid because it must contain an array of Polygons."
+			}
+			polygon, reason := parseGeoPolygon(polygonArray)
+			if reason != "" {
+				return shape, reason
+			}
+			shape.polygons = append(shape.polygons, polygon)
+		}
+	default:
+		return shape, fmt.Sprintf("The GeoJSON type '%s' is not supported.", geoType)
+	}
+
+	return shape, ""
+}
+
+func parseGeoPoint(coordinates []interface{}) (geoPoint, string) {
+	if len(coordinates) < 2 {
+		return geoPoint{}, "A position must contain at least two coordinates."
+	}
+
+	lon, lonOk := numToFloat64(coordinates[0])
+	lat, latOk := numToFloat64(coordinates[1])
+	if !lonOk || !latOk {
+		return geoPoint{}, "A position must contain only numeric coordinates."
+	}
+
+	if lat < -90 || lat > 90 {
+		return geoPoint{}, "Latitude values must be between -90 and 90 degrees."
+	}
+	if lon < -180 || lon > 180 {
+		return geoPoint{}, "Longitude values must be between -180 and 180 degrees."
+	}
+
+	return geoPoint{lon: lon, lat: lat}, ""
+}
+
+func parseGeoPoints(coordinates []interface{}) ([]geoPoint, string) {
+	points := make([]geoPoint, 0, len(coordinates))
+	for _, pointCoordinates := range coordinates {
+		pointArray, ok := pointCoordinates.([]interface{})
+		if !ok {
+			return nil, "A position must be an array of coordinates."
+		}
+
+		point, reason := parseGeoPoint(pointArray)
+		if reason != "" {
+			return nil, reason
+		}
+		points = append(points, point)
+	}
+
+	return points, ""
+}
+
+func parseGeoLineString(coordinates []interface{}) ([]geoPoint, string) {
+	points, reason := parseGeoPoints(coordinates)
+	if reason != "" {
+		return nil, reason
+	}
+
+	for _, point := range points[min(1, len(points)):] {
+		if point != points[0] {
+			return points, ""
+		}
+	}
+
+	return nil, "The LineString input is not valid because it does not have enough distinct points. A LineString must have at least two distinct points."
+}
+
+func parseGeoPolygon(coordinates []interface{}) ([][]geoPoint, string) {
+	if len(coordinates) == 0 {
+		return nil, "The Polygon input is not valid because it does not contain any rings."
+	}
+
+	rings := make([][]geoPoint, 0, len(coordinates))
+	for i, ringCoordinates := range coordinates {
+		ringArray, ok := ringCoordinates.([]interface{})
+		if !ok {
+			return nil, fmt.Sprintf("The Polygon input is not valid because the ring number %d is not an array of positions.", i+1)
+		}
+
+		ring, reason := parseGeoPoints(ringArray)
+		if reason != "" {
+			return nil, reason
+		}
+
+		if len(ring) < 4 {
+			return nil, fmt.Sprintf("The Polygon input is not valid because the ring number %d does not have enough points. Each ring of a polygon must contain at least four points.", i+1)
+		}
+		if ring[0] != ring[len(ring)-1] {
+			return nil, fmt.Sprintf("The Polygon input is not valid because the start and end points of the ring number %d are not the same. Each ring of a polygon must have the same start and end points.", i+1)
+		}
+
+		rings = append(rings, ring)
+	}
+
+	return rings, ""
+}
+
+// vertices returns every position that makes up the shape
+func (s geoShape) vertices() []geoPoint {
+	vertices := append([]geoPoint{}, s.points...)
+	for _, line := range s.lines {
+		vertices = append(vertices, line...)
+	}
+	for _, polygon := range s.polygons {
+		for _, ring := range polygon {
+			vertices = append(vertices, ring...)
+		}
+	}
+	return vertices
+}
+
+// segments returns every edge of the shape, points are represented as zero length segments
+func (s geoShape) segments() []geoSegment {
+	segments := make([]geoSegment, 0)
+	for _, point := range s.points {
+		segments = append(segments, geoSegment{start: point, end: point})
+	}
+
+	appendPath := func(path []geoPoint) {
+		for i := 1; i < len(path); i++ {
+			segments = append(segments, geoSegment{start: path[i-1], end: path[i]})
+		}
+	}
+	for _, line := range s.lines {
+		appendPath(line)
+	}
+	for _, polygon := range s.polygons {
+		for _, ring := range polygon {
+			appendPath(ring)
+		}
+	}
+	return segments
+}
+
+func (s geoShape) containsPoint(point geoPoint) bool {
+	for _, polygon := range s.polygons {
+		if !geoRingContainsPoint(polygon[0], point) {
+			continue
+		}
+
+		insideHole := false
+		for _, hole := range polygon[1:] {
+			if geoRingContainsPoint(hole, point) && !geoRingBoundaryContainsPoint(hole, point) {
+				insideHole = true
+				break
+			}
+		}
+
+		if !insideHole {
+			return true
+		}
+	}
+	return false
+}
+
+func geoIntersects(shape1 geoShape, shape2 geoShape) bool {
+	for _, point := range shape1.vertices() {
+		if shape2.containsPoint(point) {
+			return true
+		}
+	}
+	for _, point := range shape2.vertices() {
+		if shape1.containsPoint(point) {
+			return true
+		}
+	}
+
+	for _, segment1 := range shape1.segments() {
+		for _, segment2 := range shape2.segments() {
+			if geoSegmentsIntersect(segment1, segment2) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func geoRingContainsPoint(ring []geoPoint, point geoPoint) bool {
+	if geoRingBoundaryContainsPoint(ring, point) {
+		return true
+	}
+
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.lat > point.lat) != (b.lat > point.lat) &&
+			point.lon < (b.lon-a.lon)*(point.lat-a.lat)/(b.lat-a.lat)+a.lon {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func geoRingBoundaryContainsPoint(ring []geoPoint, point geoPoint) bool {
+	for i := 1; i < len(ring); i++ {
+		if geoOrientation(ring[i-1], ring[i], point) == 0 && geoOnSegment(ring[i-1], ring[i], point) {
+			return true
+		}
+	}
+	return false
+}
+
+func geoSegmentsIntersect(segment1 geoSegment, segment2 geoSegment) bool {
+	o1 := geoOrientation(segment1.start, segment1.end, segment2.start)
+	o2 := geoOrientation(segment1.start, segment1.end, segment2.end)
+	o3 := geoOrientation(segment2.start, segment2.end, segment1.start)
+	o4 := geoOrientation(segment2.start, segment2.end, segment1.end)
+
+	if o1 != o2 && o3 != o4 && o1 != 0 && o2 != 0 && o3 != 0 && o4 != 0 {
+		return true
+	}
+
+	return (o1 == 0 && geoOnSegment(segment1.start, segment1.end, segment2.start)) ||
+		(o2 == 0 && geoOnSegment(segment1.start, segment1.end, segment2.end)) ||
+		(o3 == 0 && geoOnSegment(segment2.start, segment2.end, segment1.start)) ||
+		(o4 == 0 && geoOnSegment(segment2.start, segment2.end, segment1.end))
+}
+
+// geoSegmentsCross reports whether the segments properly cross each other, touching does not count
+func geoSegmentsCross(segment1 geoSegment, segment2 geoSegment) bool {
+	o1 := geoOrientation(segment1.start, segment1.end, segment2.start)
+	o2 := geoOrientation(segment1.start, segment1.end, segment2.end)
+	o3 := geoOrientation(segment2.start, segment2.end, segment1.start)
+	o4 := geoOrientation(segment2.start, segment2.end, segment1.end)
+
+	return o1*o2 < 0 && o3*o4 < 0
+}
+
+func geoOrientation(a geoPoint, b geoPoint, c geoPoint) int {
+	value := (b.lon-a.lon)*(c.lat-a.lat) - (b.lat-a.lat)*(c.lon-a.lon)
+	switch {
+	case value > 0:
+		return 1
+	case value < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+func geoOnSegment(a geoPoint, b geoPoint, point geoPoint) bool {
+	return point.lon >= math.Min(a.lon, b.lon) && point.lon <= math.Max(a.lon, b.lon) &&
+		point.lat >= math.Min(a.lat, b.lat) && point.lat <= math.Max(a.lat, b.lat)
+}
+
+// geoPointToSegmentDistance returns the geodesic distance in metres between a point
+// and the closest point of the great circle arc between the segment endpoints
+func geoPointToSegmentDistance(point geoPoint, segment geoSegment) float64 {
+	if segment.start == segment.end {
+		return geoDistance(point, segment.start)
+	}
+
+	a, b, p := geoToVector(segment.start), geoToVector(segment.end), geoToVector(point)
+	normal := vectorNormalize(vectorCross(a, b))
+	projected := vectorSubtract(p, vectorScale(normal, vectorDot(p, normal)))
+
+	if vectorLength(projected) > 0 {
+		closest := vectorNormalize(projected)
+		if vectorDot(vectorCross(a, closest), normal) >= 0 && vectorDot(vectorCross(closest, b), normal) >= 0 {
+			return geoDistance(point, geoFromVector(closest))
+		}
+	}
+
+	return math.Min(geoDistance(point, segment.start), geoDistance(point, segment.end))
+}
+
+// geoDistance returns the geodesic distance in metres between two points
+// on the WGS-84 ellipsoid using Vincenty's inverse formula
+func geoDistance(p1 geoPoint, p2 geoPoint) float64 {
+	if p1 == p2 {
+		return 0
+	}
+
+	a, b, f := wgs84SemiMajorAxis, wgs84SemiMinorAxis, wgs84Flattening
+	L := degreesToRadians(p2.lon - p1.lon)
+	U1 := math.Atan((1 - f) * math.Tan(degreesToRadians(p1.lat)))
+	U2 := math.Atan((1 - f) * math.Tan(degreesToRadians(p2.lat)))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	lambda := L
+	var sinSigma, cosSigma, sigma, cos2Alpha, cos2SigmaM float64
+	for i := 0; i < 200; i++ {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		sinSigma = math.Sqrt(math.Pow(cosU2*sinLambda, 2) + math.Pow(cosU1*sinU2-sinU1*cosU2*cosLambda, 2))
+		if sinSigma == 0 {
+			return 0
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0
+		if cos2Alpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		}
+		C := f / 16 * cos2Alpha * (4 + f*(4-3*cos2Alpha))
+		previousLambda := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+		if math.Abs(lambda-previousLambda) < 1e-12 {
+			uSquared := cos2Alpha * (a*a - b*b) / (b * b)
+			A := 1 + uSquared/16384*(4096+uSquared*(-768+uSquared*(320-175*uSquared)))
+			B := uSquared / 1024 * (256 + uSquared*(-128+uSquared*(74-47*uSquared)))
+			deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+				B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+			return b * A * (sigma - deltaSigma)
+		}
+	}
+
+	// Vincenty's formula does not converge for nearly antipodal points
+	return earthAuthalicRadius * math.Acos(math.Max(-1, math.Min(1, vectorDot(geoToVector(p1), geoToVector(p2)))))
+}
+
+// geoRingArea returns the area in square metres enclosed by a ring on the authalic sphere
+func geoRingArea(ring []geoPoint) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		p1, p2 := ring[i-1], ring[i]
+		area += degreesToRadians(p2.lon-p1.lon) *
+			(2 + math.Sin(degreesToRadians(p1.lat)) + math.Sin(degreesToRadians(p2.lat)))
+	}
+	return math.Abs(area * earthAuthalicRadius * earthAuthalicRadius / 2)
+}
+
+func geoToVector(point geoPoint) [3]float64 {
+	lon, lat := degreesToRadians(point.lon), degreesToRadians(point.lat)
+	return [3]float64{math.Cos(lat) * math.Cos(lon), math.Cos(lat) * math.Sin(lon), math.Sin(lat)}
+}
+
+func geoFromVector(vector [3]float64) geoPoint {
+	return geoPoint{
+		lon: radiansToDegrees(math.Atan2(vector[1], vector[0])),
+		lat: radiansToDegrees(math.Atan2(vector[2], math.Hypot(vector[0], vector[1]))),
+	}
+}
+
+func vectorCross(a [3]float64, b [3]float64) [3]float64 {
+	return [3]float64{a[1]*b[2] - a[2]*b[1], a[2]*b[0] - a[0]*b[2], a[0]*b[1] - a[1]*b[0]}
+}
+
+func vectorDot(a [3]float64, b [3]float64) float64 {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+func vectorScale(a [3]float64, scale float64) [3]float64 {
+	return [3]float64{a[0] * scale, a[1] * scale, a[2] * scale}
+}
+
+func vectorSubtract(a [3]float64, b [3]float64) [3]float64 {
+	return [3]float64{a[0] - b[0], a[1] - b[1], a[2] - b[2]}
+}
+
+func vectorLength(a [3]float64) float64 {
+	return math.Sqrt(vectorDot(a, a))
+}
+
+func vectorNormalize(a [3]float64) [3]float64 {
+	length := vectorLength(a)
+	if length == 0 {
+		return a
+	}
+	return vectorScale(a, 1/length)
+}
+
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func radiansToDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
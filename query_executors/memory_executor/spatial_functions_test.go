@@ -0,0 +1,188 @@
+package memoryexecutor_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+	testutils "github.com/pikami/cosmium/test_utils"
+)
+
+func Test_Execute_SpatialFunctions(t *testing.T) {
+	square := map[string]interface{}{
+		"type": "Polygon",
+		"coordinates": []interface{}{
+			[]interface{}{
+				[]interface{}{0.0, 0.0},
+				[]interface{}{1.0, 0.0},
+				[]interface{}{1.0, 1.0},
+				[]interface{}{0.0, 1.0},
+				[]interface{}{0.0, 0.0},
+			},
+		},
+	}
+
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{
+			"id":       "1",
+			"area":     square,
+			"location": map[string]interface{}{"type": "Point", "coordinates": []interface{}{0.5, 0.5}},
+		},
+		map[string]interface{}{
+			"id":       "2",
+			"area":     square,
+			"location": map[string]interface{}{"type": "Point", "coordinates": []interface{}{2.0, 0.0}},
+		},
+		map[string]interface{}{
+			"id":   "3",
+			"area": square,
+			"location": map[string]interface{}{
+				"type":        "LineString",
+				"coordinates": []interface{}{[]interface{}{-1.0, 0.5}, []interface{}{0.5, 0.5}},
+			},
+		},
+		map[string]interface{}{
+			"id":   "4",
+			"area": square,
+			"location": map[string]interface{}{
+				"type": "Polygon",
+				"coordinates": []interface{}{
+					[]interface{}{
+						[]interface{}{31.8, -5.0},
+						[]interface{}{31.8, -4.7},
+						[]interface{}{32.0, -4.7},
+						[]interface{}{32.0, -5.0},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("Should execute function ST_DISTANCE(ex1, ex2)", func(t *testing.T) {
+		result := executeFunctionCall(
+			parsers.FunctionCallStDistance,
+			[]interface{}{testutils.SelectItem_Path("c", "location"), testutils.SelectItem_Path("c", "area")},
+			mockData,
+		)
+
+		expected := []interface{}{0.0, 111319.49, 0.0, nil}
+		for i, row := range result {
+			value := row.(map[string]interface{})["result"]
+			if expected[i] == nil {
+				if value != nil {
+					t.Errorf("row %d: expected undefined, got %v", i, value)
+				}
+				continue
+			}
+
+			if math.Abs(value.(float64)-expected[i].(float64)) > 0.01 {
+				t.Errorf("row %d: expected %v, got %v", i, expected[i], value)
+			}
+		}
+	})
+
+	t.Run("Should execute function ST_WITHIN(ex1, ex2)", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallStWithin,
+				[]interface{}{testutils.SelectItem_Path("c", "location"), testutils.SelectItem_Path("c", "area")},
+			),
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": true},
+				map[string]interface{}{"id": "2", "result": false},
+				map[string]interface{}{"id": "3", "result": false},
+				map[string]interface{}{"id": "4", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function ST_INTERSECTS(ex1, ex2)", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallStIntersects,
+				[]interface{}{testutils.SelectItem_Path("c", "location"), testutils.SelectItem_Path("c", "area")},
+			),
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": true},
+				map[string]interface{}{"id": "2", "result": false},
+				map[string]interface{}{"id": "3", "result": true},
+				map[string]interface{}{"id": "4", "result": nil},
+			},
+		)
+	})
+
+	t.Run("Should execute function ST_AREA(ex)", func(t *testing.T) {
+		result := executeFunctionCall(
+			parsers.FunctionCallStArea,
+			[]interface{}{testutils.SelectItem_Path("c", "area")},
+			mockData[:1],
+		)
+
+		value := result[0].(map[string]interface{})["result"].(float64)
+		if math.Abs(value-12364036567.0)/12364036567.0 > 0.01 {
+			t.Errorf("expected area of about 12364036567, got %v", value)
+		}
+	})
+
+	t.Run("Should execute function ST_ISVALID(ex)", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallStIsValid,
+				[]interface{}{testutils.SelectItem_Path("c", "location")},
+			),
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": true},
+				map[string]interface{}{"id": "2", "result": true},
+				map[string]interface{}{"id": "3", "result": true},
+				map[string]interface{}{"id": "4", "result": false},
+			},
+		)
+	})
+
+	t.Run("Should execute function ST_ISVALIDDETAILED(ex)", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallStIsValidDetailed,
+				[]interface{}{testutils.SelectItem_Path("c", "location")},
+			),
+			mockData[2:],
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "3", "result": map[string]interface{}{"valid": true}},
+				map[string]interface{}{"id": "4", "result": map[string]interface{}{
+					"valid":  false,
+					"reason": "The Polygon input is not valid because the start and end points of the ring number 1 are not the same. Each ring of a polygon must have the same start and end points.",
+				}},
+			},
+		)
+	})
+
+	t.Run("Should execute function ST_ISVALIDDETAILED(ex) with invalid latitude", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallStIsValidDetailed,
+				[]interface{}{testutils.SelectItem_Path("c", "location")},
+			),
+			[]memoryexecutor.RowType{
+				map[string]interface{}{
+					"id":       "5",
+					"location": map[string]interface{}{"type": "Point", "coordinates": []interface{}{31.9, -132.8}},
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "5", "result": map[string]interface{}{
+					"valid":  false,
+					"reason": "Latitude values must be between -90 and 90 degrees.",
+				}},
+			},
+		)
+	})
+}
@@ -1,7 +1,9 @@
 package memoryexecutor
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"regexp"
 	"strings"
 
@@ -88,32 +90,110 @@ func (r rowContext) strings_RegexMatch(arguments []interface{}) bool {
 		return false
 	}
 
-	regexPattern := pattern
-	if strings.Contains(modifiers, "x") {
-		regexPattern = stripRegexIgnoredWhitespace(regexPattern)
+	regex, err := compileRegexMatchPattern(pattern, modifiers)
+	if err != nil {
+		logger.Errorf("strings_RegexMatch - invalid pattern %q: %v", pattern, err)
+		r.setQueryError(err)
+		return false
 	}
 
+	return regex.MatchString(value)
+}
+
+// compileRegexMatchPattern translates a REGEXMATCH pattern and its modifiers into a Go regexp.
+// Constructs that RE2 can not evaluate are reported as errors instead of silently not matching.
+func compileRegexMatchPattern(pattern string, modifiers string) (*regexp.Regexp, error) {
 	var flags strings.Builder
-	if strings.Contains(modifiers, "i") {
-		flags.WriteByte('i')
+	for _, modifier := range modifiers {
+		switch modifier {
+		case 'i', 'm', 's':
+			if !strings.ContainsRune(flags.String(), modifier) {
+				flags.WriteRune(modifier)
+			}
+		case 'x':
+		default:
+			return nil, fmt.Errorf("Invalid regex modifier '%c' in REGEXMATCH. Supported modifiers are 'i', 'm', 's' and 'x'.", modifier)
+		}
 	}
-	if strings.Contains(modifiers, "m") {
-		flags.WriteByte('m')
+
+	if construct := findUnsupportedRegexConstruct(pattern); construct != "" {
+		return nil, fmt.Errorf("Regular expression '%s' used in REGEXMATCH contains %s, which are not supported.", pattern, construct)
 	}
-	if strings.Contains(modifiers, "s") {
-		flags.WriteByte('s')
+
+	regexPattern := pattern
+	if strings.ContainsRune(modifiers, 'x') {
+		regexPattern = stripRegexIgnoredWhitespace(regexPattern)
 	}
 	if flags.Len() > 0 {
 		regexPattern = "(?" + flags.String() + ")" + regexPattern
 	}
 
-	matched, err := regexp.MatchString(regexPattern, value)
+	regex, err := regexp.Compile(regexPattern)
 	if err != nil {
-		logger.Errorf("strings_RegexMatch - invalid pattern %q: %v", pattern, err)
-		return false
+		return nil, fmt.Errorf("Invalid regular expression '%s' in REGEXMATCH: %v", pattern, err)
+	}
+
+	return regex, nil
+}
+
+// findUnsupportedRegexConstruct returns a description of the first PCRE construct
+// in the pattern that has no RE2 equivalent, or an empty string if there is none
+func findUnsupportedRegexConstruct(pattern string) string {
+	inCharClass := false
+	runes := []rune(pattern)
+
+	for i := 0; i < len(runes); i++ {
+		rest := string(runes[i:])
+
+		if runes[i] == '\\' && i+1 < len(runes) {
+			next := runes[i+1]
+			i++
+			if inCharClass {
+				continue
+			}
+			switch {
+			case next >= '1' && next <= '9', next == 'k', next == 'g':
+				return "backreferences"
+			case next == 'G':
+				return "\\G anchors"
+			}
+			continue
+		}
+
+		if inCharClass {
+			if runes[i] == ']' {
+				inCharClass = false
+			}
+			continue
+		}
+
+		switch {
+		case runes[i] == '[':
+			inCharClass = true
+			if i+1 < len(runes) && runes[i+1] == '^' {
+				i++
+			}
+			if i+1 < len(runes) && runes[i+1] == ']' {
+				i++
+			}
+		case strings.HasPrefix(rest, "(?=") || strings.HasPrefix(rest, "(?!"):
+			return "lookahead assertions"
+		case strings.HasPrefix(rest, "(?<=") || strings.HasPrefix(rest, "(?<!"):
+			return "lookbehind assertions"
+		case strings.HasPrefix(rest, "(?>"):
+			return "atomic groups"
+		case strings.HasPrefix(rest, "(?("):
+			return "conditional groups"
+		case strings.HasPrefix(rest, "(?R)") || strings.HasPrefix(rest, "(?0)"):
+			return "recursive patterns"
+		case strings.HasPrefix(rest, "(?|"):
+			return "branch reset groups"
+		case strings.ContainsRune("*+?}", runes[i]) && i+1 < len(runes) && runes[i+1] == '+':
+			return "possessive quantifiers"
+		}
 	}
 
-	return matched
+	return ""
 }
 
 func (r rowContext) strings_Concat(arguments []interface{}) string {
@@ -347,6 +427,91 @@ func (r rowContext) strings_Trim(arguments []interface{}) string {
 	return strings.TrimSpace(str)
 }
 
+func (r rowContext) strings_StringToArray(arguments []interface{}) interface{} {
+	if value, ok := r.parseJsonString(arguments[0]).([]interface{}); ok {
+		return value
+	}
+
+	return nil
+}
+
+func (r rowContext) strings_StringToBoolean(arguments []interface{}) interface{} {
+	if value, ok := r.parseJsonString(arguments[0]).(bool); ok {
+		return value
+	}
+
+	return nil
+}
+
+func (r rowContext) strings_StringToNull(arguments []interface{}) interface{} {
+	// null and undefined are both represented as nil
+	return nil
+}
+
+func (r rowContext) strings_StringToNumber(arguments []interface{}) interface{} {
+	switch value := r.parseJsonString(arguments[0]).(type) {
+	case int, float64:
+		return value
+	}
+
+	return nil
+}
+
+func (r rowContext) strings_StringToObject(arguments []interface{}) interface{} {
+	if value, ok := r.parseJsonString(arguments[0]).(map[string]interface{}); ok {
+		return value
+	}
+
+	return nil
+}
+
+// parseJsonString parses a string argument as a single strict JSON value,
+// returning nil if the argument is not a string or not valid JSON
+func (r rowContext) parseJsonString(argument interface{}) interface{} {
+	str, ok := r.parseString(argument)
+	if !ok {
+		return nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(str))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		logger.DebugLn("parseJsonString - failed to parse JSON:", err)
+		return nil
+	}
+
+	// Only a single JSON value is allowed, trailing whitespace is fine
+	if _, err := decoder.Token(); err != io.EOF {
+		logger.DebugLn("parseJsonString - unexpected data after JSON value")
+		return nil
+	}
+
+	return convertJsonNumbers(value)
+}
+
+func convertJsonNumbers(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case json.Number:
+		if intValue, err := typedValue.Int64(); err == nil {
+			return int(intValue)
+		}
+		floatValue, _ := typedValue.Float64()
+		return floatValue
+	case []interface{}:
+		for i, item := range typedValue {
+			typedValue[i] = convertJsonNumbers(item)
+		}
+	case map[string]interface{}:
+		for key, item := range typedValue {
+			typedValue[key] = convertJsonNumbers(item)
+		}
+	}
+
+	return value
+}
+
 func (r rowContext) getBoolFlag(arguments []interface{}) bool {
 	ignoreCase := false
 	if len(arguments) > 2 && arguments[2] != nil {
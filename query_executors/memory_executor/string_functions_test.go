@@ -267,6 +267,44 @@ func Test_Execute_StringFunctions(t *testing.T) {
 		)
 	})
 
+	t.Run("Should execute function REGEXMATCH() with extended modifier", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			functionCallQuery(
+				parsers.FunctionCallRegexMatch,
+				[]interface{}{
+					testutils.SelectItem_Path("c", "str"),
+					testutils.SelectItem_Constant_String("^ cool \\s W o r l d $"),
+					testutils.SelectItem_Constant_String("ix"),
+				},
+			),
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "123", "result": false},
+				map[string]interface{}{"id": "456", "result": false},
+				map[string]interface{}{"id": "789", "result": true},
+			},
+		)
+	})
+
+	t.Run("Should fail query when REGEXMATCH() uses unsupported pattern", func(t *testing.T) {
+		for _, arguments := range [][]interface{}{
+			{testutils.SelectItem_Path("c", "str"), testutils.SelectItem_Constant_String("cool(?= world)")},
+			{testutils.SelectItem_Path("c", "str"), testutils.SelectItem_Constant_String("(o)\\1")},
+			{testutils.SelectItem_Path("c", "str"), testutils.SelectItem_Constant_String("o++")},
+			{testutils.SelectItem_Path("c", "str"), testutils.SelectItem_Constant_String("cool"), testutils.SelectItem_Constant_String("q")},
+		} {
+			iter := NewTestDocumentIterator(mockData)
+			result := memoryexecutor.ExecuteQuery(functionCallQuery(parsers.FunctionCallRegexMatch, arguments), iter, 0, 1000)
+			if result.Error == nil {
+				t.Errorf("expected query error for arguments %v", arguments)
+			}
+			if len(result.Rows) != 0 {
+				t.Errorf("expected no rows, got %v", result.Rows)
+			}
+		}
+	})
+
 	t.Run("Should execute function INDEX_OF()", func(t *testing.T) {
 		testQueryExecute(
 			t,
@@ -683,4 +721,68 @@ func Test_Execute_StringFunctions(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should execute string conversion functions", func(t *testing.T) {
+		conversionData := []memoryexecutor.RowType{
+			map[string]interface{}{"id": "1", "value": "[1, 2.5, \"a\", [true]]"},
+			map[string]interface{}{"id": "2", "value": " true "},
+			map[string]interface{}{"id": "3", "value": "-1.5e2"},
+			map[string]interface{}{"id": "4", "value": "{\"a\": {\"b\": 1}}"},
+			map[string]interface{}{"id": "5", "value": "{'a': 1}"},
+			map[string]interface{}{"id": "6", "value": "12 13"},
+			map[string]interface{}{"id": "7", "value": 12},
+		}
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					testutils.SelectItem_Path("c", "id"),
+					{
+						Alias: "array",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallStringToArray,
+							Arguments: []interface{}{testutils.SelectItem_Path("c", "value")},
+						},
+					},
+					{
+						Alias: "boolean",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallStringToBoolean,
+							Arguments: []interface{}{testutils.SelectItem_Path("c", "value")},
+						},
+					},
+					{
+						Alias: "number",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallStringToNumber,
+							Arguments: []interface{}{testutils.SelectItem_Path("c", "value")},
+						},
+					},
+					{
+						Alias: "object",
+						Type:  parsers.SelectItemTypeFunctionCall,
+						Value: parsers.FunctionCall{
+							Type:      parsers.FunctionCallStringToObject,
+							Arguments: []interface{}{testutils.SelectItem_Path("c", "value")},
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+			conversionData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "array": []interface{}{1, 2.5, "a", []interface{}{true}}, "boolean": nil, "number": nil, "object": nil},
+				map[string]interface{}{"id": "2", "array": nil, "boolean": true, "number": nil, "object": nil},
+				map[string]interface{}{"id": "3", "array": nil, "boolean": nil, "number": -150.0, "object": nil},
+				map[string]interface{}{"id": "4", "array": nil, "boolean": nil, "number": nil, "object": map[string]interface{}{"a": map[string]interface{}{"b": 1}}},
+				map[string]interface{}{"id": "5", "array": nil, "boolean": nil, "number": nil, "object": nil},
+				map[string]interface{}{"id": "6", "array": nil, "boolean": nil, "number": nil, "object": nil},
+				map[string]interface{}{"id": "7", "array": nil, "boolean": nil, "number": nil, "object": nil},
+			},
+		)
+	})
 }
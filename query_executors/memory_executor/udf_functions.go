@@ -0,0 +1,91 @@
+package memoryexecutor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dop251/goja"
+	"github.com/pikami/cosmium/internal/jsruntime"
+	"github.com/pikami/cosmium/internal/logger"
+	"github.com/pikami/cosmium/parsers"
+)
+
+// udfRuntime lazily compiles user defined functions into a JavaScript runtime
+// that is shared by all rows of a query
+type udfRuntime struct {
+	bodies    map[string]string
+	vm        *goja.Runtime
+	functions map[string]goja.Callable
+}
+
+func newUdfRuntime(bodies map[string]string) *udfRuntime {
+	return &udfRuntime{
+		bodies:    bodies,
+		functions: make(map[string]goja.Callable),
+	}
+}
+
+func (u *udfRuntime) getFunction(name string) (goja.Callable, error) {
+	if function, ok := u.functions[name]; ok {
+		return function, nil
+	}
+
+	body, ok := u.bodies[name]
+	if !ok {
+		return nil, fmt.Errorf("Unknown user defined function 'udf.%s'.", name)
+	}
+
+	if u.vm == nil {
+		u.vm = jsruntime.New()
+	}
+
+	function, err := jsruntime.Compile(u.vm, name, body)
+	if err != nil {
+		return nil, errors.New(jsruntime.ErrorMessage(err))
+	}
+
+	u.functions[name] = function
+	return function, nil
+}
+
+func (r rowContext) udf_Call(name string, arguments []interface{}) interface{} {
+	if r.queryContext == nil || r.queryContext.udfs == nil {
+		logger.ErrorLn("udf_Call - user defined functions are not available")
+		return nil
+	}
+
+	// The query has already failed, there is no point in running more scripts
+	if r.queryContext.err != nil {
+		return nil
+	}
+
+	udfs := r.queryContext.udfs
+	function, err := udfs.getFunction(name)
+	if err != nil {
+		r.setQueryError(err)
+		return nil
+	}
+
+	jsArguments := make([]goja.Value, len(arguments))
+	for i, argument := range arguments {
+		value := r.resolveSelectItem(argument.(parsers.SelectItem))
+		if jsArguments[i], err = jsruntime.ToValue(udfs.vm, value); err != nil {
+			r.setQueryError(errors.New(jsruntime.ErrorMessage(err)))
+			return nil
+		}
+	}
+
+	result, err := jsruntime.Call(udfs.vm, function, jsruntime.DefaultTimeout, jsArguments...)
+	if err != nil {
+		r.setQueryError(errors.New(jsruntime.ErrorMessage(err)))
+		return nil
+	}
+
+	exported, err := jsruntime.Export(udfs.vm, result)
+	if err != nil {
+		r.setQueryError(errors.New(jsruntime.ErrorMessage(err)))
+		return nil
+	}
+
+	return exported
+}
@@ -0,0 +1,103 @@
+package memoryexecutor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pikami/cosmium/parsers"
+	memoryexecutor "github.com/pikami/cosmium/query_executors/memory_executor"
+	testutils "github.com/pikami/cosmium/test_utils"
+)
+
+func Test_Execute_UserDefinedFunctions(t *testing.T) {
+	mockData := []memoryexecutor.RowType{
+		map[string]interface{}{"id": "1", "price": 100, "tags": []interface{}{"a", "b"}},
+		map[string]interface{}{"id": "2", "price": 12.5, "tags": []interface{}{}},
+	}
+
+	udfs := map[string]string{
+		"tax":        "function (price, rate) { return price * rate; }",
+		"describe":   "function describe(doc) { return { id: doc.id, tagCount: doc.tags.length, first: doc.tags[0] }; }",
+		"fail":       "function (doc) { throw new Error('price is missing for ' + doc.id); }",
+		"recurse":    "function recurse(n) { return recurse(n + 1); }",
+		"notAScript": "return 1;",
+	}
+
+	udfQuery := func(name string, arguments ...interface{}) parsers.SelectStmt {
+		return parsers.SelectStmt{
+			SelectItems: []parsers.SelectItem{
+				testutils.SelectItem_Path("c", "id"),
+				{
+					Alias: "result",
+					Type:  parsers.SelectItemTypeFunctionCall,
+					Value: parsers.FunctionCall{
+						Type:      parsers.FunctionCallUserDefined,
+						UdfName:   name,
+						Arguments: arguments,
+					},
+				},
+			},
+			Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			Udfs:  udfs,
+		}
+	}
+
+	t.Run("Should execute user defined function", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			udfQuery("tax", testutils.SelectItem_Path("c", "price"), testutils.SelectItem_Constant_Float(0.2)),
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": 20},
+				map[string]interface{}{"id": "2", "result": 2.5},
+			},
+		)
+	})
+
+	t.Run("Should marshal objects in and out of user defined function", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			udfQuery("describe", testutils.SelectItem_Path("c")),
+			mockData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "result": map[string]interface{}{"id": "1", "tagCount": 2, "first": "a"}},
+				map[string]interface{}{"id": "2", "result": map[string]interface{}{"id": "2", "tagCount": 0}},
+			},
+		)
+	})
+
+	t.Run("Should fail query when user defined function fails", func(t *testing.T) {
+		testCases := map[string]struct {
+			query           parsers.SelectStmt
+			expectedMessage string
+		}{
+			"exception": {
+				query:           udfQuery("fail", testutils.SelectItem_Path("c")),
+				expectedMessage: "Exception = Error: price is missing for 1",
+			},
+			"recursion": {
+				query:           udfQuery("recurse", testutils.SelectItem_Constant_Int(0)),
+				expectedMessage: "Maximum call stack size exceeded",
+			},
+			"invalid body": {
+				query:           udfQuery("notAScript"),
+				expectedMessage: "Encountered exception while executing Javascript",
+			},
+			"unknown function": {
+				query:           udfQuery("missing"),
+				expectedMessage: "Unknown user defined function 'udf.missing'",
+			},
+		}
+
+		for name, testCase := range testCases {
+			result := memoryexecutor.ExecuteQuery(testCase.query, NewTestDocumentIterator(mockData), 0, 1000)
+			if result.Error == nil {
+				t.Errorf("%s: expected query error", name)
+				continue
+			}
+			if !strings.Contains(result.Error.Error(), testCase.expectedMessage) {
+				t.Errorf("%s: expected error containing %q, got %q", name, testCase.expectedMessage, result.Error.Error())
+			}
+		}
+	})
+}
@@ -154,4 +154,176 @@ func Test_Execute_Where(t *testing.T) {
 			},
 		)
 	})
+
+	t.Run("Should execute SELECT with LIKE conditions", func(t *testing.T) {
+		likeCases := []struct {
+			pattern  string
+			negate   bool
+			expected []memoryexecutor.RowType
+		}{
+			{pattern: "%5%", expected: []memoryexecutor.RowType{map[string]interface{}{"id": "12345"}, map[string]interface{}{"id": "456"}}},
+			{pattern: "12_", expected: []memoryexecutor.RowType{map[string]interface{}{"id": "123"}}},
+			{pattern: "[14]%", expected: []memoryexecutor.RowType{map[string]interface{}{"id": "12345"}, map[string]interface{}{"id": "456"}, map[string]interface{}{"id": "123"}}},
+			{pattern: "[^1-4]%", expected: []memoryexecutor.RowType{map[string]interface{}{"id": "67890"}}},
+			{pattern: "%5%", negate: true, expected: []memoryexecutor.RowType{map[string]interface{}{"id": "67890"}, map[string]interface{}{"id": "123"}}},
+		}
+
+		for _, likeCase := range likeCases {
+			operation := "LIKE"
+			if likeCase.negate {
+				operation = "NOT LIKE"
+			}
+
+			testQueryExecute(
+				t,
+				parsers.SelectStmt{
+					SelectItems: []parsers.SelectItem{
+						{Path: []string{"c", "id"}},
+					},
+					Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+					Filters: parsers.ComparisonExpression{
+						Operation: operation,
+						Left:      parsers.SelectItem{Path: []string{"c", "id"}},
+						Right:     testutils.SelectItem_Constant_String(likeCase.pattern),
+					},
+				},
+				mockData,
+				likeCase.expected,
+			)
+		}
+	})
+
+	t.Run("Should execute SELECT with LIKE condition and ESCAPE clause", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.ComparisonExpression{
+					Operation: "LIKE",
+					Left:      parsers.SelectItem{Path: []string{"c", "discount"}},
+					Right:     testutils.SelectItem_Constant_String("%!%"),
+					Escape:    "!",
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "discount": "20%"},
+				map[string]interface{}{"id": "2", "discount": "20"},
+				map[string]interface{}{"id": "3", "discount": 20},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1"},
+			},
+		)
+	})
+
+	t.Run("Should execute SELECT with LIKE pattern of the document", func(t *testing.T) {
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.ComparisonExpression{
+					Operation: "LIKE",
+					Left:      parsers.SelectItem{Path: []string{"c", "name"}},
+					Right:     parsers.SelectItem{Path: []string{"c", "pattern"}},
+				},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "name": "apple", "pattern": "a%"},
+				map[string]interface{}{"id": "2", "name": "banana", "pattern": "a%"},
+				map[string]interface{}{"id": "3", "name": "banana", "pattern": "b%"},
+				map[string]interface{}{"id": "4", "name": "apple", "pattern": "b%"},
+			},
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1"},
+				map[string]interface{}{"id": "3"},
+			},
+		)
+	})
+
+	t.Run("Should execute SELECT with BETWEEN conditions", func(t *testing.T) {
+		rangeData := []memoryexecutor.RowType{
+			map[string]interface{}{"id": "1", "price": 5},
+			map[string]interface{}{"id": "2", "price": 10.5},
+			map[string]interface{}{"id": "3", "price": 20},
+			map[string]interface{}{"id": "4", "price": "15"},
+			map[string]interface{}{"id": "5"},
+		}
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.BetweenExpression{
+					Value: parsers.SelectItem{Path: []string{"c", "price"}},
+					Low:   testutils.SelectItem_Constant_Parameter("@lo"),
+					High:  testutils.SelectItem_Constant_Parameter("@hi"),
+				},
+				Parameters: map[string]interface{}{
+					"@lo": 5,
+					"@hi": 15,
+				},
+			},
+			rangeData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1"},
+				map[string]interface{}{"id": "2"},
+			},
+		)
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+				Filters: parsers.BetweenExpression{
+					Value:  parsers.SelectItem{Path: []string{"c", "price"}},
+					Low:    testutils.SelectItem_Constant_Int(5),
+					High:   testutils.SelectItem_Constant_Int(15),
+					Invert: true,
+				},
+			},
+			rangeData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "3"},
+			},
+		)
+
+		testQueryExecute(
+			t,
+			parsers.SelectStmt{
+				SelectItems: []parsers.SelectItem{
+					{Path: []string{"c", "id"}},
+					{
+						Alias: "inRange",
+						Type:  parsers.SelectItemTypeExpression,
+						Value: parsers.BetweenExpression{
+							Value: parsers.SelectItem{Path: []string{"c", "price"}},
+							Low:   testutils.SelectItem_Constant_Int(5),
+							High:  testutils.SelectItem_Constant_Int(15),
+						},
+					},
+				},
+				Table: parsers.Table{SelectItem: testutils.SelectItem_Path("c")},
+			},
+			rangeData,
+			[]memoryexecutor.RowType{
+				map[string]interface{}{"id": "1", "inRange": true},
+				map[string]interface{}{"id": "2", "inRange": true},
+				map[string]interface{}{"id": "3", "inRange": false},
+				map[string]interface{}{"id": "4", "inRange": nil},
+				map[string]interface{}{"id": "5", "inRange": nil},
+			},
+		)
+	})
 }
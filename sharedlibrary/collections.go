@@ -90,7 +90,7 @@ func DeleteCollection(serverName *C.char, databaseId *C.char, collectionId *C.ch
 		return ResponseServerInstanceNotFound
 	}
 
-	code := serverInstance.dataStore.DeleteCollection(databaseIdStr, collectionIdStr)
+	code := serverInstance.dataStore.DeleteCollection(databaseIdStr, collectionIdStr, "")
 
 	return dataStoreStatusToResponseCode(code)
 }
@@ -87,7 +87,7 @@ func DeleteDatabase(serverName *C.char, databaseId *C.char) int {
 		return ResponseServerInstanceNotFound
 	}
 
-	code := serverInstance.dataStore.DeleteDatabase(databaseIdStr)
+	code := serverInstance.dataStore.DeleteDatabase(databaseIdStr, "")
 
 	return dataStoreStatusToResponseCode(code)
 }
@@ -27,7 +27,7 @@ func CreateDocument(serverName *C.char, databaseId *C.char, collectionId *C.char
 		return ResponseFailedToParseRequest
 	}
 
-	_, code := serverInstance.dataStore.CreateDocument(databaseIdStr, collectionIdStr, document)
+	_, code := serverInstance.dataStore.CreateDocument(databaseIdStr, collectionIdStr, nil, document)
 
 	return dataStoreStatusToResponseCode(code)
 }
@@ -45,7 +45,7 @@ func GetDocument(serverName *C.char, databaseId *C.char, collectionId *C.char, d
 		return C.CString("")
 	}
 
-	document, code := serverInstance.dataStore.GetDocument(databaseIdStr, collectionIdStr, documentIdStr)
+	document, code := serverInstance.dataStore.GetDocument(databaseIdStr, collectionIdStr, nil, documentIdStr)
 	if code != datastore.StatusOk {
 		return C.CString("")
 	}
@@ -101,12 +101,7 @@ func UpdateDocument(serverName *C.char, databaseId *C.char, collectionId *C.char
 		return ResponseFailedToParseRequest
 	}
 
-	code := serverInstance.dataStore.DeleteDocument(databaseIdStr, collectionIdStr, documentIdStr)
-	if code != datastore.StatusOk {
-		return dataStoreStatusToResponseCode(code)
-	}
-
-	_, code = serverInstance.dataStore.CreateDocument(databaseIdStr, collectionIdStr, document)
+	_, code := serverInstance.dataStore.ReplaceDocument(databaseIdStr, collectionIdStr, nil, documentIdStr, document, "")
 	return dataStoreStatusToResponseCode(code)
 }
 
@@ -123,7 +118,7 @@ func DeleteDocument(serverName *C.char, databaseId *C.char, collectionId *C.char
 		return ResponseServerInstanceNotFound
 	}
 
-	code := serverInstance.dataStore.DeleteDocument(databaseIdStr, collectionIdStr, documentIdStr)
+	code := serverInstance.dataStore.DeleteDocument(databaseIdStr, collectionIdStr, nil, documentIdStr)
 
 	return dataStoreStatusToResponseCode(code)
 }
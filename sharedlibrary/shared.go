@@ -69,7 +69,7 @@ func dataStoreStatusToResponseCode(status datastore.DataStoreStatus) int {
 		return ResponseDataStoreNotFound
 	case datastore.Conflict:
 		return ResponseDataStoreConflict
-	case datastore.BadRequest:
+	case datastore.BadRequest, datastore.PartitionKeyMismatch:
 		return ResponseDataStoreBadRequest
 	default:
 		return ResponseUnknown